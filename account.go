@@ -0,0 +1,226 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/config"
+	"github.com/idena-network/idena-go/crypto"
+	"github.com/idena-network/idena-go/keystore"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"golang.org/x/term"
+	"io/ioutil"
+	"os"
+	"strings"
+)
+
+var accountFlags = []cli.Flag{
+	config.CfgFileFlag,
+	config.DataDirFlag,
+	config.PasswordFileFlag,
+}
+
+var accountCommand = cli.Command{
+	Name:  "account",
+	Usage: "Manage keystore accounts",
+	Subcommands: []cli.Command{
+		{
+			Name:   "new",
+			Usage:  "Create a new account",
+			Flags:  accountFlags,
+			Action: accountNew,
+		},
+		{
+			Name:   "list",
+			Usage:  "Print addresses of existing accounts",
+			Flags:  accountFlags,
+			Action: accountList,
+		},
+		{
+			Name:      "import",
+			Usage:     "Import a hex encoded private key from a file",
+			ArgsUsage: "<keyfile>",
+			Flags:     accountFlags,
+			Action:    accountImport,
+		},
+		{
+			Name:      "export",
+			Usage:     "Print the encrypted key of the account in the keystore JSON format",
+			ArgsUsage: "<address>",
+			Flags:     accountFlags,
+			Action:    accountExport,
+		},
+	},
+}
+
+func openKeyStore(ctx *cli.Context) (*keystore.KeyStore, error) {
+	cfg, err := config.MakeConfigFromFile(ctx.String(config.CfgFileFlag.Name))
+	if err != nil {
+		return nil, err
+	}
+	if ctx.IsSet(config.DataDirFlag.Name) {
+		cfg.DataDir = ctx.String(config.DataDirFlag.Name)
+	}
+	return keyStoreOf(cfg)
+}
+
+func keyStoreOf(cfg *config.Config) (*keystore.KeyStore, error) {
+	dir, err := cfg.KeyStoreDataDir()
+	if err != nil {
+		return nil, err
+	}
+	return keystore.NewKeyStore(dir, keystore.StandardScryptN, keystore.StandardScryptP), nil
+}
+
+// readPassword reads the first line of the password file or prompts it if the file is not set,
+// the prompted password isn't echoed if stdin is a terminal
+func readPassword(passwordFile string, prompt string) (string, error) {
+	if passwordFile != "" {
+		data, err := ioutil.ReadFile(passwordFile)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to read password file")
+		}
+		return strings.TrimRight(strings.SplitN(string(data), "\n", 2)[0], "\r"), nil
+	}
+	fmt.Print(prompt)
+	fd := int(os.Stdin.Fd())
+	if term.IsTerminal(fd) {
+		password, err := term.ReadPassword(fd)
+		fmt.Println()
+		if err != nil {
+			return "", errors.Wrap(err, "failed to read password")
+		}
+		return string(password), nil
+	}
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && line == "" {
+		return "", errors.Wrap(err, "failed to read password")
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+// readNewPassword reads a password for a new key, the prompted password has to be repeated
+func readNewPassword(passwordFile string) (string, error) {
+	password, err := readPassword(passwordFile, "Password: ")
+	if err != nil || passwordFile != "" {
+		return password, err
+	}
+	confirmation, err := readPassword("", "Repeat password: ")
+	if err != nil {
+		return "", err
+	}
+	if password != confirmation {
+		return "", errors.New("passwords do not match")
+	}
+	return password, nil
+}
+
+func findAccount(ks *keystore.KeyStore, address string) (keystore.Account, error) {
+	if !common.IsHexAddress(address) {
+		return keystore.Account{}, errors.Errorf("invalid address %v", address)
+	}
+	return ks.Find(keystore.Account{Address: common.HexToAddress(address)})
+}
+
+func accountNew(ctx *cli.Context) error {
+	ks, err := openKeyStore(ctx)
+	if err != nil {
+		return err
+	}
+	password, err := readNewPassword(ctx.String(config.PasswordFileFlag.Name))
+	if err != nil {
+		return err
+	}
+	account, err := ks.NewAccount(password)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(ctx.App.Writer, "Address: %v\n", account.Address.Hex())
+	return nil
+}
+
+func accountList(ctx *cli.Context) error {
+	ks, err := openKeyStore(ctx)
+	if err != nil {
+		return err
+	}
+	for i, account := range ks.Accounts() {
+		fmt.Fprintf(ctx.App.Writer, "Account #%d: %v %v\n", i, account.Address.Hex(), account.URL)
+	}
+	return nil
+}
+
+func accountImport(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return errors.New("key file is required")
+	}
+	key, err := crypto.LoadECDSA(ctx.Args().First())
+	if err != nil {
+		return errors.Wrap(err, "failed to load private key")
+	}
+	ks, err := openKeyStore(ctx)
+	if err != nil {
+		return err
+	}
+	password, err := readNewPassword(ctx.String(config.PasswordFileFlag.Name))
+	if err != nil {
+		return err
+	}
+	account, err := ks.ImportECDSA(key, password)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(ctx.App.Writer, "Address: %v\n", account.Address.Hex())
+	return nil
+}
+
+func accountExport(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return errors.New("address is required")
+	}
+	ks, err := openKeyStore(ctx)
+	if err != nil {
+		return err
+	}
+	account, err := findAccount(ks, ctx.Args().First())
+	if err != nil {
+		return err
+	}
+	password, err := readPassword(ctx.String(config.PasswordFileFlag.Name), "Password: ")
+	if err != nil {
+		return err
+	}
+	keyJSON, err := ks.Export(account, password, password)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(ctx.App.Writer, string(keyJSON))
+	return nil
+}
+
+// unlockNodeKey decrypts the keystore account and makes the node use it as the coinbase key
+func unlockNodeKey(cfg *config.Config, address string, passwordFile string) error {
+	ks, err := keyStoreOf(cfg)
+	if err != nil {
+		return err
+	}
+	account, err := findAccount(ks, address)
+	if err != nil {
+		return err
+	}
+	password, err := readPassword(passwordFile, fmt.Sprintf("Password for %v: ", account.Address.Hex()))
+	if err != nil {
+		return err
+	}
+	keyJSON, err := ioutil.ReadFile(account.URL.Path)
+	if err != nil {
+		return errors.Wrap(err, "failed to read key file")
+	}
+	key, err := keystore.DecryptKey(keyJSON, password)
+	if err != nil {
+		return errors.Wrapf(err, "failed to unlock %v", account.Address.Hex())
+	}
+	cfg.SetNodeKey(key.PrivateKey)
+	return nil
+}
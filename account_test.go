@@ -0,0 +1,69 @@
+package main
+
+import (
+	"bytes"
+	"github.com/idena-network/idena-go/config"
+	"github.com/idena-network/idena-go/crypto"
+	"github.com/idena-network/idena-go/keystore"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func runAccountCommand(args ...string) (string, error) {
+	app := cli.NewApp()
+	app.Commands = []cli.Command{accountCommand}
+	output := new(bytes.Buffer)
+	app.Writer = output
+	err := app.Run(append([]string{"idena", "account"}, args...))
+	return output.String(), err
+}
+
+func TestAccountCommands(t *testing.T) {
+	dataDir := t.TempDir()
+	passwordFile := filepath.Join(t.TempDir(), "password")
+	require.NoError(t, ioutil.WriteFile(passwordFile, []byte("secret\nignored"), 0600))
+
+	output, err := runAccountCommand("new", "--datadir", dataDir, "--password", passwordFile)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(output, "Address: 0x"))
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	keyFile := filepath.Join(t.TempDir(), "key")
+	require.NoError(t, crypto.SaveECDSA(keyFile, key))
+	imported := crypto.PubkeyToAddress(key.PublicKey).Hex()
+	output, err = runAccountCommand("import", "--datadir", dataDir, "--password", passwordFile, keyFile)
+	require.NoError(t, err)
+	require.Equal(t, "Address: "+imported+"\n", output)
+
+	_, err = runAccountCommand("import", "--datadir", dataDir, "--password", passwordFile)
+	require.Error(t, err)
+
+	output, err = runAccountCommand("list", "--datadir", dataDir)
+	require.NoError(t, err)
+	require.Equal(t, 2, strings.Count(output, "Account #"))
+	require.Contains(t, output, imported)
+
+	output, err = runAccountCommand("export", "--datadir", dataDir, "--password", passwordFile, imported)
+	require.NoError(t, err)
+	exported, err := keystore.DecryptKey([]byte(output), "secret")
+	require.NoError(t, err)
+	require.Equal(t, key.D, exported.PrivateKey.D)
+
+	_, err = runAccountCommand("export", "--datadir", dataDir, "--password", passwordFile, "0x1")
+	require.Error(t, err)
+
+	cfg := &config.Config{DataDir: dataDir}
+	require.NoError(t, unlockNodeKey(cfg, imported, passwordFile))
+	nodeKey, err := cfg.NodeKey()
+	require.NoError(t, err)
+	require.Equal(t, key.D, nodeKey.D)
+
+	wrongPasswordFile := filepath.Join(t.TempDir(), "wrong")
+	require.NoError(t, ioutil.WriteFile(wrongPasswordFile, []byte("wrong"), 0600))
+	require.Error(t, unlockNodeKey(&config.Config{DataDir: dataDir}, imported, wrongPasswordFile))
+}
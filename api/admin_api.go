@@ -0,0 +1,81 @@
+package api
+
+import (
+	"github.com/idena-network/idena-go/consensus"
+	"github.com/idena-network/idena-go/core/ceremony"
+	"github.com/idena-network/idena-go/protocol"
+)
+
+// ConfigReloader applies the changed config of the running node
+type ConfigReloader interface {
+	ReloadConfig() ([]string, error)
+}
+
+// Profiler captures CPU profiles and runtime traces of the running node
+type Profiler interface {
+	StartProfile(kind string) (string, error)
+	StopProfile() (string, error)
+}
+
+// AdminApi manages the running node, it's exposed only if the admin module is listed in the RPC config
+type AdminApi struct {
+	reloader ConfigReloader
+	profiler Profiler
+	pm       *protocol.IdenaGossipHandler
+	engine   *consensus.Engine
+	ceremony *ceremony.ValidationCeremony
+}
+
+// NewAdminApi creates a new AdminApi instance
+func NewAdminApi(reloader ConfigReloader, profiler Profiler, pm *protocol.IdenaGossipHandler, engine *consensus.Engine, ceremony *ceremony.ValidationCeremony) *AdminApi {
+	return &AdminApi{reloader, profiler, pm, engine, ceremony}
+}
+
+// ReloadConfig applies log levels, peer limits and mempool size of the config file, it returns the applied sections
+func (api *AdminApi) ReloadConfig() ([]string, error) {
+	return api.reloader.ReloadConfig()
+}
+
+func (api *AdminApi) Peers() []protocol.PeerSnapshot {
+	return api.pm.PeerSnapshots()
+}
+
+// DisconnectPeer accepts a peer multiaddr or id, static and trusted peers stay pinned and are reconnected later
+func (api *AdminApi) DisconnectPeer(url string) error {
+	return api.pm.DisconnectPeer(url)
+}
+
+type AdminStatus struct {
+	Mining     bool `json:"mining"`
+	Validation bool `json:"validation"`
+}
+
+func (api *AdminApi) Status() AdminStatus {
+	return AdminStatus{
+		Mining:     api.engine.MiningEnabled(),
+		Validation: api.ceremony.ParticipationEnabled(),
+	}
+}
+
+// SetMining pauses or resumes proposing blocks and voting without stopping the node
+func (api *AdminApi) SetMining(enabled bool) AdminStatus {
+	api.engine.SetMining(enabled)
+	return api.Status()
+}
+
+// SetValidation pauses or resumes participation of the node identity in validation ceremonies
+func (api *AdminApi) SetValidation(enabled bool) AdminStatus {
+	api.ceremony.SetParticipation(enabled)
+	return api.Status()
+}
+
+// StartProfile starts capturing a cpu profile or a runtime trace, the kind is either cpu or trace,
+// it returns the path of the file in the datadir which is written until StopProfile is called
+func (api *AdminApi) StartProfile(kind string) (string, error) {
+	return api.profiler.StartProfile(kind)
+}
+
+// StopProfile stops the running profile and returns the path of its file
+func (api *AdminApi) StopProfile() (string, error) {
+	return api.profiler.StopProfile()
+}
@@ -13,15 +13,17 @@ import (
 	"github.com/idena-network/idena-go/keystore"
 	"github.com/idena-network/idena-go/log"
 	"github.com/idena-network/idena-go/secstore"
+	"github.com/idena-network/idena-go/txtracker"
 	"github.com/shopspring/decimal"
 )
 
 type BaseApi struct {
-	engine   *consensus.Engine
-	txpool   *mempool.TxPool
-	ks       *keystore.KeyStore
-	secStore *secstore.SecStore
-	ipfs     ipfs.Proxy
+	engine    *consensus.Engine
+	txpool    *mempool.TxPool
+	ks        *keystore.KeyStore
+	secStore  *secstore.SecStore
+	ipfs      ipfs.Proxy
+	txTracker *txtracker.Tracker
 }
 
 type BaseTxArgs struct {
@@ -29,8 +31,8 @@ type BaseTxArgs struct {
 	Epoch uint16 `json:"epoch"`
 }
 
-func NewBaseApi(engine *consensus.Engine, txpool *mempool.TxPool, ks *keystore.KeyStore, secStore *secstore.SecStore, ipfs ipfs.Proxy) *BaseApi {
-	return &BaseApi{engine, txpool, ks, secStore, ipfs}
+func NewBaseApi(engine *consensus.Engine, txpool *mempool.TxPool, ks *keystore.KeyStore, secStore *secstore.SecStore, ipfs ipfs.Proxy, txTracker *txtracker.Tracker) *BaseApi {
+	return &BaseApi{engine, txpool, ks, secStore, ipfs, txTracker}
 }
 
 func (api *BaseApi) getReadonlyAppState() *appstate.AppState {
@@ -57,6 +59,13 @@ func (api *BaseApi) getTx(from common.Address, to *common.Address, txType types.
 	maxFee decimal.Decimal, tips decimal.Decimal, nonce uint32, epoch uint16, payload []byte) *types.Transaction {
 
 	state := api.getReadonlyAppState()
+	if nonce == 0 && api.txTracker != nil {
+		// nonces of own txs which are being resubmitted are taken even if the txs were dropped from the mempool
+		if epoch == 0 {
+			epoch = state.State.Epoch()
+		}
+		nonce = api.txTracker.NextNonce(from, epoch)
+	}
 	return blockchain.BuildTxWithFeeEstimating(state, from, to, txType, amount, maxFee, tips, nonce, epoch, payload)
 }
 
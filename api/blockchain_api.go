@@ -117,6 +117,9 @@ type Transaction struct {
 	BlockHash common.Hash     `json:"blockHash"`
 	UsedFee   decimal.Decimal `json:"usedFee"`
 	Timestamp int64           `json:"timestamp"`
+	// BlockHeight and Index locate the transaction in the chain, they are set by bcn_transaction for included txs
+	BlockHeight uint64  `json:"blockHeight,omitempty"`
+	Index       *uint32 `json:"index,omitempty"`
 }
 
 type BurntCoins struct {
@@ -166,15 +169,22 @@ func (api *BlockchainApi) Transaction(hash common.Hash) *Transaction {
 	var blockHash common.Hash
 	var feePerGas *big.Int
 	var timestamp int64
+	var block *types.Block
 	if idx != nil {
 		blockHash = idx.BlockHash
-		block := api.bc.GetBlock(blockHash)
+		block = api.bc.GetBlock(blockHash)
 		if block != nil {
 			feePerGas = block.Header.FeePerGas()
 			timestamp = block.Header.Time()
 		}
 	}
-	return convertToTransaction(tx, blockHash, feePerGas, timestamp)
+	result := convertToTransaction(tx, blockHash, feePerGas, timestamp)
+	if block != nil {
+		index := idx.Idx
+		result.BlockHeight = block.Height()
+		result.Index = &index
+	}
+	return result
 }
 
 func (api *BlockchainApi) TxReceipt(hash common.Hash) *TxReceipt {
@@ -204,7 +214,9 @@ func (api *BlockchainApi) TxReceipt(hash common.Hash) *TxReceipt {
 	}
 
 	receipt := api.bc.GetReceipt(hash)
-
+	if receipt == nil {
+		return nil
+	}
 	return convertReceipt(tx, receipt, feePerGas)
 }
 
@@ -220,12 +232,21 @@ func (api *BlockchainApi) Mempool() []common.Hash {
 }
 
 type Syncing struct {
-	Syncing      bool   `json:"syncing"`
-	CurrentBlock uint64 `json:"currentBlock"`
-	HighestBlock uint64 `json:"highestBlock"`
-	WrongTime    bool   `json:"wrongTime"`
-	GenesisBlock uint64 `json:"genesisBlock"`
-	Message      string `json:"message"`
+	Syncing      bool       `json:"syncing"`
+	CurrentBlock uint64     `json:"currentBlock"`
+	HighestBlock uint64     `json:"highestBlock"`
+	WrongTime    bool       `json:"wrongTime"`
+	GenesisBlock uint64     `json:"genesisBlock"`
+	Message      string     `json:"message"`
+	Retry        *SyncRetry `json:"retry,omitempty"`
+}
+
+// SyncRetry describes retries of the latest batch of blocks which failed to load
+type SyncRetry struct {
+	From    uint64 `json:"from"`
+	To      uint64 `json:"to"`
+	Retries int    `json:"retries"`
+	DelayMs int64  `json:"delayMs"`
 }
 
 func (api *BlockchainApi) Syncing() Syncing {
@@ -238,7 +259,7 @@ func (api *BlockchainApi) Syncing() Syncing {
 	if !isSyncing {
 		highest = current
 	}
-	return Syncing{
+	result := Syncing{
 		Syncing:      isSyncing,
 		GenesisBlock: api.bc.GenesisInfo().Genesis.Height(),
 		CurrentBlock: current,
@@ -246,6 +267,15 @@ func (api *BlockchainApi) Syncing() Syncing {
 		WrongTime:    api.pm.WrongTime(),
 		Message:      api.nodeState.Info(),
 	}
+	if status := api.pm.SyncStatus(); status.Retries > 0 {
+		result.Retry = &SyncRetry{
+			From:    status.From,
+			To:      status.To,
+			Retries: status.Retries,
+			DelayMs: status.Delay.Milliseconds(),
+		}
+	}
+	return result
 }
 
 type TransactionsArgs struct {
@@ -289,6 +319,33 @@ func (api *BlockchainApi) PendingTransactions(args TransactionsArgs) Transaction
 	}
 }
 
+type OwnTransaction struct {
+	Transaction *Transaction `json:"transaction"`
+	// Status is one of Pending, Stuck, Mined, Confirmed and Failed
+	Status        string `json:"status"`
+	AddedHeight   uint64 `json:"addedHeight"`
+	BlockHeight   uint64 `json:"blockHeight,omitempty"`
+	Resubmissions int    `json:"resubmissions"`
+	Error         string `json:"error,omitempty"`
+}
+
+// OwnTransactions returns transactions submitted by the node which are tracked until confirmation, a transaction
+// which isn't mined for a while is reported as stuck
+func (api *BlockchainApi) OwnTransactions() []*OwnTransaction {
+	var list []*OwnTransaction
+	for _, tracked := range api.baseApi.txTracker.Txs() {
+		list = append(list, &OwnTransaction{
+			Transaction:   convertToTransaction(tracked.Tx, tracked.BlockHash, nil, 0),
+			Status:        string(tracked.Status),
+			AddedHeight:   tracked.AddedHeight,
+			BlockHeight:   tracked.BlockHeight,
+			Resubmissions: tracked.Resubmissions,
+			Error:         tracked.Error,
+		})
+	}
+	return list
+}
+
 func (api *BlockchainApi) FeePerGas() *big.Int {
 	return api.baseApi.getReadonlyAppState().State.FeePerGas()
 }
@@ -313,7 +370,12 @@ func (api *BlockchainApi) GetRawTx(args SendTxArgs) (hexutil.Bytes, error) {
 		payload = *args.Payload
 	}
 
+	if args.Network != 0 && !args.UseProto {
+		return nil, errors.New("network requires proto encoding")
+	}
+
 	tx := api.baseApi.getTx(args.From, args.To, args.Type, args.Amount, args.MaxFee, args.Tips, args.Nonce, args.Epoch, payload)
+	tx.Network = args.Network
 
 	var data []byte
 	var err error
@@ -419,6 +481,62 @@ func (api *BlockchainApi) Transactions(args TransactionsArgs) Transactions {
 	}
 }
 
+// TxsByAddress returns transactions sent or received by any address, sorted by timestamp desc, it requires
+// the addresses index
+func (api *BlockchainApi) TxsByAddress(args TransactionsArgs) (Transactions, error) {
+	if !api.bc.Config().Blockchain.IndexAddresses {
+		return Transactions{}, errors.New("addresses index is disabled, run the node with --indexaddresses")
+	}
+	return api.Transactions(args), nil
+}
+
+type BalanceHistoryArgs struct {
+	Address common.Address `json:"address"`
+	Count   int            `json:"count"`
+	Token   hexutil.Bytes  `json:"token"`
+}
+
+type BalanceRecord struct {
+	Epoch   uint16          `json:"epoch"`
+	Height  uint64          `json:"height"`
+	Balance decimal.Decimal `json:"balance"`
+	Change  decimal.Decimal `json:"change"`
+}
+
+type BalanceHistory struct {
+	Records []*BalanceRecord `json:"records"`
+	Token   *hexutil.Bytes   `json:"token"`
+}
+
+// BalanceHistory returns balances of the address per epoch starting from the latest one, it requires the addresses index
+func (api *BlockchainApi) BalanceHistory(args BalanceHistoryArgs) (BalanceHistory, error) {
+	if !api.bc.Config().Blockchain.IndexAddresses {
+		return BalanceHistory{}, errors.New("addresses index is disabled, run the node with --indexaddresses")
+	}
+	records, nextToken := api.bc.ReadBalanceHistory(args.Address, args.Count, args.Token)
+
+	var list []*BalanceRecord
+	for _, item := range records {
+		list = append(list, &BalanceRecord{
+			Epoch:   item.Epoch,
+			Height:  item.Height,
+			Balance: blockchain.ConvertToFloat(item.Balance),
+			Change:  blockchain.ConvertToFloat(item.Change),
+		})
+	}
+
+	var token *hexutil.Bytes
+	if nextToken != nil {
+		b := hexutil.Bytes(nextToken)
+		token = &b
+	}
+
+	return BalanceHistory{
+		Records: list,
+		Token:   token,
+	}, nil
+}
+
 func (api *BlockchainApi) BurntCoins() []BurntCoins {
 
 	appState := api.baseApi.getReadonlyAppState()
@@ -597,6 +715,28 @@ func convertToBlock(block *types.Block) *Block {
 	}
 }
 
+type StateProof struct {
+	Height   uint64        `json:"height"`
+	Root     common.Hash   `json:"root"`
+	Account  hexutil.Bytes `json:"account"`
+	Identity hexutil.Bytes `json:"identity"`
+}
+
+// GetProof returns Merkle proofs of the account and the identity of the address, they are verified against the state root
+// of the block at the returned height, a proof is empty if the address has no account or identity
+func (api *BlockchainApi) GetProof(address common.Address) (*StateProof, error) {
+	proof, err := api.bc.GetStateProof(address)
+	if err != nil {
+		return nil, err
+	}
+	return &StateProof{
+		Height:   proof.Height,
+		Root:     proof.Root,
+		Account:  proof.Account,
+		Identity: proof.Identity,
+	}, nil
+}
+
 func (api *BlockchainApi) KeyWord(index int) (keywords.Keyword, error) {
 	return keywords.Get(index)
 }
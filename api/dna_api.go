@@ -15,6 +15,7 @@ import (
 	"github.com/idena-network/idena-go/common/hexutil"
 	"github.com/idena-network/idena-go/core/appstate"
 	"github.com/idena-network/idena-go/core/ceremony"
+	"github.com/idena-network/idena-go/core/identity"
 	"github.com/idena-network/idena-go/core/profile"
 	"github.com/idena-network/idena-go/core/state"
 	"github.com/idena-network/idena-go/crypto"
@@ -25,16 +26,17 @@ import (
 )
 
 type DnaApi struct {
-	bc             *blockchain.Blockchain
-	baseApi        *BaseApi
-	ceremony       *ceremony.ValidationCeremony
-	appVersion     string
-	profileManager *profile.Manager
+	bc              *blockchain.Blockchain
+	baseApi         *BaseApi
+	ceremony        *ceremony.ValidationCeremony
+	appVersion      string
+	profileManager  *profile.Manager
+	identityTracker *identity.Tracker
 }
 
 func NewDnaApi(baseApi *BaseApi, bc *blockchain.Blockchain, ceremony *ceremony.ValidationCeremony, appVersion string,
-	profileManager *profile.Manager) *DnaApi {
-	return &DnaApi{bc, baseApi, ceremony, appVersion, profileManager}
+	profileManager *profile.Manager, identityTracker *identity.Tracker) *DnaApi {
+	return &DnaApi{bc, baseApi, ceremony, appVersion, profileManager, identityTracker}
 }
 
 type State struct {
@@ -88,6 +90,7 @@ type SendTxArgs struct {
 	Payload  *hexutil.Bytes  `json:"payload"`
 	Tips     decimal.Decimal `json:"tips"`
 	UseProto bool            `json:"useProto"`
+	Network  types.Network   `json:"network"`
 	BaseTxArgs
 }
 
@@ -320,6 +323,16 @@ type Identity struct {
 	ShardId             uint32          `json:"shardId"`
 	PenaltySeconds      uint16          `json:"penaltySeconds"`
 	DiscriminationFlags []string        `json:"discriminationFlags"`
+	// StateTransitions are the latest state changes of the node identity tracked since the node start
+	StateTransitions []IdentityTransition `json:"stateTransitions,omitempty"`
+}
+
+type IdentityTransition struct {
+	From   string `json:"from"`
+	To     string `json:"to"`
+	Height uint64 `json:"height"`
+	Epoch  uint16 `json:"epoch"`
+	Time   int64  `json:"timestamp"`
 }
 
 type Flip struct {
@@ -365,31 +378,46 @@ func (api *DnaApi) Identity(address *common.Address) Identity {
 	}
 
 	appState := api.baseApi.getReadonlyAppState()
-	return convertIdentity(appState.State.Epoch(), *address, appState.State.GetIdentity(*address), flipKeyWordPairs, appState)
+	result := convertIdentity(appState.State.Epoch(), *address, appState.State.GetIdentity(*address), flipKeyWordPairs, appState)
+	if api.identityTracker != nil && *address == api.identityTracker.Address() {
+		for _, item := range api.identityTracker.Transitions() {
+			result.StateTransitions = append(result.StateTransitions, IdentityTransition{
+				From:   identityStateName(item.From),
+				To:     identityStateName(item.To),
+				Height: item.Height,
+				Epoch:  item.Epoch,
+				Time:   item.Time,
+			})
+		}
+	}
+	return result
 }
 
-func convertIdentity(currentEpoch uint16, address common.Address, data state.Identity, flipKeyWordPairs []int, appState *appstate.AppState) Identity {
-	var s string
-	switch data.State {
+func identityStateName(identityState state.IdentityState) string {
+	switch identityState {
 	case state.Invite:
-		s = "Invite"
+		return "Invite"
 	case state.Candidate:
-		s = "Candidate"
+		return "Candidate"
 	case state.Newbie:
-		s = "Newbie"
+		return "Newbie"
 	case state.Verified:
-		s = "Verified"
+		return "Verified"
 	case state.Suspended:
-		s = "Suspended"
+		return "Suspended"
 	case state.Zombie:
-		s = "Zombie"
+		return "Zombie"
 	case state.Killed:
-		s = "Killed"
+		return "Killed"
 	case state.Human:
-		s = "Human"
+		return "Human"
 	default:
-		s = "Undefined"
+		return "Undefined"
 	}
+}
+
+func convertIdentity(currentEpoch uint16, address common.Address, data state.Identity, flipKeyWordPairs []int, appState *appstate.AppState) Identity {
+	s := identityStateName(data.State)
 
 	var flags []string
 	if data.LastValidationStatus.HasFlag(state.AllFlipsNotQualified) {
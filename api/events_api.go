@@ -0,0 +1,101 @@
+package api
+
+import (
+	"context"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/eventbus"
+	"github.com/idena-network/idena-go/events"
+	"github.com/idena-network/idena-go/rpc"
+)
+
+// notificationsBufferSize is the number of events buffered per subscription, events are dropped for slow subscribers
+const notificationsBufferSize = 100
+
+// EventsApi offers subscriptions to node events, available over WebSocket only
+type EventsApi struct {
+	bus eventbus.Bus
+}
+
+// NewEventsApi creates a new EventsApi instance
+func NewEventsApi(bus eventbus.Bus) *EventsApi {
+	return &EventsApi{bus}
+}
+
+type Vote struct {
+	Hash        common.Hash    `json:"hash"`
+	Voter       common.Address `json:"voter"`
+	Round       uint64         `json:"round"`
+	Step        uint8          `json:"step"`
+	ParentHash  common.Hash    `json:"parentHash"`
+	VotedHash   common.Hash    `json:"votedHash"`
+	TurnOffline bool           `json:"turnOffline"`
+	Upgrade     uint32         `json:"upgrade"`
+}
+
+// NewBlock sends a notification for each block added to the chain
+func (api *EventsApi) NewBlock(ctx context.Context) (*rpc.Subscription, error) {
+	return api.subscribe(ctx, events.AddBlockEventID, func(e eventbus.Event) interface{} {
+		return convertToBlock(e.(*events.NewBlockEvent).Block)
+	})
+}
+
+// NewTx sends a notification for each transaction added to the mempool
+func (api *EventsApi) NewTx(ctx context.Context) (*rpc.Subscription, error) {
+	return api.subscribe(ctx, events.NewTxEventID, func(e eventbus.Event) interface{} {
+		return convertToTransaction(e.(*events.NewTxEvent).Tx, common.Hash{}, nil, 0)
+	})
+}
+
+// NewVote sends a notification for each accepted vote
+func (api *EventsApi) NewVote(ctx context.Context) (*rpc.Subscription, error) {
+	return api.subscribe(ctx, events.NewVoteEventID, func(e eventbus.Event) interface{} {
+		return convertToVote(e.(*events.NewVoteEvent).Vote)
+	})
+}
+
+func (api *EventsApi) subscribe(ctx context.Context, eventID eventbus.EventID, convert func(e eventbus.Event) interface{}) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return nil, rpc.ErrNotificationsUnsupported
+	}
+	rpcSub := notifier.CreateSubscription()
+
+	// bus handlers are called synchronously by publishers so they must never block
+	queue := make(chan eventbus.Event, notificationsBufferSize)
+	busSub := api.bus.Subscribe(eventID, func(e eventbus.Event) {
+		select {
+		case queue <- e:
+		default:
+		}
+	})
+
+	go func() {
+		defer api.bus.Unsubscribe(busSub)
+		for {
+			select {
+			case e := <-queue:
+				notifier.Notify(rpcSub.ID, convert(e))
+			case <-rpcSub.Err():
+				return
+			case <-notifier.Closed():
+				return
+			}
+		}
+	}()
+
+	return rpcSub, nil
+}
+
+func convertToVote(vote *types.Vote) *Vote {
+	return &Vote{
+		Hash:        vote.Hash(),
+		Voter:       vote.VoterAddr(),
+		Round:       vote.Header.Round,
+		Step:        vote.Header.Step,
+		ParentHash:  vote.Header.ParentHash,
+		VotedHash:   vote.Header.VotedHash,
+		TurnOffline: vote.Header.TurnOffline,
+		Upgrade:     vote.Header.Upgrade,
+	}
+}
@@ -23,6 +23,7 @@ func (api *NetApi) PeersCount() int {
 type Peer struct {
 	ID         string `json:"id"`
 	RemoteAddr string `json:"addr"`
+	LatencyMs  int64  `json:"latency"`
 }
 
 func (api *NetApi) Peers() []Peer {
@@ -31,11 +32,22 @@ func (api *NetApi) Peers() []Peer {
 		peers = append(peers, Peer{
 			ID:         p.ID(),
 			RemoteAddr: p.RemoteAddr(),
+			LatencyMs:  p.LatencyMs(),
 		})
 	}
 	return peers
 }
 
+// PeerSnapshots returns detailed state of connected peers
+func (api *NetApi) PeerSnapshots() []protocol.PeerSnapshot {
+	return api.pm.PeerSnapshots()
+}
+
+// NodeInfo returns identity and protocol details of the node
+func (api *NetApi) NodeInfo() protocol.NodeInfo {
+	return api.pm.NodeInfo()
+}
+
 func (api *NetApi) IpfsAddress() string {
 	return api.pm.Endpoint()
 }
@@ -43,3 +55,18 @@ func (api *NetApi) IpfsAddress() string {
 func (api *NetApi) AddPeer(url string) error {
 	return api.pm.AddPeer(url)
 }
+
+// AddStaticPeer connects to the peer and keeps the connection to it until the peer is removed
+func (api *NetApi) AddStaticPeer(url string) error {
+	return api.pm.AddStaticPeer(url)
+}
+
+// AddTrustedPeer accepts a peer multiaddr or id, trusted peers bypass peer limits and are never banned
+func (api *NetApi) AddTrustedPeer(url string) error {
+	return api.pm.AddTrustedPeer(url)
+}
+
+// RemovePeer unpins a static or trusted peer and disconnects it
+func (api *NetApi) RemovePeer(url string) error {
+	return api.pm.RemovePeer(url)
+}
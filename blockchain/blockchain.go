@@ -36,6 +36,7 @@ import (
 	"github.com/idena-network/idena-go/vm"
 	cid2 "github.com/ipfs/go-cid"
 	"github.com/pkg/errors"
+	"github.com/rcrowley/go-metrics"
 	"github.com/shopspring/decimal"
 	dbm "github.com/tendermint/tm-db"
 	math2 "math"
@@ -65,6 +66,8 @@ var (
 	BlockInsertionErr   = errors.New("can't insert block")
 )
 
+var blockInsertTimer = metrics.NewRegisteredTimer("chain.insert", nil)
+
 type Blockchain struct {
 	repo            *database.Repo
 	secStore        *secstore.SecStore
@@ -89,6 +92,7 @@ type Blockchain struct {
 	isSyncing       bool
 	ipfsLoadQueue   chan *attachments.StoreToIpfsAttachment
 	middlewares     []Middleware
+	epochIdentities *epochIdentities
 }
 
 type txsExecutionContext struct {
@@ -132,10 +136,11 @@ type Middleware = func(block *types.Block, appState *appstate.AppState)
 
 func NewBlockchain(config *config.Config, db dbm.DB, txpool *mempool.TxPool, appState *appstate.AppState,
 	ipfs ipfs.Proxy, secStore *secstore.SecStore, bus eventbus.Bus, offlineDetector *OfflineDetector, keyStore *keystore.KeyStore, subManager *subscriptions.Manager, upgrader *upgrade.Upgrader) *Blockchain {
+	repo := database.NewRepo(db)
 	return &Blockchain{
-		repo:            database.NewRepo(db),
+		repo:            repo,
 		config:          config,
-		log:             log.New(),
+		log:             log.New(log.ModuleKey, "chain"),
 		txpool:          txpool,
 		appState:        appState,
 		ipfs:            ipfs,
@@ -144,6 +149,7 @@ func NewBlockchain(config *config.Config, db dbm.DB, txpool *mempool.TxPool, app
 		secStore:        secStore,
 		offlineDetector: offlineDetector,
 		indexer:         newBlockchainIndexer(db, bus, config, keyStore),
+		epochIdentities: newEpochIdentities(repo),
 		subManager:      subManager,
 		upgrader:        upgrader,
 		ipfsLoadQueue:   make(chan *attachments.StoreToIpfsAttachment, 100),
@@ -168,6 +174,11 @@ func (chain *Blockchain) Config() *config.Config {
 	return chain.config
 }
 
+// GetStateProof returns Merkle proofs of the address state at the head block
+func (chain *Blockchain) GetStateProof(addr common.Address) (*state.StateProof, error) {
+	return chain.appState.State.GetProof(addr)
+}
+
 func (chain *Blockchain) Indexer() *indexer {
 	return chain.indexer
 }
@@ -431,6 +442,7 @@ func (chain *Blockchain) AddBlock(block *types.Block, checkState *appstate.AppSt
 	if err := validateBlockParentHash(block.Header, chain.Head); err != nil {
 		return err
 	}
+	defer blockInsertTimer.UpdateSince(time.Now())
 	statsCollector.EnableCollecting()
 	defer statsCollector.CompleteCollecting()
 	if blockInsertionResult, err := chain.ValidateBlock(block, checkState, statsCollector); err != nil {
@@ -457,6 +469,7 @@ func (chain *Blockchain) AddBlock(block *types.Block, checkState *appstate.AppSt
 		if err := chain.insertBlock(block, blockInsertionResult.identityStateDiff, blockInsertionResult.txReceipts); err != nil {
 			return err
 		}
+		chain.indexer.HandleBlockState(block.Header, blockInsertionResult.stateDiff, chain.appState.State)
 
 		for _, task := range blockInsertionResult.txTasks {
 			task()
@@ -478,6 +491,7 @@ func (chain *Blockchain) AddBlock(block *types.Block, checkState *appstate.AppSt
 		if block.Header.Flags().HasFlag(types.ValidationFinished) {
 			shardId, _ := chain.CoinbaseShard()
 			log.Info("Coinbase shard", "shardId", shardId)
+			chain.epochIdentities.get(chain.appState)
 		}
 		chain.RemovePreliminaryHead(nil)
 		return nil
@@ -1966,6 +1980,26 @@ func getSeedData(prevBlock *types.Header) []byte {
 	return result
 }
 
+// VerifySeed checks that the seed of the proposed block is the VRF output of its proposer for the previous seed
+func VerifySeed(prevBlock *types.Header, header *types.Header) error {
+	pubKey, err := crypto.UnmarshalPubkey(header.ProposedHeader.ProposerPubKey)
+	if err != nil {
+		return err
+	}
+	verifier, err := p256.NewVRFVerifier(pubKey)
+	if err != nil {
+		return err
+	}
+	hash, err := verifier.ProofToHash(getSeedData(prevBlock), header.ProposedHeader.SeedProof)
+	if err != nil {
+		return err
+	}
+	if hash != header.Seed() {
+		return errors.New("seed is invalid")
+	}
+	return nil
+}
+
 func (chain *Blockchain) GetProposerSortition() (bool, []byte) {
 
 	if checkIfProposer(chain.coinBaseAddress, chain.appState) {
@@ -2209,10 +2243,10 @@ func (chain *Blockchain) filterTxs(appState *appstate.AppState, txs []*types.Tra
 	return result, totalFee, totalTips, receipts, usedGas
 }
 
-func (chain *Blockchain) insertHeader(header *types.Header) {
-	chain.repo.WriteBlockHeader(header)
-	chain.repo.WriteHead(nil, header)
-	chain.repo.WriteCanonicalHash(header.Height(), header.Hash())
+func (chain *Blockchain) insertHeader(header *types.Header, batch dbm.Batch) {
+	chain.repo.WriteBlockHeader(batch, header)
+	chain.repo.WriteHead(batch, header)
+	chain.repo.WriteCanonicalHash(batch, header.Height(), header.Hash())
 }
 
 func (chain *Blockchain) insertBlock(block *types.Block, diff *state.IdentityStateDiff, receipts types.TxReceipts) error {
@@ -2228,28 +2262,28 @@ func (chain *Blockchain) insertBlock(block *types.Block, diff *state.IdentitySta
 		}
 	}
 
-	chain.insertHeader(block.Header)
-	chain.WriteIdentityStateDiff(block.Height(), diff)
-	chain.WriteTxIndex(block.Hash(), block.Body.Transactions)
+	// the block and its indexes are written at once, so a crash doesn't leave the head without its data
+	batch := chain.repo.NewBatch()
+	defer batch.Close()
+	chain.insertHeader(block.Header, batch)
+	chain.WriteIdentityStateDiff(block.Height(), diff, batch)
+	chain.WriteTxIndex(block.Hash(), block.Body.Transactions, batch)
 	if receipts != nil {
-		chain.WriteTxReceipts(block.Header.ProposedHeader.TxReceiptsCid, receipts)
+		chain.WriteTxReceipts(block.Header.ProposedHeader.TxReceiptsCid, receipts, batch)
+	}
+	if err := batch.WriteSync(); err != nil {
+		return errors.Wrap(BlockInsertionErr, err.Error())
 	}
 	chain.indexer.HandleBlockTransactions(block.Header, block.Body.Transactions)
 	chain.setCurrentHead(block.Header)
 	return nil
 }
 
-func (chain *Blockchain) WriteTxIndex(hash common.Hash, txs types.Transactions) {
-	for i, tx := range txs {
-		idx := &types.TransactionIndex{
-			BlockHash: hash,
-			Idx:       uint32(i),
-		}
-		chain.repo.WriteTxIndex(tx.Hash(), idx)
-	}
+func (chain *Blockchain) WriteTxIndex(hash common.Hash, txs types.Transactions, batch dbm.Batch) {
+	writeTxIndex(chain.repo, batch, hash, txs)
 }
 
-func (chain *Blockchain) WriteTxReceipts(cid []byte, receipts types.TxReceipts) {
+func (chain *Blockchain) WriteTxReceipts(cid []byte, receipts types.TxReceipts, batch dbm.Batch) {
 	m := make(map[common.Address]map[string]struct{})
 	for _, s := range chain.subManager.Subscriptions() {
 		eventMap, ok := m[s.Contract]
@@ -2265,7 +2299,7 @@ func (chain *Blockchain) WriteTxReceipts(cid []byte, receipts types.TxReceipts)
 			Idx:        uint32(i),
 			ReceiptCid: cid,
 		}
-		chain.repo.WriteReceiptIndex(r.TxHash, idx)
+		chain.repo.WriteReceiptIndex(batch, r.TxHash, idx)
 		if eventMap, ok := m[r.ContractAddress]; ok || chain.config.Blockchain.WriteAllEvents {
 			for idx, event := range r.Events {
 				if _, ok := eventMap[event.EventName]; ok || chain.config.Blockchain.WriteAllEvents {
@@ -2273,7 +2307,7 @@ func (chain *Blockchain) WriteTxReceipts(cid []byte, receipts types.TxReceipts)
 					if !event.Contract.IsEmpty() {
 						contract = event.Contract
 					}
-					chain.repo.WriteEvent(contract, r.TxHash, uint32(idx), event)
+					chain.repo.WriteEvent(batch, contract, r.TxHash, uint32(idx), event)
 				}
 			}
 		}
@@ -2499,10 +2533,14 @@ func (chain *Blockchain) ValidateProposerProof(proof []byte, pubKeyData []byte)
 		return err
 	}
 
+	proposerAddr := crypto.PubkeyToAddress(*pubKey)
+	if !chain.mayPropose(proposerAddr) {
+		return errors.New("Proposer is not identity of the epoch")
+	}
+
 	h, err := verifier.ProofToHash(chain.getProposerData(), proof)
 
 	vrfThreshold := new(big.Float).SetFloat64(chain.appState.State.VrfProposerThreshold())
-	proposerAddr := crypto.PubkeyToAddress(*pubKey)
 	modifier := 1
 	if chain.appState.ValidatorsCache.IsPool(proposerAddr) {
 		modifier = chain.appState.ValidatorsCache.PoolSize(proposerAddr)
@@ -2743,7 +2781,7 @@ func (chain *Blockchain) EnsureIntegrity() error {
 		chain.Head.IdentityRoot() != chain.appState.IdentityState.Root() {
 		wasReset = true
 		resetTo := uint64(0)
-		for h, tryCnt := chain.Head.Height()-1, 0; h >= 1 && tryCnt < state.MaxSavedStatesCount+1; h, tryCnt = h-1, tryCnt+1 {
+		for h, tryCnt := chain.Head.Height()-1, 0; h >= 1 && tryCnt < chain.appState.State.SavedStatesCount()+1; h, tryCnt = h-1, tryCnt+1 {
 			if chain.appState.State.HasVersion(h) && chain.appState.IdentityState.HasVersion(h) {
 				resetTo = h
 				break
@@ -2778,8 +2816,8 @@ func checkIfProposer(addr common.Address, appState *appstate.AppState) bool {
 }
 
 func (chain *Blockchain) AddHeaderUnsafe(header *types.Header) error {
-	chain.repo.WriteBlockHeader(header)
-	chain.repo.WriteCanonicalHash(header.Height(), header.Hash())
+	chain.repo.WriteBlockHeader(nil, header)
+	chain.repo.WriteCanonicalHash(nil, header.Height(), header.Hash())
 	chain.repo.WritePreliminaryHead(header)
 	chain.PreliminaryHead = header
 	return nil
@@ -2804,23 +2842,9 @@ func (chain *Blockchain) ValidateHeader(header, prevBlock *types.Header) error {
 		return errors.New("invalid coinbase")
 	}
 
-	var seedData = getSeedData(prevBlock)
-	pubKey, err := crypto.UnmarshalPubkey(header.ProposedHeader.ProposerPubKey)
-	if err != nil {
-		return err
-	}
-	verifier, err := p256.NewVRFVerifier(pubKey)
-	if err != nil {
-		return err
-	}
-
-	hash, err := verifier.ProofToHash(seedData, header.ProposedHeader.SeedProof)
-	if err != nil {
+	if err := VerifySeed(prevBlock, header); err != nil {
 		return err
 	}
-	if hash != header.Seed() {
-		return errors.New("seed is invalid")
-	}
 	if header.Flags().HasFlag(types.NewGenesis) {
 		if header.ProposedHeader.Upgrade != 0 || prevBlock.ProposedHeader == nil ||
 			prevBlock.ProposedHeader.Upgrade == 0 ||
@@ -2871,10 +2895,10 @@ func (chain *Blockchain) ReadPreliminaryHead() *types.Header {
 	return chain.repo.ReadPreliminaryHead()
 }
 
-func (chain *Blockchain) WriteIdentityStateDiff(height uint64, diff *state.IdentityStateDiff) {
+func (chain *Blockchain) WriteIdentityStateDiff(height uint64, diff *state.IdentityStateDiff, batch dbm.Batch) {
 	if !diff.Empty() {
 		b, _ := diff.ToBytes()
-		chain.repo.WriteIdentityStateDiff(height, b)
+		chain.repo.WriteIdentityStateDiff(batch, height, b)
 	}
 }
 
@@ -2894,6 +2918,10 @@ func (chain *Blockchain) ReadTxs(address common.Address, count int, token []byte
 	return chain.repo.GetSavedTxs(address, count, token)
 }
 
+func (chain *Blockchain) ReadBalanceHistory(address common.Address, count int, token []byte) ([]*database.BalanceRecord, []byte) {
+	return chain.repo.GetBalanceHistory(address, count, token)
+}
+
 func (chain *Blockchain) ReadTotalBurntCoins() []*types.BurntCoins {
 	return chain.repo.GetTotalBurntCoins()
 }
@@ -254,6 +254,14 @@ func (chain *TestBlockchain) Bus() eventbus.Bus {
 	return chain.bus
 }
 
+func (chain *TestBlockchain) TxPool() *mempool.TxPool {
+	return chain.txpool
+}
+
+func (chain *TestBlockchain) Db() db.DB {
+	return chain.db
+}
+
 func GetDefaultConsensusConfig() *config.ConsensusConf {
 	base := config.GetDefaultConsensusConfig()
 	res := *base
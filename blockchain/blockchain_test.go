@@ -16,6 +16,7 @@ import (
 	"github.com/idena-network/idena-go/core/state"
 	"github.com/idena-network/idena-go/core/validators"
 	"github.com/idena-network/idena-go/crypto"
+	"github.com/idena-network/idena-go/events"
 	"github.com/idena-network/idena-go/tests"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
@@ -2476,3 +2477,47 @@ func TestBlockchain_applyDiscriminationStatusSwitch(t *testing.T) {
 	require.True(t, appState.ValidatorsCache.IsDiscriminated(delegator2))
 	require.False(t, appState.ValidatorsCache.IsDiscriminated(delegator3))
 }
+
+func TestBlockchain_ResetTo(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	chain, _ := NewCustomTestBlockchain(10, 0, key)
+	defer chain.SecStore().Destroy()
+	chain.GenerateBlocks(5, 1)
+	resetHeader := chain.GetBlockHeaderByHeight(10)
+
+	var resetEvent *events.BlockchainResetEvent
+	chain.bus.Subscribe(events.BlockchainResetEventID, func(e eventbus.Event) {
+		resetEvent = e.(*events.BlockchainResetEvent)
+	})
+
+	revertedTxs, err := chain.ResetTo(10)
+	require.NoError(t, err)
+	require.Len(t, revertedTxs, 5)
+	require.Equal(t, resetHeader.Hash(), chain.Head.Hash())
+	require.Nil(t, chain.GetBlockHeaderByHeight(11))
+
+	require.NotNil(t, resetEvent)
+	require.Equal(t, resetHeader.Hash(), resetEvent.Header.Hash())
+	require.Equal(t, revertedTxs, resetEvent.RevertedTxs)
+}
+
+func TestBlockchain_GetStateProof(t *testing.T) {
+	addr := common.Address{0x1}
+	alloc := map[common.Address]config.GenesisAllocation{
+		addr: {
+			Balance: big.NewInt(100),
+			State:   uint8(state.Verified),
+		},
+	}
+	chain, _, _, _ := NewTestBlockchain(true, alloc)
+	defer chain.SecStore().Destroy()
+
+	proof, err := chain.GetStateProof(addr)
+	require.NoError(t, err)
+	require.Equal(t, chain.Head.Height(), proof.Height)
+	require.Equal(t, chain.Head.Root(), proof.Root)
+	account, identity, err := proof.Verify(addr)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(100), account.Balance)
+	require.Equal(t, state.Verified, identity.State)
+}
@@ -0,0 +1,164 @@
+package blockchain
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/database"
+	"github.com/idena-network/idena-go/ipfs"
+	"github.com/idena-network/idena-go/stats/collector"
+	"github.com/pkg/errors"
+	"io"
+)
+
+// maxExportedRecordSize limits a single encoded block or certificate of the export file
+const maxExportedRecordSize = 64 * 1024 * 1024
+
+// exportedBlock is a record of the chain export file, the block and its certificate are written one after another
+// as length prefixed protobuf messages, an empty certificate means that it isn't stored. The whole stream is gzip compressed
+type exportedBlock struct {
+	Block *types.Block
+	Cert  *types.BlockCert
+}
+
+func writeExportedBlock(w io.Writer, record *exportedBlock) error {
+	block, err := record.Block.ToBytes()
+	if err != nil {
+		return err
+	}
+	var cert []byte
+	if record.Cert != nil {
+		if cert, err = record.Cert.ToBytes(); err != nil {
+			return err
+		}
+	}
+	for _, data := range [][]byte{block, cert} {
+		if err := binary.Write(w, binary.BigEndian, uint32(len(data))); err != nil {
+			return err
+		}
+		if _, err := w.Write(data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readExportedBlock returns io.EOF if the stream ends before the next record
+func readExportedBlock(r io.Reader) (*exportedBlock, error) {
+	var parts [2][]byte
+	for i := range parts {
+		var size uint32
+		if err := binary.Read(r, binary.BigEndian, &size); err != nil {
+			if err == io.EOF && i > 0 {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+		if size > maxExportedRecordSize {
+			return nil, errors.Errorf("record is too big: %d", size)
+		}
+		parts[i] = make([]byte, size)
+		if _, err := io.ReadFull(r, parts[i]); err != nil {
+			return nil, err
+		}
+	}
+	record := &exportedBlock{Block: new(types.Block)}
+	if err := record.Block.FromBytes(parts[0]); err != nil {
+		return nil, err
+	}
+	if len(parts[1]) > 0 {
+		record.Cert = new(types.BlockCert)
+		if err := record.Cert.FromBytes(parts[1]); err != nil {
+			return nil, err
+		}
+	}
+	return record, nil
+}
+
+// ExportBlocks writes canonical blocks from..to with their certificates, bodies are loaded from ipfs
+// and missing ones are fetched from the network
+func ExportBlocks(repo *database.Repo, proxy ipfs.Proxy, w io.Writer, from uint64, to uint64, onBlock func(height uint64)) error {
+	writer := gzip.NewWriter(w)
+	for height := from; height <= to; height++ {
+		hash := repo.ReadCanonicalHash(height)
+		if hash == (common.Hash{}) {
+			return errors.Errorf("block %d is not found", height)
+		}
+		header := repo.ReadBlockHeader(hash)
+		if header == nil {
+			return errors.Errorf("header of block %d is not found", height)
+		}
+		body := &types.Body{}
+		if header.ProposedHeader != nil && !bytes.Equal(header.ProposedHeader.IpfsHash, ipfs.EmptyCid.Bytes()) {
+			data, err := proxy.Get(header.ProposedHeader.IpfsHash, ipfs.Block)
+			if err != nil {
+				return errors.Wrapf(err, "cannot load body of block %d", height)
+			}
+			body.FromBytes(data)
+		}
+		record := &exportedBlock{
+			Block: &types.Block{Header: header, Body: body},
+			Cert:  repo.ReadCertificate(hash),
+		}
+		if err := writeExportedBlock(writer, record); err != nil {
+			return errors.Wrapf(err, "cannot write block %d", height)
+		}
+		if onBlock != nil {
+			onBlock(height)
+		}
+	}
+	return writer.Close()
+}
+
+// ImportBlocks inserts exported blocks on top of the chain head, every block passes the validation of blocks received
+// from peers, so headers, certificates and state roots are checked against the local state. Blocks which the chain
+// already has are skipped if they match the local ones. It returns the number of inserted blocks
+func (chain *Blockchain) ImportBlocks(r io.Reader, onBlock func(height uint64)) (int, error) {
+	reader, err := gzip.NewReader(r)
+	if err != nil {
+		return 0, err
+	}
+	defer reader.Close()
+	stream := bufio.NewReader(reader)
+	imported := 0
+	for {
+		record, err := readExportedBlock(stream)
+		if err == io.EOF {
+			return imported, nil
+		} else if err != nil {
+			return imported, errors.Wrap(err, "cannot read block")
+		}
+		block := record.Block
+		if block.Header == nil {
+			return imported, errors.New("block record has no header")
+		}
+		if block.Body == nil {
+			block.Body = &types.Body{}
+		}
+		height := block.Height()
+		if height <= chain.Head.Height() {
+			if chain.repo.ReadCanonicalHash(height) != block.Hash() {
+				return imported, errors.Errorf("block %d differs from the local one", height)
+			}
+			continue
+		}
+		if record.Cert != nil {
+			if err := chain.ValidateBlockCertOnHead(block.Header, record.Cert); err != nil {
+				return imported, errors.Wrapf(err, "invalid certificate of block %d", height)
+			}
+		}
+		if err := chain.AddBlock(block, nil, collector.NewStatsCollector()); err != nil {
+			return imported, errors.Wrapf(err, "cannot import block %d", height)
+		}
+		if record.Cert != nil {
+			chain.WriteCertificate(block.Hash(), record.Cert, true)
+		}
+		imported++
+		if onBlock != nil {
+			onBlock(height)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package blockchain
+
+import (
+	"bytes"
+	"github.com/idena-network/idena-go/crypto"
+	"github.com/idena-network/idena-go/database"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestExportImportBlocks(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	chain, _ := NewCustomTestBlockchain(0, 0, key)
+	defer chain.SecStore().Destroy()
+	target, _ := chain.Copy()
+	chain.GenerateBlocks(3, 1)
+	chain.GenerateEmptyBlocks(1)
+
+	data := new(bytes.Buffer)
+	var exported []uint64
+	require.NoError(t, ExportBlocks(database.NewRepo(chain.Db()), chain.ipfs, data, 1, chain.Head.Height(), func(height uint64) {
+		exported = append(exported, height)
+	}))
+	require.Len(t, exported, int(chain.Head.Height()))
+
+	imported, err := target.ImportBlocks(bytes.NewReader(data.Bytes()), nil)
+	require.NoError(t, err)
+	require.Equal(t, 4, imported)
+	require.Equal(t, chain.Head.Hash(), target.Head.Hash())
+	require.Equal(t, chain.Head.Root(), target.Head.Root())
+	block := target.GetBlockByHeight(chain.Head.Height() - 1)
+	require.NotNil(t, block)
+	require.Len(t, block.Body.Transactions, 1)
+	require.NotNil(t, target.GetCertificate(chain.Head.Hash()))
+
+	// blocks the chain has are skipped
+	imported, err = target.ImportBlocks(bytes.NewReader(data.Bytes()), nil)
+	require.NoError(t, err)
+	require.Zero(t, imported)
+
+	// a block which differs from the local one stops the import
+	other, _ := NewCustomTestBlockchain(0, 0, key)
+	other.GenerateEmptyBlocks(1)
+	data.Reset()
+	require.NoError(t, ExportBlocks(database.NewRepo(other.Db()), other.ipfs, data, 1, other.Head.Height(), nil))
+	_, err = target.ImportBlocks(bytes.NewReader(data.Bytes()), nil)
+	require.Error(t, err)
+}
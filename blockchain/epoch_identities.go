@@ -0,0 +1,162 @@
+package blockchain
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/core/appstate"
+	"github.com/idena-network/idena-go/core/state"
+	"github.com/idena-network/idena-go/database"
+	"github.com/idena-network/idena-go/log"
+	"github.com/pkg/errors"
+	"sort"
+	"sync"
+)
+
+// keptEpochIdentitySets is the number of latest epochs whose identity sets are kept in the db
+const keptEpochIdentitySets = 3
+
+// EpochIdentitySet is the set of identities validated at the start of the epoch along with pools they delegated to.
+// Identities don't become validated within an epoch, so the set includes every identity which may propose a block
+// until the next validation, only pools created later in the epoch are missing
+type EpochIdentitySet struct {
+	Epoch uint16
+	// EpochBlock is the height of the block the epoch started with, BlockHash is its hash
+	EpochBlock uint64
+	BlockHash  common.Hash
+	identities map[common.Address]struct{}
+}
+
+func (s *EpochIdentitySet) Contains(addr common.Address) bool {
+	_, ok := s.identities[addr]
+	return ok
+}
+
+func (s *EpochIdentitySet) Size() int {
+	return len(s.identities)
+}
+
+func (s *EpochIdentitySet) matches(epoch uint16, epochBlock uint64, hash common.Hash) bool {
+	return s.Epoch == epoch && s.EpochBlock == epochBlock && s.BlockHash == hash
+}
+
+// ToBytes encodes the epoch block and sorted addresses of the set, so the same set is always stored the same way
+func (s *EpochIdentitySet) ToBytes() []byte {
+	addresses := make([]common.Address, 0, len(s.identities))
+	for addr := range s.identities {
+		addresses = append(addresses, addr)
+	}
+	sort.Slice(addresses, func(i, j int) bool {
+		return bytes.Compare(addresses[i][:], addresses[j][:]) < 0
+	})
+	data := make([]byte, 8, 8+common.HashLength+len(addresses)*common.AddressLength)
+	binary.BigEndian.PutUint64(data, s.EpochBlock)
+	data = append(data, s.BlockHash[:]...)
+	for _, addr := range addresses {
+		data = append(data, addr[:]...)
+	}
+	return data
+}
+
+func (s *EpochIdentitySet) FromBytes(epoch uint16, data []byte) error {
+	if len(data) < 8+common.HashLength || (len(data)-8-common.HashLength)%common.AddressLength != 0 {
+		return errors.Errorf("invalid identity set length: %v", len(data))
+	}
+	s.Epoch = epoch
+	s.EpochBlock = binary.BigEndian.Uint64(data[:8])
+	s.BlockHash = common.BytesToHash(data[8 : 8+common.HashLength])
+	data = data[8+common.HashLength:]
+	s.identities = make(map[common.Address]struct{}, len(data)/common.AddressLength)
+	for i := 0; i < len(data); i += common.AddressLength {
+		s.identities[common.BytesToAddress(data[i:i+common.AddressLength])] = struct{}{}
+	}
+	return nil
+}
+
+// epochIdentities keeps the identity set of the current epoch, the set is materialized from the identity state
+// once per epoch and stored, so it stays the same after restarts while identities are killed within the epoch
+type epochIdentities struct {
+	repo    *database.Repo
+	current *EpochIdentitySet
+	log     log.Logger
+	mutex   sync.Mutex
+}
+
+func newEpochIdentities(repo *database.Repo) *epochIdentities {
+	return &epochIdentities{
+		repo: repo,
+		log:  log.New(log.ModuleKey, "epochids"),
+	}
+}
+
+// get returns the set of the epoch of the state, the stored set is used if it belongs to the same epoch block,
+// otherwise the set is materialized from the state, e.g. at the start of the epoch or after a fork of the epoch block
+func (e *epochIdentities) get(appState *appstate.AppState) *EpochIdentitySet {
+	epoch := appState.State.Epoch()
+	epochBlock := appState.State.EpochBlock()
+	hash := e.repo.ReadCanonicalHash(epochBlock)
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	if e.current != nil && e.current.matches(epoch, epochBlock, hash) {
+		return e.current
+	}
+	if data := e.repo.ReadEpochIdentities(epoch); data != nil {
+		set := new(EpochIdentitySet)
+		if err := set.FromBytes(epoch, data); err != nil {
+			e.log.Warn("Stored identity set is invalid", "epoch", epoch, "err", err)
+		} else if set.matches(epoch, epochBlock, hash) {
+			e.current = set
+			return set
+		}
+	}
+	set := materializeEpochIdentities(appState.IdentityState, epoch, epochBlock, hash)
+	e.repo.WriteEpochIdentities(epoch, set.ToBytes())
+	if epoch >= keptEpochIdentitySets {
+		e.repo.DeleteEpochIdentities(epoch - keptEpochIdentitySets)
+	}
+	e.current = set
+	e.log.Info("Epoch identity set materialized", "epoch", epoch, "epochBlock", epochBlock, "size", set.Size())
+	return set
+}
+
+func materializeEpochIdentities(identityState *state.IdentityStateDB, epoch uint16, epochBlock uint64, hash common.Hash) *EpochIdentitySet {
+	set := &EpochIdentitySet{
+		Epoch:      epoch,
+		EpochBlock: epochBlock,
+		BlockHash:  hash,
+		identities: make(map[common.Address]struct{}),
+	}
+	identityState.IterateIdentities(func(key []byte, value []byte) bool {
+		if key == nil {
+			return true
+		}
+		var data state.ApprovedIdentity
+		if err := data.FromBytes(value); err != nil {
+			return false
+		}
+		if data.Validated {
+			addr := common.Address{}
+			addr.SetBytes(key[1:])
+			set.identities[addr] = struct{}{}
+		}
+		if data.Delegatee != nil {
+			set.identities[*data.Delegatee] = struct{}{}
+		}
+		return false
+	})
+	return set
+}
+
+// EpochIdentities returns the identity set of the current epoch
+func (chain *Blockchain) EpochIdentities() *EpochIdentitySet {
+	return chain.epochIdentities.get(chain.appState)
+}
+
+// mayPropose checks the address against the identity set of the epoch, pools created within the epoch and the god
+// address are checked against the current state. Online identities are always in the set, so the check doesn't
+// reject valid proposers and is done before the more expensive VRF check
+func (chain *Blockchain) mayPropose(addr common.Address) bool {
+	return chain.EpochIdentities().Contains(addr) || chain.appState.ValidatorsCache.IsPool(addr) ||
+		chain.appState.State.GodAddress() == addr
+}
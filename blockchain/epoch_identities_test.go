@@ -0,0 +1,49 @@
+package blockchain
+
+import (
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/config"
+	"github.com/idena-network/idena-go/core/state"
+	"github.com/idena-network/idena-go/crypto"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestBlockchain_EpochIdentities(t *testing.T) {
+	identity := common.Address{0x1}
+	chain, appState, _, key := NewTestBlockchain(true, map[common.Address]config.GenesisAllocation{
+		identity: {State: uint8(state.Newbie)},
+	})
+	defer chain.SecStore().Destroy()
+
+	set := chain.EpochIdentities()
+	require.True(t, set.Contains(crypto.PubkeyToAddress(key.PublicKey)))
+	require.True(t, set.Contains(identity))
+	require.False(t, set.Contains(common.Address{0x2}))
+	require.Equal(t, appState.State.EpochBlock(), set.EpochBlock)
+	require.Equal(t, set.ToBytes(), chain.repo.ReadEpochIdentities(set.Epoch))
+
+	restored := new(EpochIdentitySet)
+	require.NoError(t, restored.FromBytes(set.Epoch, set.ToBytes()))
+	require.Equal(t, set, restored)
+	require.Error(t, restored.FromBytes(set.Epoch, []byte{0x1}))
+
+	// the stored set is used after the restart even if the state changed within the epoch
+	set.identities[common.Address{0x3}] = struct{}{}
+	chain.repo.WriteEpochIdentities(set.Epoch, set.ToBytes())
+	require.True(t, newEpochIdentities(chain.repo).get(appState).Contains(common.Address{0x3}))
+
+	// the set of another epoch block is materialized again
+	set.BlockHash = common.Hash{0x1}
+	chain.repo.WriteEpochIdentities(set.Epoch, set.ToBytes())
+	require.False(t, newEpochIdentities(chain.repo).get(appState).Contains(common.Address{0x3}))
+}
+
+func TestBlockchain_ValidateProposerProof_epochIdentities(t *testing.T) {
+	chain, _, _, _ := NewTestBlockchain(true, nil)
+	defer chain.SecStore().Destroy()
+
+	key, _ := crypto.GenerateKey()
+	err := chain.ValidateProposerProof([]byte{0x1}, crypto.FromECDSAPub(&key.PublicKey))
+	require.EqualError(t, err, "Proposer is not identity of the epoch")
+}
@@ -72,6 +72,17 @@ func getFeePerGasForTx(networkSize int, feePerGas *big.Int, tx *types.Transactio
 	return feePerGas
 }
 
+// IsFeeFree reports whether the transaction type is exempt from fees
+func IsFeeFree(tx *types.Transaction) bool {
+	return getFeePerGasForTx(1, big.NewInt(1), tx).Sign() == 0
+}
+
+// FeePerByte returns the max fee and tips the transaction offers per byte of its size
+func FeePerByte(tx *types.Transaction) *big.Int {
+	offer := new(big.Int).Add(tx.MaxFeeOrZero(), tx.TipsOrZero())
+	return offer.Div(offer, big.NewInt(int64(getTxSizeForFee(tx))))
+}
+
 func getTxSizeForFee(tx *types.Transaction) int {
 	size := tx.Size()
 	if tx.Signature == nil {
@@ -6,6 +6,7 @@ import (
 	"github.com/idena-network/idena-go/common"
 	"github.com/idena-network/idena-go/common/eventbus"
 	"github.com/idena-network/idena-go/config"
+	"github.com/idena-network/idena-go/core/state"
 	"github.com/idena-network/idena-go/database"
 	"github.com/idena-network/idena-go/events"
 	"github.com/idena-network/idena-go/keystore"
@@ -55,6 +56,10 @@ func (i *indexer) HandleBlockTransactions(header *types.Header, txs []*types.Tra
 }
 
 func (i *indexer) handleOwnTx(header *types.Header, sender common.Address, tx *types.Transaction, accountsMap map[common.Address]struct{}) {
+	if i.cfg.Blockchain.IndexAddresses {
+		i.saveAddressTx(header, sender, tx)
+		return
+	}
 	if _, ok := accountsMap[sender]; ok {
 		i.repo.SaveTx(sender, header.Hash(), header.Time(), header.FeePerGas(), tx)
 	}
@@ -69,6 +74,30 @@ func (i *indexer) handleOwnTx(header *types.Header, sender common.Address, tx *t
 	}
 }
 
+// saveAddressTx indexes the transaction for both its sender and recipient
+func (i *indexer) saveAddressTx(header *types.Header, sender common.Address, tx *types.Transaction) {
+	i.repo.SaveTx(sender, header.Hash(), header.Time(), header.FeePerGas(), tx)
+	if tx.To != nil && *tx.To != sender {
+		i.repo.SaveTx(*tx.To, header.Hash(), header.Time(), header.FeePerGas(), tx)
+	}
+}
+
+// HandleBlockState records balances of the accounts changed by the block, the state has to be committed at the height
+// of the block
+func (i *indexer) HandleBlockState(header *types.Header, diff []*state.StateTreeDiff, stateDb *state.StateDB) {
+	if !i.cfg.Blockchain.IndexAddresses {
+		return
+	}
+	epoch := stateDb.Epoch()
+	for _, item := range diff {
+		if !state.StateDbKeys.IsAddressKey(item.Key) {
+			continue
+		}
+		addr := state.StateDbKeys.AddressKeyToAddress(item.Key)
+		i.repo.SaveBalance(addr, epoch, header.Height(), stateDb.GetBalance(addr))
+	}
+}
+
 func (i *indexer) handleBurnTx(height uint64, sender common.Address, tx *types.Transaction) {
 	if i.cfg.Consensus.EnableUpgrade10 {
 		return
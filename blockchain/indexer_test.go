@@ -2,9 +2,11 @@ package blockchain
 
 import (
 	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/blockchain/validation"
 	"github.com/idena-network/idena-go/common"
 	"github.com/idena-network/idena-go/crypto"
 	"github.com/idena-network/idena-go/tests"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 	"math/big"
 	"testing"
@@ -140,3 +142,35 @@ func Test_handleOwnTxsWithAccounts(t *testing.T) {
 	data, _ = chain.ReadTxs(addr4, 10, nil)
 	require.Equal(0, len(data))
 }
+
+func TestIndexer_addresses(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	chain, appState := NewCustomTestBlockchain(0, 0, key)
+	defer chain.SecStore().Destroy()
+	chain.Config().Blockchain.IndexAddresses = true
+
+	to := common.Address{0x1}
+	tx := BuildTx(appState, chain.coinBaseAddress, &to, types.SendTx, decimal.New(1, 0), decimal.New(20, 0), decimal.Zero, 0, 0, nil)
+	tx, err := chain.secStore.SignTx(tx)
+	require.NoError(t, err)
+	require.NoError(t, chain.txpool.AddExternalTxs(validation.InboundTx, tx))
+	chain.GenerateBlocks(1, 0)
+	require.NotNil(t, chain.GetTxIndex(tx.Hash()))
+
+	txs, token := chain.ReadTxs(to, 10, nil)
+	require.Nil(t, token)
+	require.Len(t, txs, 1)
+	require.Equal(t, tx.Hash(), txs[0].Tx.Hash())
+
+	records, token := chain.ReadBalanceHistory(to, 10, nil)
+	require.Nil(t, token)
+	require.Len(t, records, 1)
+	require.Equal(t, chain.Head.Height(), records[0].Height)
+	require.Equal(t, appState.State.Epoch(), records[0].Epoch)
+	require.Equal(t, common.DnaBase, records[0].Balance)
+	require.Equal(t, common.DnaBase, records[0].Change)
+
+	records, _ = chain.ReadBalanceHistory(chain.coinBaseAddress, 10, nil)
+	require.Len(t, records, 1)
+	require.Equal(t, appState.State.GetBalance(chain.coinBaseAddress), records[0].Balance)
+}
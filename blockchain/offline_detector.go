@@ -43,6 +43,8 @@ type OfflineDetector struct {
 	selfAddress             common.Address
 	offlineCommitteeMaxSize int
 	head                    *types.Header
+	// stopped is set on node shutdown, the activity isn't persisted after that
+	stopped bool
 
 	validatorsMutex   sync.Mutex
 	validatorsByRound map[uint64][]*validators.StepValidators
@@ -74,7 +76,7 @@ func NewOfflineDetector(config *config.Config, db dbm.DB, appState *appstate.App
 		startTime:               time.Now().UTC(),
 		secStore:                secStore,
 		offlineCommitteeMaxSize: config.Consensus.MaxCommitteeSize * 3,
-		throttlingLogger:        log.NewThrottlingLogger(log.New("component", "offlineDetector")),
+		throttlingLogger:        log.NewThrottlingLogger(log.New(log.ModuleKey, "chain", "component", "offlineDetector")),
 		validatorsByRound:       map[uint64][]*validators.StepValidators{},
 	}
 }
@@ -98,6 +100,17 @@ func (dt *OfflineDetector) Start(head *types.Header) {
 	go dt.startListening()
 }
 
+// Stop persists the activity map, the detector doesn't write to the database after that
+func (dt *OfflineDetector) Stop() {
+	dt.mutex.Lock()
+	defer dt.mutex.Unlock()
+	if dt.stopped {
+		return
+	}
+	dt.stopped = true
+	dt.persist()
+}
+
 func (dt *OfflineDetector) ProcessVote(vote *types.Vote) {
 	select {
 	case dt.votesChan <- vote:
@@ -261,6 +274,9 @@ func (dt *OfflineDetector) startListening() {
 func (dt *OfflineDetector) processBlock(block *types.Block) {
 	dt.mutex.Lock()
 	defer dt.mutex.Unlock()
+	if dt.stopped {
+		return
+	}
 
 	if dt.lastPersistBlock+uint64(dt.config.PersistInterval) <= block.Height() {
 
@@ -0,0 +1,74 @@
+package blockchain
+
+import (
+	"bytes"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/database"
+	"github.com/idena-network/idena-go/ipfs"
+	"github.com/pkg/errors"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func writeTxIndex(repo *database.Repo, batch dbm.Batch, hash common.Hash, txs types.Transactions) {
+	for i, tx := range txs {
+		idx := &types.TransactionIndex{
+			BlockHash: hash,
+			Idx:       uint32(i),
+		}
+		repo.WriteTxIndex(batch, tx.Hash(), idx)
+	}
+}
+
+// IndexTransactions writes tx and receipt indexes of canonical blocks in the range, a chain synced by fast sync has
+// indexes of own transactions only, so the indexes of other ones are built from block bodies loaded from ipfs,
+// contract events aren't indexed, the number of transactions which weren't indexed before is returned
+func IndexTransactions(repo *database.Repo, proxy ipfs.Proxy, from uint64, to uint64, onBlock func(height uint64)) (int, error) {
+	indexed := 0
+	for height := from; height <= to; height++ {
+		if onBlock != nil {
+			onBlock(height)
+		}
+		hash := repo.ReadCanonicalHash(height)
+		if hash == (common.Hash{}) {
+			continue
+		}
+		header := repo.ReadBlockHeader(hash)
+		if header == nil || header.ProposedHeader == nil || bytes.Equal(header.ProposedHeader.IpfsHash, ipfs.EmptyCid.Bytes()) {
+			continue
+		}
+		data, err := proxy.Get(header.ProposedHeader.IpfsHash, ipfs.Block)
+		if err != nil {
+			return indexed, errors.Wrapf(err, "cannot load body of block %d", height)
+		}
+		body := &types.Body{}
+		body.FromBytes(data)
+		for _, tx := range body.Transactions {
+			if repo.ReadTxIndex(tx.Hash()) == nil {
+				indexed++
+			}
+		}
+		batch := repo.NewBatch()
+		writeTxIndex(repo, batch, hash, body.Transactions)
+		if len(header.ProposedHeader.TxReceiptsCid) > 0 {
+			data, err := proxy.Get(header.ProposedHeader.TxReceiptsCid, ipfs.TxReceipt)
+			if err != nil {
+				batch.Close()
+				return indexed, errors.Wrapf(err, "cannot load receipts of block %d", height)
+			}
+			receipts := types.TxReceipts{}.FromBytes(data)
+			for i, r := range receipts {
+				repo.WriteReceiptIndex(batch, r.TxHash, &types.TxReceiptIndex{
+					Idx:        uint32(i),
+					ReceiptCid: header.ProposedHeader.TxReceiptsCid,
+				})
+			}
+		}
+		err = batch.Write()
+		batch.Close()
+		if err != nil {
+			return indexed, err
+		}
+	}
+	return indexed, nil
+}
@@ -0,0 +1,49 @@
+package blockchain
+
+import (
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/crypto"
+	"github.com/idena-network/idena-go/database"
+	"github.com/stretchr/testify/require"
+	db "github.com/tendermint/tm-db"
+	"testing"
+)
+
+func TestIndexTransactions(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	chain, _ := NewCustomTestBlockchain(0, 0, key)
+	defer chain.SecStore().Destroy()
+	chain.GenerateBlocks(3, 1)
+	chain.GenerateEmptyBlocks(1)
+
+	// the repo has the chain without tx indexes as if it was synced by fast sync
+	repo := database.NewRepo(db.NewMemDB())
+	var txs []*types.Transaction
+	for height := uint64(1); height <= chain.Head.Height(); height++ {
+		header := chain.GetBlockHeaderByHeight(height)
+		if header == nil {
+			continue
+		}
+		repo.WriteBlockHeader(nil, header)
+		repo.WriteCanonicalHash(nil, height, header.Hash())
+		if block := chain.GetBlockByHeight(height); block != nil && block.Body != nil {
+			txs = append(txs, block.Body.Transactions...)
+		}
+	}
+	require.NotEmpty(t, txs)
+
+	var visited []uint64
+	indexed, err := IndexTransactions(repo, chain.ipfs, 1, chain.Head.Height(), func(height uint64) {
+		visited = append(visited, height)
+	})
+	require.NoError(t, err)
+	require.Equal(t, len(txs), indexed)
+	require.Len(t, visited, int(chain.Head.Height()))
+	for _, tx := range txs {
+		require.Equal(t, chain.GetTxIndex(tx.Hash()), repo.ReadTxIndex(tx.Hash()))
+	}
+
+	indexed, err = IndexTransactions(repo, chain.ipfs, 1, chain.Head.Height(), nil)
+	require.NoError(t, err)
+	require.Zero(t, indexed)
+}
@@ -25,6 +25,7 @@ func SignTx(tx *Transaction, prv *ecdsa.PrivateKey) (*Transaction, error) {
 		Payload:      tx.Payload,
 		To:           tx.To,
 		Type:         tx.Type,
+		Network:      tx.Network,
 		Signature:    sig,
 	}, nil
 }
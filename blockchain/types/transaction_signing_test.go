@@ -30,3 +30,46 @@ func TestSignTx(t *testing.T) {
 		t.Errorf("exected from and address to be equal. Got %x want %x", from, addr)
 	}
 }
+
+func TestSignTx_network(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+
+	tx := Transaction{
+		AccountNonce: 1,
+		Type:         SendTx,
+		To:           &addr,
+		Amount:       big.NewInt(1),
+		Network:      0x1,
+	}
+	signedTx, err := SignTx(&tx, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if signedTx.Network != tx.Network {
+		t.Fatalf("expected network %v, got %v", tx.Network, signedTx.Network)
+	}
+
+	data, _ := signedTx.ToBytes()
+	decoded := new(Transaction)
+	if err := decoded.FromBytes(data); err != nil {
+		t.Fatal(err)
+	}
+	if from, _ := Sender(decoded); from != addr {
+		t.Errorf("exected from and address to be equal. Got %x want %x", from, addr)
+	}
+
+	replayed := new(Transaction)
+	replayed.FromBytes(data)
+	replayed.Network = 0x2
+	if from, _ := Sender(replayed); from == addr {
+		t.Error("expected the signature to be bound to the network")
+	}
+
+	legacy := tx
+	legacy.Network = 0
+	legacySig, _ := SignTx(&legacy, key)
+	if legacySig.Hash() == signedTx.Hash() {
+		t.Error("expected different hashes for bound and unbound txs")
+	}
+}
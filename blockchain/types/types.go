@@ -170,6 +170,8 @@ type Transaction struct {
 	MaxFee       *big.Int
 	Tips         *big.Int
 	Payload      []byte `rlp:"nil"       json:"input"`
+	// Network binds the signature to a network to prevent replaying the tx on another one, zero means any network
+	Network Network `rlp:"-"`
 
 	Signature []byte
 
@@ -790,6 +792,11 @@ func (v *Vote) VoterAddr() common.Address {
 	return addr
 }
 
+// SetVoterAddr sets the voter recovered from the same vote earlier, so the signature isn't recovered again
+func (v *Vote) SetVoterAddr(addr common.Address) {
+	v.addr.Store(addr)
+}
+
 func (v *Vote) PubKey() ([]byte, error) {
 	hash := crypto.SignatureHash(v)
 	return crypto.Ecrecover(hash[:], v.Signature)
@@ -858,6 +865,7 @@ func (tx *Transaction) ToSignatureBytes() ([]byte, error) {
 		Amount:  common.BigIntBytesOrNil(tx.Amount),
 		Tips:    common.BigIntBytesOrNil(tx.Tips),
 		MaxFee:  common.BigIntBytesOrNil(tx.MaxFee),
+		Network: tx.Network,
 	}
 	if tx.To != nil {
 		protoTx.To = tx.To.Bytes()
@@ -875,6 +883,7 @@ func (tx *Transaction) ToProto() *models.ProtoTransaction {
 			Amount:  common.BigIntBytesOrNil(tx.Amount),
 			Tips:    common.BigIntBytesOrNil(tx.Tips),
 			MaxFee:  common.BigIntBytesOrNil(tx.MaxFee),
+			Network: tx.Network,
 		},
 		Signature: tx.Signature,
 		UseRlp:    tx.UseRlp,
@@ -904,6 +913,7 @@ func (tx *Transaction) FromProto(protoTx *models.ProtoTransaction) *Transaction
 		tx.Amount = common.BigIntOrNil(protoTx.Data.Amount)
 		tx.Tips = common.BigIntOrNil(protoTx.Data.Tips)
 		tx.MaxFee = common.BigIntOrNil(protoTx.Data.MaxFee)
+		tx.Network = protoTx.Data.GetNetwork()
 	}
 
 	tx.Signature = protoTx.GetSignature()
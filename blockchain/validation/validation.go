@@ -67,6 +67,7 @@ var (
 	WrongEpoch           = errors.New("wrong epoch")
 	InvalidDeployAmount  = errors.New("insufficient amount to create contract")
 	SenderHasPenalty     = errors.New("sender has penalty")
+	InvalidNetwork       = errors.New("tx is signed for another network")
 
 	validators map[types.TxType]validator
 )
@@ -147,6 +148,10 @@ func ValidateTx(appState *appstate.AppState, tx *types.Transaction, minFeePerGas
 		return InvalidSignature
 	}
 
+	if err := validateNetwork(tx); err != nil {
+		return err
+	}
+
 	if appCfg != nil && appCfg.Consensus.EnableUpgrade11 {
 		if len(tx.Payload) > MaxPayloadSizeUpgrade11 {
 			return InvalidPayload
@@ -207,6 +212,17 @@ func ValidateTx(appState *appstate.AppState, tx *types.Transaction, minFeePerGas
 	return nil
 }
 
+// validateNetwork accepts txs bound to a network only once the consensus enables them, the legacy rlp signature doesn't cover the network
+func validateNetwork(tx *types.Transaction) error {
+	if tx.Network == 0 {
+		return nil
+	}
+	if tx.UseRlp || appCfg == nil || !appCfg.Consensus.EnableTxNetwork || tx.Network != appCfg.Network {
+		return InvalidNetwork
+	}
+	return nil
+}
+
 func validateTotalCost(sender common.Address, appState *appstate.AppState, tx *types.Transaction, txType TxType) error {
 	var cost *big.Int
 	if txType != InBlockTx {
@@ -164,3 +164,21 @@ func Test_validateDelegateTx(t *testing.T) {
 		require.NoError(t, err)
 	}
 }
+
+func Test_validateNetwork(t *testing.T) {
+	defer SetAppConfig(nil)
+
+	tx := func(network types.Network, useRlp bool) *types.Transaction {
+		return &types.Transaction{Network: network, UseRlp: useRlp}
+	}
+
+	SetAppConfig(&config.Config{Network: 0x1, Consensus: &config.ConsensusConf{}})
+	require.NoError(t, validateNetwork(tx(0, false)))
+	require.Equal(t, InvalidNetwork, validateNetwork(tx(0x1, false)))
+
+	SetAppConfig(&config.Config{Network: 0x1, Consensus: &config.ConsensusConf{EnableTxNetwork: true}})
+	require.NoError(t, validateNetwork(tx(0, false)))
+	require.NoError(t, validateNetwork(tx(0x1, false)))
+	require.Equal(t, InvalidNetwork, validateNetwork(tx(0x2, false)))
+	require.Equal(t, InvalidNetwork, validateNetwork(tx(0x1, true)))
+}
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"fmt"
+	"github.com/idena-network/idena-go/blockchain"
+	"github.com/idena-network/idena-go/common/eventbus"
+	"github.com/idena-network/idena-go/config"
+	"github.com/idena-network/idena-go/database"
+	"github.com/idena-network/idena-go/ipfs"
+	"github.com/idena-network/idena-go/node"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"os"
+)
+
+const chainDataProgressInterval = 10000
+
+var (
+	exportFromFlag = cli.Uint64Flag{
+		Name:  "from",
+		Usage: "First block height to export",
+		Value: 1,
+	}
+	exportToFlag = cli.Uint64Flag{
+		Name:  "to",
+		Usage: "Last block height to export, the head is used by default",
+	}
+)
+
+var exportCommand = cli.Command{
+	Name:      "export",
+	Usage:     "Write blocks of the chain to a gzip compressed file, the node must be stopped",
+	ArgsUsage: "<file>",
+	Flags: []cli.Flag{
+		config.CfgFileFlag,
+		config.DataDirFlag,
+		config.DbBackendFlag,
+		exportFromFlag,
+		exportToFlag,
+	},
+	Action: exportChain,
+}
+
+var importCommand = cli.Command{
+	Name:      "import",
+	Usage:     "Insert blocks of an exported file into the chain, blocks are validated like the ones received from peers, the node must be stopped",
+	ArgsUsage: "<file>",
+	Flags: []cli.Flag{
+		config.CfgFileFlag,
+		config.DataDirFlag,
+		config.DbBackendFlag,
+	},
+	Action: importChain,
+}
+
+func exportChain(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return errors.New("path of the export file is required")
+	}
+	cfg, err := config.MakeConfig(ctx, func(cfg *config.Config) {})
+	if err != nil {
+		return err
+	}
+	db, err := node.OpenDatabase(cfg.DataDir, node.ChainDbName, cfg.Database.Backend, 16, 16, false)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	repo := database.NewRepo(db)
+	head := repo.ReadHead()
+	if head == nil {
+		return errors.New("chain is empty")
+	}
+	from, to := ctx.Uint64(exportFromFlag.Name), head.Height()
+	if ctx.IsSet(exportToFlag.Name) && ctx.Uint64(exportToFlag.Name) < to {
+		to = ctx.Uint64(exportToFlag.Name)
+	}
+	if from == 0 || from > to {
+		return errors.Errorf("invalid range %d-%d, head %d", from, to, head.Height())
+	}
+
+	// block bodies are kept by ipfs, missing ones are loaded from the network
+	proxy, err := ipfs.NewIpfsProxy(cfg.IpfsConf, eventbus.New())
+	if err != nil {
+		return err
+	}
+	defer proxy.Close()
+	file, err := os.Create(ctx.Args().First())
+	if err != nil {
+		return err
+	}
+	err = blockchain.ExportBlocks(repo, proxy, file, from, to, func(height uint64) {
+		if (height-from+1)%chainDataProgressInterval == 0 {
+			fmt.Fprintf(ctx.App.Writer, "Exported block %d of %d\n", height, to)
+		}
+	})
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(ctx.App.Writer, "Blocks %d-%d are exported\n", from, to)
+	return nil
+}
+
+func importChain(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return errors.New("path of the import file is required")
+	}
+	cfg, err := config.MakeConfig(ctx, func(cfg *config.Config) {})
+	if err != nil {
+		return err
+	}
+	file, err := os.Open(ctx.Args().First())
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	imported, err := node.ImportChain(cfg, ctx.App.Version, file, func(height uint64) {
+		if height%chainDataProgressInterval == 0 {
+			fmt.Fprintf(ctx.App.Writer, "Imported block %d\n", height)
+		}
+	})
+	if err != nil {
+		return errors.Wrapf(err, "%d blocks are imported", imported)
+	}
+	fmt.Fprintf(ctx.App.Writer, "Blocks are imported: %d\n", imported)
+	return nil
+}
@@ -0,0 +1,69 @@
+package knowncache
+
+import (
+	"github.com/hashicorp/golang-lru"
+	"time"
+)
+
+// Cache remembers keys of known entities for a limited time. At most size keys are kept,
+// the oldest added keys are evicted first when the cache is full, so its memory usage is bounded.
+// Expired keys are reported as unknown and are dropped lazily.
+type Cache struct {
+	entries *lru.Cache
+	ttl     time.Duration
+	now     func() time.Time
+}
+
+// New creates a cache of the given size, keys live for ttl unless another lifetime is set on adding, zero ttl means no expiration
+func New(size int, ttl time.Duration) *Cache {
+	entries, _ := lru.New(size)
+	return &Cache{
+		entries: entries,
+		ttl:     ttl,
+		now:     time.Now,
+	}
+}
+
+// Add marks the key as known for the default lifetime
+func (c *Cache) Add(key interface{}) {
+	c.AddWithTTL(key, c.ttl)
+}
+
+// AddWithTTL marks the key as known for the given lifetime, zero means the default one.
+// Adding a key which is known already changes neither its lifetime nor its eviction order
+func (c *Cache) AddWithTTL(key interface{}, ttl time.Duration) {
+	if c.Has(key) {
+		return
+	}
+	if ttl == 0 {
+		ttl = c.ttl
+	}
+	var expiresAt time.Time
+	if ttl > 0 {
+		expiresAt = c.now().Add(ttl)
+	}
+	c.entries.Add(key, expiresAt)
+}
+
+// Has reports whether the key is known and not expired, lookups don't affect the eviction order
+func (c *Cache) Has(key interface{}) bool {
+	value, ok := c.entries.Peek(key)
+	if !ok {
+		return false
+	}
+	expiresAt := value.(time.Time)
+	if !expiresAt.IsZero() && !c.now().Before(expiresAt) {
+		c.entries.Remove(key)
+		return false
+	}
+	return true
+}
+
+func (c *Cache) Remove(key interface{}) {
+	c.entries.Remove(key)
+}
+
+// Len returns the number of stored keys including expired ones which are not dropped yet
+func (c *Cache) Len() int {
+	return c.entries.Len()
+}
@@ -0,0 +1,52 @@
+package knowncache
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestCache_eviction(t *testing.T) {
+	c := New(3, 0)
+	for i := 0; i < 3; i++ {
+		c.Add(i)
+	}
+	// lookups don't save keys from eviction
+	require.True(t, c.Has(0))
+	c.Add(3)
+
+	require.False(t, c.Has(0))
+	for i := 1; i <= 3; i++ {
+		require.True(t, c.Has(i))
+	}
+	require.Equal(t, 3, c.Len())
+
+	c.Remove(1)
+	require.False(t, c.Has(1))
+	require.Equal(t, 2, c.Len())
+}
+
+func TestCache_expiration(t *testing.T) {
+	now := time.Unix(0, 0)
+	c := New(10, time.Minute)
+	c.now = func() time.Time {
+		return now
+	}
+	c.Add("default")
+	c.AddWithTTL("short", time.Second)
+	c.AddWithTTL("long", time.Hour)
+
+	now = now.Add(time.Second)
+	require.False(t, c.Has("short"))
+	require.True(t, c.Has("default"))
+	require.Equal(t, 2, c.Len())
+
+	now = now.Add(time.Minute)
+	require.False(t, c.Has("default"))
+	require.True(t, c.Has("long"))
+
+	// re-adding doesn't prolong the lifetime
+	c.AddWithTTL("long", time.Hour)
+	now = now.Add(time.Minute * 59)
+	require.False(t, c.Has("long"))
+}
@@ -0,0 +1,90 @@
+package prometheus
+
+import (
+	"bufio"
+	"fmt"
+	"github.com/rcrowley/go-metrics"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+const namespace = "idena"
+
+var quantiles = []float64{0.5, 0.9, 0.99}
+
+// Handler serves the metrics of the registry in the Prometheus text format
+func Handler(registry metrics.Registry) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		if err := Write(w, registry); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+}
+
+// Write writes the metrics of the registry in the Prometheus text format, metrics are sorted by name
+func Write(w io.Writer, registry metrics.Registry) error {
+	all := make(map[string]interface{})
+	registry.Each(func(name string, metric interface{}) {
+		all[name] = metric
+	})
+	names := make([]string, 0, len(all))
+	for name := range all {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	buf := bufio.NewWriter(w)
+	for _, name := range names {
+		writeMetric(buf, metricName(name), all[name])
+	}
+	return buf.Flush()
+}
+
+func writeMetric(w io.Writer, name string, metric interface{}) {
+	switch m := metric.(type) {
+	case metrics.Counter:
+		writeValue(w, name, "counter", float64(m.Count()))
+	case metrics.Gauge:
+		writeValue(w, name, "gauge", float64(m.Value()))
+	case metrics.GaugeFloat64:
+		writeValue(w, name, "gauge", m.Value())
+	case metrics.Meter:
+		snapshot := m.Snapshot()
+		writeValue(w, name+"_total", "counter", float64(snapshot.Count()))
+		writeValue(w, name+"_rate1m", "gauge", snapshot.Rate1())
+	case metrics.Histogram:
+		snapshot := m.Snapshot()
+		writeSummary(w, name, snapshot.Percentiles(quantiles), float64(snapshot.Sum()), snapshot.Count(), 1)
+	case metrics.Timer:
+		snapshot := m.Snapshot()
+		// timers keep nanoseconds while Prometheus expects seconds
+		writeSummary(w, name+"_seconds", snapshot.Percentiles(quantiles), float64(snapshot.Sum()), snapshot.Count(), 1e-9)
+	}
+}
+
+func writeValue(w io.Writer, name string, kind string, value float64) {
+	fmt.Fprintf(w, "# TYPE %s %s\n", name, kind)
+	fmt.Fprintf(w, "%s %v\n", name, value)
+}
+
+func writeSummary(w io.Writer, name string, values []float64, sum float64, count int64, scale float64) {
+	fmt.Fprintf(w, "# TYPE %s summary\n", name)
+	for i, q := range quantiles {
+		fmt.Fprintf(w, "%s{quantile=\"%v\"} %v\n", name, q, values[i]*scale)
+	}
+	fmt.Fprintf(w, "%s_sum %v\n", name, sum*scale)
+	fmt.Fprintf(w, "%s_count %v\n", name, count)
+}
+
+// metricName converts a go-metrics name like "pq.requests" to a valid Prometheus name like "idena_pq_requests"
+func metricName(name string) string {
+	return namespace + "_" + strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_' {
+			return r
+		}
+		return '_'
+	}, name)
+}
@@ -0,0 +1,43 @@
+package prometheus
+
+import (
+	"bytes"
+	"github.com/rcrowley/go-metrics"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestWrite(t *testing.T) {
+	registry := metrics.NewRegistry()
+	metrics.GetOrRegisterCounter("bs.total", registry).Inc(10)
+	registry.Register("pq.requests", metrics.NewFunctionalGauge(func() int64 {
+		return 3
+	}))
+	registry.Register("sc.tx.hitRate", metrics.NewFunctionalGaugeFloat64(func() float64 {
+		return 0.5
+	}))
+	metrics.GetOrRegisterTimer("consensus.round", registry).Update(time.Second * 2)
+
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, registry))
+
+	require.Equal(t, `# TYPE idena_bs_total counter
+idena_bs_total 10
+# TYPE idena_consensus_round_seconds summary
+idena_consensus_round_seconds{quantile="0.5"} 2
+idena_consensus_round_seconds{quantile="0.9"} 2
+idena_consensus_round_seconds{quantile="0.99"} 2
+idena_consensus_round_seconds_sum 2
+idena_consensus_round_seconds_count 1
+# TYPE idena_pq_requests gauge
+idena_pq_requests 3
+# TYPE idena_sc_tx_hitRate gauge
+idena_sc_tx_hitRate 0.5
+`, buf.String())
+}
+
+func TestMetricName(t *testing.T) {
+	require.Equal(t, "idena_pq_requests", metricName("pq.requests"))
+	require.Equal(t, "idena_p2p_conn_count", metricName("p2p/conn-count"))
+}
@@ -1,11 +1,17 @@
 package pushpull
 
 import (
+	"github.com/hashicorp/golang-lru"
 	"github.com/idena-network/idena-go/common"
-	"github.com/patrickmn/go-cache"
+	"sync/atomic"
 	"time"
 )
 
+const (
+	DefaultHolderSize = 50000
+	DefaultHolderTTL  = time.Minute * 3
+)
+
 type Holder interface {
 	Add(hash common.Hash128, entry interface{}, shardId common.ShardId, highPriority bool)
 	Has(hash common.Hash128) bool
@@ -16,15 +22,20 @@ type Holder interface {
 }
 
 type DefaultHolder struct {
-	entryCache  *cache.Cache
+	entryCache  *lru.Cache
+	ttl         time.Duration
 	maxPulls    int
 	pushTracker PendingPushTracker
+	hits        uint64
+	misses      uint64
+	now         func() time.Time
 }
 
 type entryWrapper struct {
 	entry        interface{}
 	highPriority bool
 	shardId      common.ShardId
+	expiresAt    time.Time
 }
 
 func (d *DefaultHolder) PushTracker() PendingPushTracker {
@@ -36,10 +47,25 @@ func (d *DefaultHolder) SupportPendingRequests() bool {
 }
 
 func NewDefaultHolder(maxPulls int, pushTracker PendingPushTracker) Holder {
+	return NewBoundedHolder(maxPulls, pushTracker, DefaultHolderSize, DefaultHolderTTL)
+}
+
+// NewBoundedHolder creates a holder keeping at most size entries, each of them lives no longer than ttl.
+// The least recently used entries are evicted when the holder is full.
+func NewBoundedHolder(maxPulls int, pushTracker PendingPushTracker, size int, ttl time.Duration) *DefaultHolder {
+	if size <= 0 {
+		size = DefaultHolderSize
+	}
+	if ttl <= 0 {
+		ttl = DefaultHolderTTL
+	}
+	entryCache, _ := lru.New(size)
 	holder := &DefaultHolder{
-		entryCache:  cache.New(time.Minute*3, time.Minute*5),
+		entryCache:  entryCache,
+		ttl:         ttl,
 		maxPulls:    maxPulls,
 		pushTracker: pushTracker,
+		now:         time.Now,
 	}
 	if pushTracker != nil {
 		pushTracker.SetHolder(holder)
@@ -49,27 +75,52 @@ func NewDefaultHolder(maxPulls int, pushTracker PendingPushTracker) Holder {
 }
 
 func (d *DefaultHolder) Add(hash common.Hash128, entry interface{}, shardId common.ShardId, highPriority bool) {
-	d.entryCache.SetDefault(hash.String(), &entryWrapper{
+	d.entryCache.Add(hash, &entryWrapper{
 		entry:        entry,
 		highPriority: highPriority,
 		shardId:      shardId,
+		expiresAt:    d.now().Add(d.ttl),
 	})
 	if d.pushTracker != nil {
 		d.pushTracker.RemovePull(hash)
 	}
 }
 
+func (d *DefaultHolder) get(hash common.Hash128) *entryWrapper {
+	value, ok := d.entryCache.Get(hash)
+	if !ok {
+		atomic.AddUint64(&d.misses, 1)
+		return nil
+	}
+	wrapper := value.(*entryWrapper)
+	if d.now().After(wrapper.expiresAt) {
+		d.entryCache.Remove(hash)
+		atomic.AddUint64(&d.misses, 1)
+		return nil
+	}
+	atomic.AddUint64(&d.hits, 1)
+	return wrapper
+}
+
 func (d *DefaultHolder) Has(hash common.Hash128) bool {
-	_, ok := d.entryCache.Get(hash.String())
-	return ok
+	return d.get(hash) != nil
 }
 
 func (d *DefaultHolder) Get(hash common.Hash128) (entry interface{}, id common.ShardId, highPriority bool, present bool) {
-	wrapper, ok := d.entryCache.Get(hash.String())
-	if !ok {
+	wrapper := d.get(hash)
+	if wrapper == nil {
 		return nil, common.MultiShard, false, false
 	}
-	return wrapper.(*entryWrapper).entry, wrapper.(*entryWrapper).shardId, wrapper.(*entryWrapper).highPriority, true
+	return wrapper.entry, wrapper.shardId, wrapper.highPriority, true
+}
+
+func (d *DefaultHolder) Len() int {
+	return d.entryCache.Len()
+}
+
+// Stats returns the number of lookups which found and which didn't find an entry
+func (d *DefaultHolder) Stats() (hits, misses uint64) {
+	return atomic.LoadUint64(&d.hits), atomic.LoadUint64(&d.misses)
 }
 
 func (d *DefaultHolder) MaxParallelPulls() uint32 {
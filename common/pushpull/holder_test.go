@@ -0,0 +1,60 @@
+package pushpull
+
+import (
+	"github.com/idena-network/idena-go/common"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestBoundedHolder_Size(t *testing.T) {
+	holder := NewBoundedHolder(1, nil, 3, time.Minute)
+	for i := byte(1); i <= 3; i++ {
+		holder.Add(common.Hash128{i}, i, common.MultiShard, false)
+	}
+	// touch the first entry so the second one becomes the least recently used
+	require.True(t, holder.Has(common.Hash128{1}))
+
+	holder.Add(common.Hash128{4}, byte(4), common.MultiShard, false)
+
+	require.Equal(t, 3, holder.Len())
+	require.True(t, holder.Has(common.Hash128{1}))
+	require.False(t, holder.Has(common.Hash128{2}))
+	require.True(t, holder.Has(common.Hash128{3}))
+	entry, _, _, ok := holder.Get(common.Hash128{4})
+	require.True(t, ok)
+	require.Equal(t, byte(4), entry)
+}
+
+func TestBoundedHolder_TTL(t *testing.T) {
+	now := time.Now()
+	holder := NewBoundedHolder(1, nil, 10, time.Minute)
+	holder.now = func() time.Time {
+		return now
+	}
+	holder.Add(common.Hash128{1}, 1, common.MultiShard, false)
+	now = now.Add(time.Second * 30)
+	holder.Add(common.Hash128{2}, 2, common.MultiShard, false)
+
+	now = now.Add(time.Second * 31)
+	require.False(t, holder.Has(common.Hash128{1}))
+	require.True(t, holder.Has(common.Hash128{2}))
+	require.Equal(t, 1, holder.Len())
+
+	now = now.Add(time.Second * 30)
+	_, _, _, ok := holder.Get(common.Hash128{2})
+	require.False(t, ok)
+	require.Equal(t, 0, holder.Len())
+}
+
+func TestBoundedHolder_Stats(t *testing.T) {
+	holder := NewBoundedHolder(1, nil, 10, time.Minute)
+	holder.Add(common.Hash128{1}, 1, common.MultiShard, false)
+	holder.Has(common.Hash128{1})
+	holder.Get(common.Hash128{1})
+	holder.Has(common.Hash128{2})
+
+	hits, misses := holder.Stats()
+	require.Equal(t, uint64(2), hits)
+	require.Equal(t, uint64(1), misses)
+}
@@ -5,4 +5,8 @@ type BlockchainConfig struct {
 	StoreCertRange uint64
 	BurnTxRange    uint64
 	WriteAllEvents bool
+	// number of latest state versions kept in the database, older versions are pruned
+	StatesRetention int
+	// index transactions and balances of all addresses, not only of the node accounts
+	IndexAddresses bool
 }
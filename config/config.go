@@ -12,6 +12,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/urfave/cli"
 	"io/ioutil"
+	"math/big"
 	"os"
 	"path/filepath"
 	"strings"
@@ -41,6 +42,17 @@ type Config struct {
 	OfflineDetection *OfflineDetectionConfig
 	Blockchain       *BlockchainConfig
 	Mempool          *Mempool
+	Log              *LogConfig
+	Database         *DatabaseConfig
+	Profiling        *ProfilingConfig
+
+	// nodeKey is an unlocked keystore key which is used instead of the key file
+	nodeKey *ecdsa.PrivateKey
+}
+
+// SetNodeKey makes the node use the key instead of the one stored in the datadir
+func (c *Config) SetNodeKey(key *ecdsa.PrivateKey) {
+	c.nodeKey = key
 }
 
 func (c *Config) ProvideNodeKey(key string, password string, withBackup bool) error {
@@ -86,6 +98,9 @@ func (c *Config) ProvideNodeKey(key string, password string, withBackup bool) er
 }
 
 func (c *Config) NodeKey() (*ecdsa.PrivateKey, error) {
+	if c.nodeKey != nil {
+		return c.nodeKey, nil
+	}
 	// Generate ephemeral key if no datadir is being used.
 	if c.DataDir == "" {
 		key, err := crypto.GenerateKey()
@@ -125,6 +140,22 @@ func (c *Config) NodeDB() string {
 	return filepath.Join(c.DataDir, "nodes")
 }
 
+// BlockCheckpoints returns the hard-coded block checkpoints of the network merged with the configured ones
+func (c *Config) BlockCheckpoints() map[uint64]string {
+	checkpoints := make(map[uint64]string)
+	if c.Network == 0x1 {
+		for height, hash := range MainnetBlockCheckpoints {
+			checkpoints[height] = hash
+		}
+	}
+	if c.Sync != nil {
+		for height, hash := range c.Sync.BlockCheckpoints {
+			checkpoints[height] = hash
+		}
+	}
+	return checkpoints
+}
+
 func (c *Config) KeyStoreDataDir() (string, error) {
 	instanceDir := filepath.Join(c.DataDir, "keystore")
 	if err := os.MkdirAll(instanceDir, 0700); err != nil {
@@ -186,11 +217,95 @@ func MakeConfig(ctx *cli.Context, cfgTransform func(cfg *Config)) (*Config, erro
 	if ctx.IsSet(DataDirFlag.Name) {
 		cfg.DataDir = ctx.String(DataDirFlag.Name)
 	}
+	if err := applyDataDirGenesis(cfg); err != nil {
+		return nil, err
+	}
+	// the db is opened by cfgTransform, so its backend is known in advance
+	applyDatabaseFlags(ctx, cfg.Database)
+	if err := validateDatabaseConfig(cfg.Database); err != nil {
+		return nil, err
+	}
 	cfgTransform(cfg)
 	applyFlags(ctx, cfg)
+	if err := applyMempoolFlags(ctx, cfg); err != nil {
+		return nil, err
+	}
+	if err := applyCheckpointsFlag(ctx, cfg); err != nil {
+		return nil, err
+	}
+	if err := validatePruning(ctx, cfg); err != nil {
+		return nil, err
+	}
+	if _, _, err := ParseNat(cfg.IpfsConf.Nat); err != nil {
+		return nil, err
+	}
+	if _, err := ParseListenIps(cfg.IpfsConf.ListenIps); err != nil {
+		return nil, err
+	}
+	if err := validateLogConfig(cfg.Log); err != nil {
+		return nil, err
+	}
+	if err := validateRpcConfig(cfg.RPC); err != nil {
+		return nil, err
+	}
 	return cfg, nil
 }
 
+func validateRpcConfig(cfg *rpc.Config) error {
+	if (cfg.TLSCertFile == "") != (cfg.TLSKeyFile == "") {
+		return errors.Errorf("both --%v and --%v are required to serve RPC over TLS", RpcTLSCertFlag.Name, RpcTLSKeyFlag.Name)
+	}
+	for _, key := range cfg.AccessKeys {
+		if key.Key == "" {
+			return errors.New("RPC access key cannot be empty")
+		}
+		if key.Key == cfg.APIKey {
+			return errors.New("RPC access key cannot be equal to the api key")
+		}
+	}
+	return nil
+}
+
+func applyMempoolFlags(ctx *cli.Context, cfg *Config) error {
+	if !ctx.IsSet(MinFeePerByteFlag.Name) {
+		return nil
+	}
+	value := ctx.String(MinFeePerByteFlag.Name)
+	minFeePerByte, ok := new(big.Int).SetString(value, 10)
+	if !ok || minFeePerByte.Sign() < 0 {
+		return errors.Errorf("invalid --%v value %v", MinFeePerByteFlag.Name, value)
+	}
+	cfg.Mempool.MinFeePerByte = minFeePerByte
+	return nil
+}
+
+func applyCheckpointsFlag(ctx *cli.Context, cfg *Config) error {
+	if !ctx.IsSet(CheckpointsFlag.Name) {
+		return validateBlockCheckpoints(cfg.Sync.BlockCheckpoints)
+	}
+	checkpoints, err := ParseBlockCheckpoints(ctx.String(CheckpointsFlag.Name))
+	if err != nil {
+		return err
+	}
+	if cfg.Sync.BlockCheckpoints == nil {
+		cfg.Sync.BlockCheckpoints = make(map[uint64]string)
+	}
+	for height, hash := range checkpoints {
+		cfg.Sync.BlockCheckpoints[height] = hash
+	}
+	return validateBlockCheckpoints(cfg.Sync.BlockCheckpoints)
+}
+
+func validatePruning(ctx *cli.Context, cfg *Config) error {
+	if ctx.Bool(ArchiveFlag.Name) && ctx.Bool(FastSyncFlag.Name) {
+		return errors.Errorf("--%v cannot be used with --%v, archive node loads all blocks with full sync", ArchiveFlag.Name, FastSyncFlag.Name)
+	}
+	if cfg.Blockchain.StatesRetention < MinStatesRetention {
+		return errors.Errorf("states retention should be at least %v, got %v", MinStatesRetention, cfg.Blockchain.StatesRetention)
+	}
+	return nil
+}
+
 func applyProfile(ctx *cli.Context, cfg *Config) {
 	if ctx.IsSet(ProfileFlag.Name) {
 		switch ctx.String(ProfileFlag.Name) {
@@ -244,7 +359,10 @@ func getDefaultConfig(dataDir string) *Config {
 			MaxOutboundPeers:         DefaultMaxOutboundNotOwnShardPeers,
 			MaxInboundOwnShardPeers:  DefaultMaxInboundOwnShardPeers,
 			MaxOutboundOwnShardPeers: DefaultMaxOutboundOwnShardPeers,
+			MaxInboundPeersPerIP:     DefaultMaxInboundPeersPerIP,
+			MaxInboundPeersPerSubnet: DefaultMaxInboundPeersPerSubnet,
 			DisableMetrics:           false,
+			MinPeerScore:             DefaultMinPeerScore,
 		},
 		Consensus: GetDefaultConsensusConfig(),
 		RPC:       rpc.GetDefaultRPCConfig(DefaultRpcHost, DefaultRpcPort),
@@ -261,10 +379,14 @@ func getDefaultConfig(dataDir string) *Config {
 		},
 		OfflineDetection: GetDefaultOfflineDetectionConfig(),
 		Blockchain: &BlockchainConfig{
-			StoreCertRange: DefaultStoreCertRange,
-			BurnTxRange:    DefaultBurntTxRange,
+			StoreCertRange:  DefaultStoreCertRange,
+			BurnTxRange:     DefaultBurntTxRange,
+			StatesRetention: DefaultStatesRetention,
 		},
-		Mempool: GetDefaultMempoolConfig(),
+		Mempool:   GetDefaultMempoolConfig(),
+		Log:       GetDefaultLogConfig(),
+		Database:  GetDefaultDatabaseConfig(),
+		Profiling: GetDefaultProfilingConfig(),
 	}
 }
 
@@ -278,12 +400,23 @@ func applyFlags(ctx *cli.Context, cfg *Config) {
 	applyIpfsFlags(ctx, cfg)
 	applyValidationFlags(ctx, cfg)
 	applySyncFlags(ctx, cfg)
+	ApplyLogFlags(ctx, cfg.Log)
+	applyProfilingFlags(ctx, cfg.Profiling)
 }
 
 func applyCommonFlags(ctx *cli.Context, cfg *Config) {
 	if ctx.IsSet(AutoOnline.Name) {
 		cfg.AutoOnline = ctx.Bool(AutoOnline.Name)
 	}
+	if ctx.Bool(ArchiveFlag.Name) {
+		applyArchiveMode(cfg)
+	}
+	if ctx.IsSet(StatesRetentionFlag.Name) {
+		cfg.Blockchain.StatesRetention = ctx.Int(StatesRetentionFlag.Name)
+	}
+	if ctx.Bool(IndexAddressesFlag.Name) {
+		cfg.Blockchain.IndexAddresses = true
+	}
 }
 
 func applySyncFlags(ctx *cli.Context, cfg *Config) {
@@ -314,9 +447,30 @@ func applyRpcFlags(ctx *cli.Context, cfg *Config) {
 	if ctx.IsSet(RpcPortFlag.Name) {
 		cfg.RPC.HTTPPort = ctx.Int(RpcPortFlag.Name)
 	}
+	if ctx.IsSet(WsHostFlag.Name) {
+		cfg.RPC.WSHost = ctx.String(WsHostFlag.Name)
+	}
+	if ctx.IsSet(WsPortFlag.Name) {
+		cfg.RPC.WSPort = ctx.Int(WsPortFlag.Name)
+	}
+	if ctx.IsSet(MetricsHostFlag.Name) {
+		cfg.RPC.MetricsHost = ctx.String(MetricsHostFlag.Name)
+	}
+	if ctx.IsSet(MetricsPortFlag.Name) {
+		cfg.RPC.MetricsPort = ctx.Int(MetricsPortFlag.Name)
+	}
 	if ctx.IsSet(ApiKeyFlag.Name) {
 		cfg.RPC.APIKey = ctx.String(ApiKeyFlag.Name)
 	}
+	if ctx.IsSet(RpcTLSCertFlag.Name) {
+		cfg.RPC.TLSCertFile = ctx.String(RpcTLSCertFlag.Name)
+	}
+	if ctx.IsSet(RpcTLSKeyFlag.Name) {
+		cfg.RPC.TLSKeyFile = ctx.String(RpcTLSKeyFlag.Name)
+	}
+	if ctx.IsSet(IpcPathFlag.Name) {
+		cfg.RPC.IPCPath = ctx.String(IpcPathFlag.Name)
+	}
 }
 
 func applyGenesisFlags(ctx *cli.Context, cfg *Config) {
@@ -340,6 +494,12 @@ func applyIpfsFlags(ctx *cli.Context, cfg *Config) {
 	if ctx.IsSet(IpfsBootNodeFlag.Name) {
 		cfg.IpfsConf.BootNodes = []string{ctx.String(IpfsBootNodeFlag.Name)}
 	}
+	if ctx.IsSet(NatFlag.Name) {
+		cfg.IpfsConf.Nat = ctx.String(NatFlag.Name)
+	}
+	if ctx.IsSet(ListenIpsFlag.Name) {
+		cfg.IpfsConf.ListenIps = strings.Split(ctx.String(ListenIpsFlag.Name), ",")
+	}
 }
 
 func applyValidationFlags(ctx *cli.Context, cfg *Config) {
@@ -351,13 +511,14 @@ func loadConfig(configPath string, conf *Config) error {
 		return errors.Errorf("Config file cannot be found, path: %v", configPath)
 	}
 
-	if jsonFile, err := os.Open(configPath); err != nil {
+	if file, err := os.Open(configPath); err != nil {
 		return errors.Errorf("Config file cannot be opened, path: %v", configPath)
 	} else {
-		byteValue, _ := ioutil.ReadAll(jsonFile)
-		err := json.Unmarshal(byteValue, &conf)
+		byteValue, _ := ioutil.ReadAll(file)
+		format := FileFormat(configPath)
+		err := unmarshalConfig(byteValue, format, conf)
 		if err != nil {
-			return errors.Wrap(err, errors.Errorf("Cannot parse JSON config, path: %v", configPath).Error())
+			return errors.Wrap(err, errors.Errorf("Cannot parse %v config, path: %v", strings.ToUpper(format), configPath).Error())
 		}
 		return nil
 	}
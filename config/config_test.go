@@ -0,0 +1,256 @@
+package config
+
+import (
+	"flag"
+	"github.com/idena-network/idena-go/common"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func makeTestConfig(args ...string) (*Config, error) {
+	set := flag.NewFlagSet("test", flag.ContinueOnError)
+	for _, f := range []cli.Flag{CfgFileFlag, DataDirFlag, ArchiveFlag, FastSyncFlag, StatesRetentionFlag, IndexAddressesFlag, IpfsBootNodeFlag, ProfileFlag, MinFeePerByteFlag, NatFlag, CheckpointsFlag, VerbosityFlag, LogFormatFlag, DbBackendFlag, RpcTLSCertFlag, RpcTLSKeyFlag, IpcPathFlag, PprofHostFlag, PprofPortFlag, ResourceLogIntervalFlag, ListenIpsFlag} {
+		f.Apply(set)
+	}
+	if err := set.Parse(args); err != nil {
+		return nil, err
+	}
+	return MakeConfig(cli.NewContext(cli.NewApp(), set, nil), func(cfg *Config) {})
+}
+
+func TestMakeConfig_pruning(t *testing.T) {
+	cfg, err := makeTestConfig()
+	require.NoError(t, err)
+	require.Equal(t, DefaultStatesRetention, cfg.Blockchain.StatesRetention)
+	require.True(t, cfg.Sync.FastSync)
+
+	cfg, err = makeTestConfig("--statesretention", "500")
+	require.NoError(t, err)
+	require.Equal(t, 500, cfg.Blockchain.StatesRetention)
+
+	_, err = makeTestConfig("--statesretention", "10")
+	require.Error(t, err)
+
+	cfg, err = makeTestConfig("--indexaddresses")
+	require.NoError(t, err)
+	require.True(t, cfg.Blockchain.IndexAddresses)
+
+	cfg, err = makeTestConfig("--archive")
+	require.NoError(t, err)
+	require.False(t, cfg.Sync.FastSync)
+	require.Equal(t, float32(1), cfg.IpfsConf.BlockPinThreshold)
+
+	_, err = makeTestConfig("--archive", "--fast")
+	require.Error(t, err)
+}
+
+func TestMakeConfig_discovery(t *testing.T) {
+	cfg, err := makeTestConfig()
+	require.NoError(t, err)
+	require.Equal(t, "dht", cfg.IpfsConf.Routing)
+	require.Equal(t, DefaultIpfsBootstrapNodes, cfg.IpfsConf.BootNodes)
+	require.Equal(t, DefaultMaxOutboundNotOwnShardPeers, cfg.P2P.MaxOutboundPeers)
+
+	bootNode := "/ip4/127.0.0.1/tcp/40405/ipfs/QmNYWtiwM1UfeCmHfWSdefrMuQdg6nycY5yS64HYqWCUhD"
+	cfg, err = makeTestConfig("--ipfsbootnode", bootNode)
+	require.NoError(t, err)
+	require.Equal(t, []string{bootNode}, cfg.IpfsConf.BootNodes)
+
+	cfg, err = makeTestConfig("--profile", LowPowerProfile)
+	require.NoError(t, err)
+	require.Equal(t, "dhtclient", cfg.IpfsConf.Routing)
+	require.Equal(t, LowPowerMaxOutboundNotOwnShardPeers, cfg.P2P.MaxOutboundPeers)
+}
+
+func TestMakeConfig_minFeePerByte(t *testing.T) {
+	cfg, err := makeTestConfig()
+	require.NoError(t, err)
+	require.Nil(t, cfg.Mempool.MinFeePerByte)
+
+	cfg, err = makeTestConfig("--minfeeperbyte", "1000000000000000")
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(1e15), cfg.Mempool.MinFeePerByte)
+
+	_, err = makeTestConfig("--minfeeperbyte", "0.1")
+	require.Error(t, err)
+	_, err = makeTestConfig("--minfeeperbyte", "-1")
+	require.Error(t, err)
+}
+
+func TestMakeConfig_nat(t *testing.T) {
+	cfg, err := makeTestConfig()
+	require.NoError(t, err)
+	require.Empty(t, cfg.IpfsConf.Nat)
+
+	for _, value := range []string{NatNone, NatUpnp, NatPmp, "extip:1.2.3.4", "extip:2001:db8::1"} {
+		cfg, err = makeTestConfig("--nat", value)
+		require.NoError(t, err, value)
+		require.Equal(t, value, cfg.IpfsConf.Nat)
+	}
+
+	for _, value := range []string{"any", "upnp:1.2.3.4", "extip", "extip:", "extip:host"} {
+		_, err = makeTestConfig("--nat", value)
+		require.Error(t, err, value)
+	}
+
+	mode, ip, err := ParseNat("extip:1.2.3.4")
+	require.NoError(t, err)
+	require.Equal(t, NatExtIp, mode)
+	require.Equal(t, "1.2.3.4", ip.String())
+}
+
+func TestMakeConfig_listenIps(t *testing.T) {
+	cfg, err := makeTestConfig()
+	require.NoError(t, err)
+	require.Empty(t, cfg.IpfsConf.ListenIps)
+	ips, err := ParseListenIps(cfg.IpfsConf.ListenIps)
+	require.NoError(t, err)
+	require.Equal(t, []string{"0.0.0.0", "::"}, []string{ips[0].String(), ips[1].String()})
+
+	cfg, err = makeTestConfig("--listenips", "192.168.1.2, 2001:db8::1")
+	require.NoError(t, err)
+	ips, err = ParseListenIps(cfg.IpfsConf.ListenIps)
+	require.NoError(t, err)
+	require.Equal(t, []string{"192.168.1.2", "2001:db8::1"}, []string{ips[0].String(), ips[1].String()})
+
+	_, err = makeTestConfig("--listenips", "0.0.0.0,host")
+	require.Error(t, err)
+}
+
+func TestMakeConfig_checkpoints(t *testing.T) {
+	cfg, err := makeTestConfig()
+	require.NoError(t, err)
+	require.Empty(t, cfg.BlockCheckpoints())
+
+	hash1 := "0x" + strings.Repeat("01", 32)
+	hash2 := strings.Repeat("ab", 32)
+	cfg, err = makeTestConfig("--checkpoints", "100:"+hash1+", 200:"+hash2)
+	require.NoError(t, err)
+	require.Equal(t, map[uint64]string{100: hash1, 200: hash2}, cfg.BlockCheckpoints())
+
+	for _, value := range []string{"100", "100:0x01", "a:" + hash1, "100:" + hash1 + ":1", "100:0x" + strings.Repeat("zz", 32)} {
+		_, err = makeTestConfig("--checkpoints", value)
+		require.Error(t, err, value)
+	}
+}
+
+func TestMakeConfig_dbBackend(t *testing.T) {
+	cfg, err := makeTestConfig()
+	require.NoError(t, err)
+	require.Equal(t, DbBackendGoLevelDb, cfg.Database.Backend)
+
+	cfg, err = makeTestConfig("--dbbackend", DbBackendBadgerDb)
+	require.NoError(t, err)
+	require.Equal(t, DbBackendBadgerDb, cfg.Database.Backend)
+
+	_, err = makeTestConfig("--dbbackend", "rocksdb")
+	require.Error(t, err)
+}
+
+func TestMakeConfig_profiling(t *testing.T) {
+	cfg, err := makeTestConfig()
+	require.NoError(t, err)
+	require.Empty(t, cfg.Profiling.PprofEndpoint())
+	require.Zero(t, cfg.Profiling.ResourceLogInterval)
+
+	cfg, err = makeTestConfig("--pprofaddr", "localhost", "--resourcelog", "1m")
+	require.NoError(t, err)
+	require.Equal(t, "localhost:6060", cfg.Profiling.PprofEndpoint())
+	require.Equal(t, time.Minute, cfg.Profiling.ResourceLogInterval)
+}
+
+func TestMakeConfig_rpc(t *testing.T) {
+	cfg, err := makeTestConfig("--rpctlscert", "cert.pem", "--rpctlskey", "key.pem", "--ipcpath", "idena.ipc")
+	require.NoError(t, err)
+	require.Equal(t, "cert.pem", cfg.RPC.TLSCertFile)
+	require.Equal(t, "key.pem", cfg.RPC.TLSKeyFile)
+	require.Equal(t, "idena.ipc", cfg.RPC.IPCPath)
+
+	_, err = makeTestConfig("--rpctlscert", "cert.pem")
+	require.Error(t, err)
+}
+
+func TestMakeConfig_file(t *testing.T) {
+	for _, name := range []string{"config.toml", "config.json"} {
+		cfg, err := makeTestConfig()
+		require.NoError(t, err)
+		cfg.P2P.MaxInboundPeersPerIP = 5
+		cfg.RPC.HTTPPort = 9100
+		cfg.Log.Verbosity = 4
+		cfg.Mempool.MinFeePerByte = big.NewInt(100)
+		path := filepath.Join(t.TempDir(), name)
+		data, err := MarshalConfig(cfg, FileFormat(path))
+		require.NoError(t, err)
+		require.NoError(t, ioutil.WriteFile(path, data, 0644))
+
+		loaded, err := makeTestConfig("--config", path)
+		require.NoError(t, err, name)
+		require.Equal(t, 5, loaded.P2P.MaxInboundPeersPerIP, name)
+		require.Equal(t, 9100, loaded.RPC.HTTPPort, name)
+		require.Equal(t, 4, loaded.Log.Verbosity, name)
+		require.Equal(t, big.NewInt(100), loaded.Mempool.MinFeePerByte, name)
+		require.Equal(t, cfg.Consensus, loaded.Consensus, name)
+
+		// flags override the file
+		loaded, err = makeTestConfig("--config", path, "--verbosity", "2", "--fast=false")
+		require.NoError(t, err, name)
+		require.Equal(t, 2, loaded.Log.Verbosity, name)
+		require.False(t, loaded.Sync.FastSync, name)
+		require.Equal(t, 5, loaded.P2P.MaxInboundPeersPerIP, name)
+	}
+
+	path := filepath.Join(t.TempDir(), "config.toml")
+	require.NoError(t, ioutil.WriteFile(path, []byte("[P2P]\nMaxInboundPeers = \"many\"\n"), 0644))
+	_, err := makeTestConfig("--config", path)
+	require.Error(t, err)
+
+	_, err = makeTestConfig("--log.format", "xml")
+	require.Error(t, err)
+}
+
+func TestMakeConfig_genesis(t *testing.T) {
+	dataDir := t.TempDir()
+	cfg, err := makeTestConfig("--datadir", dataDir)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0x1), cfg.Network)
+
+	writeSpec := func(spec string) string {
+		path := filepath.Join(t.TempDir(), "genesis.json")
+		require.NoError(t, ioutil.WriteFile(path, []byte(spec), 0644))
+		return path
+	}
+	_, err = InitGenesis(dataDir, writeSpec(`{"network": 1, "firstCeremonyTime": 1700000000}`))
+	require.Error(t, err)
+	_, err = InitGenesis(dataDir, writeSpec(`{"network": 2, "firstCeremonyTime": 1700000000}`))
+	require.Error(t, err)
+	_, err = InitGenesis(dataDir, writeSpec(`{"network": 100}`))
+	require.Error(t, err)
+	_, err = InitGenesis(dataDir, writeSpec(`{"network": 100, "firstCeremonyTime": 1700000000, "alloc": {"0x0000000000000000000000000000000000000002": {"balance": -1}}}`))
+	require.Error(t, err)
+
+	_, err = InitGenesis(dataDir, writeSpec(`{"network": 100, "firstCeremonyTime": 1700000000, "alloc": {"0x0000000000000000000000000000000000000002": {"state": 9}}}`))
+	require.Error(t, err)
+
+	_, err = InitGenesis(dataDir, writeSpec(`{
+  "network": 100,
+  "godAddress": "0x0000000000000000000000000000000000000001",
+  "firstCeremonyTime": 1700000000,
+  "godAddressInvites": 5,
+  "alloc": {"0x0000000000000000000000000000000000000002": {"balance": 1000, "stake": 10, "state": 3}}
+}`))
+	require.NoError(t, err)
+
+	cfg, err = makeTestConfig("--datadir", dataDir)
+	require.NoError(t, err)
+	require.Equal(t, uint32(100), cfg.Network)
+	require.Equal(t, common.HexToAddress("0x1"), cfg.GenesisConf.GodAddress)
+	require.Equal(t, int64(1700000000), cfg.GenesisConf.FirstCeremonyTime)
+	require.Equal(t, uint16(5), cfg.GenesisConf.GodAddressInvites)
+	require.Equal(t, GenesisAllocation{Balance: big.NewInt(1000), Stake: big.NewInt(10), State: 3}, cfg.GenesisConf.Alloc[common.HexToAddress("0x2")])
+}
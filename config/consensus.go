@@ -60,6 +60,7 @@ type ConsensusConf struct {
 	UnlockStakeAge                    uint8
 	EnableUpgrade11                   bool
 	EnableUpgrade12                   bool
+	EnableTxNetwork                   bool
 }
 
 type ConsensusVerson uint16
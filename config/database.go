@@ -0,0 +1,41 @@
+package config
+
+import (
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+const (
+	DbBackendGoLevelDb = "goleveldb"
+	// DbBackendBadgerDb is available in builds with the badgerdb tag
+	DbBackendBadgerDb = "badgerdb"
+	// DbBackendBoltDb is available in builds with the boltdb tag
+	DbBackendBoltDb = "boltdb"
+)
+
+// DatabaseConfig defines the storage of the chain db
+type DatabaseConfig struct {
+	// Backend is the key-value store of the chain db, an existing db can't be opened with another backend
+	Backend string
+}
+
+func GetDefaultDatabaseConfig() *DatabaseConfig {
+	return &DatabaseConfig{
+		Backend: DbBackendGoLevelDb,
+	}
+}
+
+func applyDatabaseFlags(ctx *cli.Context, cfg *DatabaseConfig) {
+	if ctx.IsSet(DbBackendFlag.Name) {
+		cfg.Backend = ctx.String(DbBackendFlag.Name)
+	}
+}
+
+func validateDatabaseConfig(cfg *DatabaseConfig) error {
+	switch cfg.Backend {
+	case DbBackendGoLevelDb, DbBackendBadgerDb, DbBackendBoltDb:
+		return nil
+	default:
+		return errors.Errorf("unknown db backend %v", cfg.Backend)
+	}
+}
@@ -0,0 +1,48 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/BurntSushi/toml"
+	"github.com/pkg/errors"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	FileFormatJson = "json"
+	FileFormatToml = "toml"
+)
+
+// FileFormat detects the format of a config file by its extension, files without the .toml extension are read as JSON
+func FileFormat(path string) string {
+	if strings.EqualFold(filepath.Ext(path), ".toml") {
+		return FileFormatToml
+	}
+	return FileFormatJson
+}
+
+// MarshalConfig encodes the config in the given format, the result can be loaded with --config,
+// maps with integer keys like SyncConfig.BlockCheckpoints can be encoded in JSON only
+func MarshalConfig(cfg *Config, format string) ([]byte, error) {
+	switch format {
+	case FileFormatJson:
+		return json.MarshalIndent(cfg, "", "  ")
+	case FileFormatToml:
+		buf := new(bytes.Buffer)
+		if err := toml.NewEncoder(buf).Encode(cfg); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	default:
+		return nil, errors.Errorf("unknown config format %v", format)
+	}
+}
+
+func unmarshalConfig(data []byte, format string, cfg *Config) error {
+	if format == FileFormatToml {
+		_, err := toml.Decode(string(data), cfg)
+		return err
+	}
+	return json.Unmarshal(data, cfg)
+}
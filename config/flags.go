@@ -15,14 +15,21 @@ const (
 	DefaultSwarmKey           = "00d6f96bb2b02a7308ad87938d6139a974b555cc029ce416641a60c46db2f531"
 	DefaultForceFullSync      = 100
 	DefaultStoreCertRange     = 2000
+	// MinStatesRetention is the depth of forks which can be resolved, the state is reset to the common block of a fork
+	MinStatesRetention     = 100
+	DefaultStatesRetention = MinStatesRetention
 
 	DefaultMaxInboundOwnShardPeers     = 8
 	DefaultMaxOutboundOwnShardPeers    = 4
 	DefaultMaxInboundNotOwnShardPeers  = 4
 	DefaultMaxOutboundNotOwnShardPeers = 2
+	DefaultMaxInboundPeersPerIP        = 2
+	DefaultMaxInboundPeersPerSubnet    = 4
 
 	DefaultBurntTxRange = 4320
 
+	DefaultMinPeerScore = -100
+
 	LowPowerMaxInboundOwnShardPeers     = 3
 	LowPowerMaxOutboundOwnShardPeers    = 2
 	LowPowerMaxInboundNotOwnShardPeers  = 1
@@ -49,6 +56,10 @@ var (
 		Name:  "datadir",
 		Usage: "datadir for blockchain",
 	}
+	GenesisFlag = cli.StringFlag{
+		Name:  "genesis",
+		Usage: "Genesis spec of a private network in JSON",
+	}
 	TcpPortFlag = cli.IntFlag{
 		Name:  "port",
 		Usage: "Network listening port",
@@ -61,6 +72,34 @@ var (
 		Name:  "rpcport",
 		Usage: "RPC listening port",
 	}
+	WsHostFlag = cli.StringFlag{
+		Name:  "wsaddr",
+		Usage: "WebSocket RPC listening address",
+	}
+	WsPortFlag = cli.IntFlag{
+		Name:  "wsport",
+		Usage: "WebSocket RPC listening port",
+	}
+	MetricsHostFlag = cli.StringFlag{
+		Name:  "metricsaddr",
+		Usage: "Prometheus metrics listening address, metrics are not exposed if it's not set",
+	}
+	MetricsPortFlag = cli.IntFlag{
+		Name:  "metricsport",
+		Usage: "Prometheus metrics listening port",
+	}
+	PprofHostFlag = cli.StringFlag{
+		Name:  "pprofaddr",
+		Usage: "pprof HTTP listening address, profiling endpoints are not exposed if it's not set",
+	}
+	PprofPortFlag = cli.IntFlag{
+		Name:  "pprofport",
+		Usage: "pprof HTTP listening port",
+	}
+	ResourceLogIntervalFlag = cli.DurationFlag{
+		Name:  "resourcelog",
+		Usage: "Interval of logging goroutines, heap usage and open connections, e.g. 1m",
+	}
 	BootNodeFlag = cli.StringFlag{
 		Name:  "bootnode",
 		Usage: "Bootstrap node url",
@@ -77,6 +116,14 @@ var (
 		Name:  "ipfsport",
 		Usage: "Ipfs port",
 	}
+	NatFlag = cli.StringFlag{
+		Name:  "nat",
+		Usage: "NAT traversal mode: upnp, pmp, extip:<ip> or none",
+	}
+	ListenIpsFlag = cli.StringFlag{
+		Name:  "listenips",
+		Usage: "Comma separated IPs the p2p host listens on, e.g. 0.0.0.0,:: for all IPv4 and IPv6 interfaces",
+	}
 	NoDiscoveryFlag = cli.BoolFlag{
 		Name:  "nodiscovery",
 		Usage: "NoDiscovery can be used to disable the peer discovery mechanism.",
@@ -114,10 +161,30 @@ var (
 		Name:  "ipfsportstatic",
 		Usage: "Enable static ipfs port",
 	}
+	UnlockFlag = cli.StringFlag{
+		Name:  "unlock",
+		Usage: "Address of a keystore account to use as the node key",
+	}
+	PasswordFileFlag = cli.StringFlag{
+		Name:  "password",
+		Usage: "Password file to unlock keystore accounts, the password is prompted if it's not set",
+	}
 	ApiKeyFlag = cli.StringFlag{
 		Name:  "apikey",
 		Usage: "Set RPC api key",
 	}
+	RpcTLSCertFlag = cli.StringFlag{
+		Name:  "rpctlscert",
+		Usage: "PEM certificate file to serve HTTP and WebSocket RPC over TLS",
+	}
+	RpcTLSKeyFlag = cli.StringFlag{
+		Name:  "rpctlskey",
+		Usage: "PEM key file of the RPC TLS certificate",
+	}
+	IpcPathFlag = cli.StringFlag{
+		Name:  "ipcpath",
+		Usage: "Unix domain socket path for local RPC access, relative paths are resolved in the data dir",
+	}
 	LogFileSizeFlag = cli.IntFlag{
 		Name:  "logfilesize",
 		Usage: "Set log file size in KB",
@@ -127,6 +194,39 @@ var (
 		Name:  "logcoloring",
 		Usage: "Use log coloring",
 	}
+	LogLevelFlag = cli.StringFlag{
+		Name:  "log.level",
+		Usage: "Per-module log verbosity, e.g. p2p=debug,consensus=info, an entry without a module overrides --verbosity",
+	}
+	LogFormatFlag = cli.StringFlag{
+		Name:  "log.format",
+		Usage: "Log format: terminal or json",
+		Value: "terminal",
+	}
+	ArchiveFlag = cli.BoolFlag{
+		Name:  "archive",
+		Usage: "Keep bodies of all blocks instead of a random share of them",
+	}
+	StatesRetentionFlag = cli.IntFlag{
+		Name:  "statesretention",
+		Usage: "Number of latest state versions to keep, older ones are pruned",
+	}
+	IndexAddressesFlag = cli.BoolFlag{
+		Name:  "indexaddresses",
+		Usage: "Index transactions and balance history of all addresses for bcn_txsByAddress and bcn_balanceHistory",
+	}
+	CheckpointsFlag = cli.StringFlag{
+		Name:  "checkpoints",
+		Usage: "Comma separated block checkpoints height:hash the synced chain has to match",
+	}
+	MinFeePerByteFlag = cli.StringFlag{
+		Name:  "minfeeperbyte",
+		Usage: "Minimal fee per byte of accepted txs in the smallest DNA units, zero disables the limit",
+	}
+	DbBackendFlag = cli.StringFlag{
+		Name:  "dbbackend",
+		Usage: "Chain db backend: goleveldb, badgerdb or boltdb, the last two require builds with the same tags",
+	}
 	AutoOnline = cli.BoolFlag{
 		Name:  "autoonline",
 		Usage: "Node will automatically turn on online mining status",
@@ -1,10 +1,24 @@
 package config
 
 import (
+	"encoding/json"
 	"github.com/idena-network/idena-go/common"
+	"github.com/pkg/errors"
+	"io/ioutil"
 	"math/big"
+	"os"
+	"path/filepath"
 )
 
+// GenesisFileName is the name of the genesis spec of a private network in the datadir
+const GenesisFileName = "genesis.json"
+
+// maxIdentityState is the last identity state, state.Human
+const maxIdentityState = 8
+
+// reservedNetworks can't be used by private networks
+var reservedNetworks = map[uint32]struct{}{0x0: {}, 0x1: {}, 0x2: {}}
+
 type GenesisAllocation struct {
 	Balance *big.Int
 	Stake   *big.Int
@@ -17,3 +31,89 @@ type GenesisConf struct {
 	FirstCeremonyTime int64
 	GodAddressInvites uint16
 }
+
+// GenesisSpec describes the genesis block of a private network, nodes of the network have to use the same spec
+// to generate the same genesis block
+type GenesisSpec struct {
+	// Network must differ from the public ones, peers of other networks are rejected during handshake
+	Network           uint32
+	GodAddress        common.Address
+	FirstCeremonyTime int64
+	GodAddressInvites uint16
+	Alloc             map[common.Address]GenesisAllocation
+}
+
+// LoadGenesisSpec reads and validates a genesis spec in JSON
+func LoadGenesisSpec(path string) (*GenesisSpec, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	spec := new(GenesisSpec)
+	if err := json.Unmarshal(data, spec); err != nil {
+		return nil, errors.Wrapf(err, "cannot parse genesis %v", path)
+	}
+	if err := spec.validate(); err != nil {
+		return nil, errors.Wrapf(err, "invalid genesis %v", path)
+	}
+	return spec, nil
+}
+
+func (spec *GenesisSpec) validate() error {
+	if _, ok := reservedNetworks[spec.Network]; ok {
+		return errors.Errorf("network %v is reserved", spec.Network)
+	}
+	// the ceremony time is stored in the genesis state, the current time would make the genesis block differ between nodes
+	if spec.FirstCeremonyTime <= 0 {
+		return errors.New("first ceremony time is required")
+	}
+	for addr, alloc := range spec.Alloc {
+		if alloc.Balance != nil && alloc.Balance.Sign() < 0 || alloc.Stake != nil && alloc.Stake.Sign() < 0 {
+			return errors.Errorf("negative allocation of %v", addr.Hex())
+		}
+		if alloc.State > maxIdentityState {
+			return errors.Errorf("unknown identity state %v of %v", alloc.State, addr.Hex())
+		}
+	}
+	return nil
+}
+
+// InitGenesis validates the genesis spec and copies it to the datadir, the genesis block is generated
+// from the spec when the node starts for the first time
+func InitGenesis(dataDir string, specPath string) (*GenesisSpec, error) {
+	spec, err := LoadGenesisSpec(specPath)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(filepath.Join(dataDir, "idenachain.db")); err == nil {
+		return nil, errors.Errorf("datadir %v already contains a chain", dataDir)
+	}
+	data, err := json.MarshalIndent(spec, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(dataDir, 0755); err != nil {
+		return nil, err
+	}
+	return spec, ioutil.WriteFile(filepath.Join(dataDir, GenesisFileName), data, 0644)
+}
+
+// applyDataDirGenesis makes the node run the private network initialized in the datadir, if there is any
+func applyDataDirGenesis(cfg *Config) error {
+	path := filepath.Join(cfg.DataDir, GenesisFileName)
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return nil
+	}
+	spec, err := LoadGenesisSpec(path)
+	if err != nil {
+		return err
+	}
+	cfg.Network = spec.Network
+	cfg.GenesisConf = &GenesisConf{
+		Alloc:             spec.Alloc,
+		GodAddress:        spec.GodAddress,
+		FirstCeremonyTime: spec.FirstCeremonyTime,
+		GodAddressInvites: spec.GodAddressInvites,
+	}
+	return nil
+}
@@ -1,6 +1,18 @@
 package config
 
-import "time"
+import (
+	"github.com/pkg/errors"
+	"net"
+	"strings"
+	"time"
+)
+
+const (
+	NatNone  = "none"
+	NatUpnp  = "upnp"
+	NatPmp   = "pmp"
+	NatExtIp = "extip"
+)
 
 type IpfsConfig struct {
 	DataDir            string
@@ -17,7 +29,11 @@ type IpfsConfig struct {
 	BlockPinThreshold  float32
 	FlipPinThreshold   float32
 	PublishPeers       bool
-	Gc                 IpfsGcConfig
+	// Nat is the NAT traversal mode: upnp, pmp, extip:<ip> or none, empty keeps the setting of the IPFS profile
+	Nat string
+	// ListenIps are the interfaces the p2p host listens on with IpfsPort, all IPv4 and IPv6 ones are used if it's empty
+	ListenIps []string
+	Gc        IpfsGcConfig
 }
 
 type IpfsGcConfig struct {
@@ -42,3 +58,45 @@ func GetDefaultIpfsConfig() *IpfsConfig {
 		},
 	}
 }
+
+// DefaultListenIps are the unspecified IPv4 and IPv6 addresses, so the host listens on all interfaces of both families
+var DefaultListenIps = []string{"0.0.0.0", "::"}
+
+// ParseListenIps returns the IPs the p2p host listens on, the default ones are returned if none are set
+func ParseListenIps(values []string) ([]net.IP, error) {
+	if len(values) == 0 {
+		values = DefaultListenIps
+	}
+	ips := make([]net.IP, 0, len(values))
+	for _, value := range values {
+		ip := net.ParseIP(strings.TrimSpace(value))
+		if ip == nil {
+			return nil, errors.Errorf("invalid listen IP %v", value)
+		}
+		ips = append(ips, ip)
+	}
+	return ips, nil
+}
+
+// ParseNat splits the NAT traversal setting into the mode and the external IP of the extip mode
+func ParseNat(value string) (mode string, extIp net.IP, err error) {
+	mode = value
+	if i := strings.IndexByte(value, ':'); i >= 0 {
+		mode = value[:i]
+	}
+	switch mode {
+	case "", NatNone, NatUpnp, NatPmp:
+		if mode != value {
+			return "", nil, errors.Errorf("invalid NAT mode %v", value)
+		}
+		return mode, nil, nil
+	case NatExtIp:
+		extIp = net.ParseIP(strings.TrimPrefix(value, NatExtIp+":"))
+		if extIp == nil {
+			return "", nil, errors.Errorf("invalid external IP in NAT mode %v", value)
+		}
+		return mode, extIp, nil
+	default:
+		return "", nil, errors.Errorf("unknown NAT mode %v", value)
+	}
+}
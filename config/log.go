@@ -0,0 +1,74 @@
+package config
+
+import (
+	"github.com/idena-network/idena-go/log"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+const (
+	LogFormatTerminal = "terminal"
+	LogFormatJson     = "json"
+)
+
+// LogConfig defines node logging, log flags override the values
+type LogConfig struct {
+	// Verbosity is the log level of modules without a level in Level, from 0 (crit) to 5 (trace)
+	Verbosity int
+	// Level sets per-module log levels, e.g. p2p=debug,consensus=info, an entry without a module overrides Verbosity
+	Level string
+	// Format is either terminal or json
+	Format string
+	// FileSize is the size of a log file in KB
+	FileSize int
+	// Coloring enables colored terminal output on Windows, it's always used on other platforms
+	Coloring bool
+}
+
+func GetDefaultLogConfig() *LogConfig {
+	return &LogConfig{
+		Verbosity: VerbosityFlag.Value,
+		Format:    LogFormatFlag.Value,
+		FileSize:  LogFileSizeFlag.Value,
+	}
+}
+
+// ApplyLogFlags overrides the log config with the log flags which are set
+func ApplyLogFlags(ctx *cli.Context, cfg *LogConfig) {
+	if ctx.IsSet(VerbosityFlag.Name) {
+		cfg.Verbosity = ctx.Int(VerbosityFlag.Name)
+	}
+	if ctx.IsSet(LogLevelFlag.Name) {
+		cfg.Level = ctx.String(LogLevelFlag.Name)
+	}
+	if ctx.IsSet(LogFormatFlag.Name) {
+		cfg.Format = ctx.String(LogFormatFlag.Name)
+	}
+	if ctx.IsSet(LogFileSizeFlag.Name) {
+		cfg.FileSize = ctx.Int(LogFileSizeFlag.Name)
+	}
+	if ctx.IsSet(LogColoring.Name) {
+		cfg.Coloring = ctx.Bool(LogColoring.Name)
+	}
+}
+
+// ModuleLevels parses the log levels of the config
+func (cfg *LogConfig) ModuleLevels() (log.ModuleLevels, error) {
+	levels, err := log.ParseModuleLevels(cfg.Level, log.Lvl(cfg.Verbosity))
+	if err != nil {
+		return levels, errors.Wrap(err, "invalid log level")
+	}
+	return levels, nil
+}
+
+func validateLogConfig(cfg *LogConfig) error {
+	if _, err := cfg.ModuleLevels(); err != nil {
+		return err
+	}
+	switch cfg.Format {
+	case LogFormatTerminal, LogFormatJson:
+		return nil
+	default:
+		return errors.Errorf("unknown log format %v", cfg.Format)
+	}
+}
@@ -1,6 +1,9 @@
 package config
 
-import "time"
+import (
+	"math/big"
+	"time"
+)
 
 type Mempool struct {
 	TxPoolQueueSlots      int
@@ -10,6 +13,10 @@ type Mempool struct {
 	TxPoolAddrExecutableLimit int
 	TxLifetime                time.Duration
 	ResetInCeremony           bool
+	// TxReplacementBump is the minimal percentage by which fees of a tx must exceed the pooled tx with the same nonce to replace it
+	TxReplacementBump int
+	// MinFeePerByte is the minimal max fee and tips per byte of a tx which the node accepts, own txs included, and relays, nil or zero disables the limit
+	MinFeePerByte *big.Int
 }
 
 func GetDefaultMempoolConfig() *Mempool {
@@ -20,5 +27,6 @@ func GetDefaultMempoolConfig() *Mempool {
 		TxPoolAddrQueueLimit:      32,
 		TxPoolAddrExecutableLimit: 32,
 		TxLifetime:                time.Hour * 3,
+		TxReplacementBump:         10,
 	}
 }
@@ -1,14 +1,111 @@
 package config
 
+import "time"
+
 type P2P struct {
 	MaxInboundPeers  int
 	MaxOutboundPeers int
 
 	MaxInboundOwnShardPeers  int
 	MaxOutboundOwnShardPeers int
+	// MaxInboundPeersPerIP and MaxInboundPeersPerSubnet limit inbound peers from a single IP and from a single /24 subnet
+	// (/64 for IPv6), the limits are checked before handshake, zero disables a limit, loopback and pinned peers aren't limited
+	MaxInboundPeersPerIP     int
+	MaxInboundPeersPerSubnet int
 
 	MaxDelay       int
 	DisableMetrics bool
 	Multishard     bool
 	Shared         bool
+
+	MinPeerScore       int
+	MinProtocolVersion uint32
+	TrustedPeers       []string
+	ObserveNetworks    []uint32
+	// StaticPeers are multiaddrs with peer ids, the node keeps connections to them regardless of peer limits
+	StaticPeers []string
+	// MaxPeerHeightAhead rejects peers which report a height ahead of the local head by more blocks during handshake
+	MaxPeerHeightAhead uint64
+	PingInterval       time.Duration
+	PingMissThreshold  int
+	// OwnTxPeersOrder defines which peers receive own transactions first: "trusted", "latency" or "random", trusted and then low latency peers are preferred by default
+	OwnTxPeersOrder string
+
+	// PeerSelector defines which peer is asked for blocks when a batch is retried, "latency" prefers the fastest peer which has the blocks,
+	// the highest peer is asked otherwise
+	PeerSelector string
+
+	// BansFile keeps temporary bans of peers across restarts, bans are kept in memory only if it's empty
+	BansFile string
+	// PeersFile keeps addresses and scores of good peers across restarts, the best of them are dialed at start,
+	// peers are kept in memory only if it's empty
+	PeersFile string
+
+	// MaxPoolSyncTxs limits the number of mempool transaction hashes exchanged when the mempool is synced with a peer after start
+	MaxPoolSyncTxs int
+
+	// MsgCacheSize limits the number of message keys remembered per peer to skip sending known messages, a default is used if it's zero
+	MsgCacheSize int
+	// MsgCacheTTL is how long a message key is remembered per peer, a default is used if it's zero
+	MsgCacheTTL time.Duration
+
+	// KnownTxsFilterFPRate enables tracking of transaction and flip pushes known by peers with Bloom filters of the given false positive rate
+	// instead of the message cache, it saves memory at the cost of rarely skipped pushes, zero disables the filters
+	KnownTxsFilterFPRate float64
+
+	// MaxOutboundBytesPerSecondPerPeer limits upload rate to a single peer, zero means no limit
+	MaxOutboundBytesPerSecondPerPeer int
+	// MaxServedBytesPerSecondPerPeer limits blocks, headers and proofs served to a single peer, its requests are dropped
+	// while the limit is exceeded, zero means no limit
+	MaxServedBytesPerSecondPerPeer int
+	// MaxBlockRangeResponseSize is the max size of a single blocks range message, larger responses are split
+	MaxBlockRangeResponseSize int
+	// MaxBlocksPerRange limits the number of blocks a peer may request at once, larger own requests are split
+	MaxBlocksPerRange uint64
+
+	// back-off between attempts to load the same batch of blocks
+	SyncRetryInitialDelay time.Duration
+	SyncRetryMaxDelay     time.Duration
+	SyncRetryMultiplier   float64
+
+	// PeerQueues defines capacities of outgoing message queues of a single peer
+	PeerQueues PeerQueueConfig
+
+	// delays between attempts to reconnect to a dropped trusted peer
+	ReconnectInitialDelay time.Duration
+	ReconnectMaxDelay     time.Duration
+
+	// ShutdownDrainTimeout bounds the time spent on sending queued messages to peers when the node stops
+	ShutdownDrainTimeout time.Duration
+
+	// DisableLightServing rejects light clients during handshake and ignores their requests for headers and state proofs
+	DisableLightServing bool
+
+	RateLimits map[string]MsgRateLimit
+
+	SeenCaches map[string]SeenCache
+}
+
+// PeerQueueConfig contains capacities of peer queues, zero or negative values are replaced with defaults
+type PeerQueueConfig struct {
+	Requests             int
+	HighPriorityRequests int
+	ConsensusRequests    int
+	Pushes               int
+	FlipKeys             int
+	Votes                int
+	// BlockRanges is the max number of block range responses waiting to be sent
+	BlockRanges int
+}
+
+type MsgRateLimit struct {
+	Rate  float64
+	Burst int
+	// BytesPerSecond limits the payload size of inbound messages, zero means no limit
+	BytesPerSecond int
+}
+
+type SeenCache struct {
+	Size int
+	TTL  time.Duration
 }
@@ -24,6 +24,13 @@ func applySharedNodeProfile(cfg *Config) {
 	cfg.IpfsConf.HighWater = 100
 }
 
+// applyArchiveMode keeps bodies of all blocks, they are pinned in ipfs and loaded with full sync.
+// State history is pruned regardless of the mode.
+func applyArchiveMode(cfg *Config) {
+	cfg.IpfsConf.BlockPinThreshold = 1
+	cfg.Sync.FastSync = false
+}
+
 func applyDefaultProfile(cfg *Config) {
 	cfg.P2P.MaxInboundPeers = DefaultMaxInboundNotOwnShardPeers
 	cfg.P2P.MaxOutboundPeers = DefaultMaxOutboundNotOwnShardPeers
@@ -0,0 +1,44 @@
+package config
+
+import (
+	"fmt"
+	"github.com/urfave/cli"
+	"time"
+)
+
+const DefaultPprofPort = 6060
+
+// ProfilingConfig enables diagnostics of the running node
+type ProfilingConfig struct {
+	// PprofHost is the interface of the net/http/pprof endpoint, the endpoint is not opened if it's empty
+	PprofHost string
+	PprofPort int
+	// ResourceLogInterval is the period of logging goroutines, heap usage and open connections, zero disables the logging
+	ResourceLogInterval time.Duration
+}
+
+func GetDefaultProfilingConfig() *ProfilingConfig {
+	return &ProfilingConfig{
+		PprofPort: DefaultPprofPort,
+	}
+}
+
+// PprofEndpoint returns the address of the pprof endpoint or an empty string if it's disabled
+func (cfg *ProfilingConfig) PprofEndpoint() string {
+	if cfg.PprofHost == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s:%d", cfg.PprofHost, cfg.PprofPort)
+}
+
+func applyProfilingFlags(ctx *cli.Context, cfg *ProfilingConfig) {
+	if ctx.IsSet(PprofHostFlag.Name) {
+		cfg.PprofHost = ctx.String(PprofHostFlag.Name)
+	}
+	if ctx.IsSet(PprofPortFlag.Name) {
+		cfg.PprofPort = ctx.Int(PprofPortFlag.Name)
+	}
+	if ctx.IsSet(ResourceLogIntervalFlag.Name) {
+		cfg.ResourceLogInterval = ctx.Duration(ResourceLogIntervalFlag.Name)
+	}
+}
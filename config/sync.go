@@ -1,10 +1,59 @@
 package config
 
-import "time"
+import (
+	"encoding/hex"
+	"github.com/idena-network/idena-go/common"
+	"github.com/pkg/errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MainnetBlockCheckpoints are the hard-coded block checkpoints of the mainnet, they are checked along with
+// the configured SyncConfig.BlockCheckpoints
+var MainnetBlockCheckpoints = map[uint64]string{}
 
 type SyncConfig struct {
 	FastSync            bool
 	ForceFullSync       uint64
 	LoadAllFlips        bool
 	AllFlipsLoadingTime time.Duration
+	// TrustedCheckpoints maps a snapshot height to the expected state root, if it is not empty only matching manifests are used for fast sync
+	TrustedCheckpoints map[uint64]string
+	// BlockCheckpoints maps a block height to the expected block hash, peers serving conflicting blocks during sync are banned
+	BlockCheckpoints map[uint64]string
+	// MinTipConfirmations is the number of peers which have to report a height before the node syncs up to it
+	MinTipConfirmations int
+	// MaxSyncPeers limits the number of peers blocks are loaded from in parallel, zero means all suitable peers
+	MaxSyncPeers int
+}
+
+// ParseBlockCheckpoints parses comma separated height:hash block checkpoints
+func ParseBlockCheckpoints(value string) (map[uint64]string, error) {
+	checkpoints := make(map[uint64]string)
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		parts := strings.Split(item, ":")
+		if len(parts) != 2 {
+			return nil, errors.Errorf("invalid block checkpoint %v, height:hash is expected", item)
+		}
+		height, err := strconv.ParseUint(parts[0], 10, 64)
+		if err != nil {
+			return nil, errors.Errorf("invalid block checkpoint height %v", parts[0])
+		}
+		checkpoints[height] = parts[1]
+	}
+	return checkpoints, validateBlockCheckpoints(checkpoints)
+}
+
+func validateBlockCheckpoints(checkpoints map[uint64]string) error {
+	for height, hash := range checkpoints {
+		if b, err := hex.DecodeString(strings.TrimPrefix(hash, "0x")); err != nil || len(b) != common.HashLength {
+			return errors.Errorf("invalid block checkpoint hash %v at height %v", hash, height)
+		}
+	}
+	return nil
 }
@@ -23,18 +23,25 @@ import (
 	"github.com/idena-network/idena-go/secstore"
 	"github.com/idena-network/idena-go/stats/collector"
 	"github.com/pkg/errors"
+	"github.com/rcrowley/go-metrics"
 	"github.com/shopspring/decimal"
 	math2 "math"
+	"path/filepath"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
 const (
 	MaxStoredAvgTimeDiffs = 20
+
+	walFile = "consensus.wal"
 )
 
 var (
 	ForkDetected = errors.New("fork is detected")
+
+	errEngineStopped = errors.New("consensus engine is stopped")
 )
 
 type Engine struct {
@@ -65,6 +72,17 @@ type Engine struct {
 	upgrader          *upgrade.Upgrader
 	eventBus          eventbus.Bus
 	statsCollector    collector.StatsCollector
+	roundTimer        metrics.Timer
+	failedRounds      metrics.Counter
+	// round is the current consensus round, it's attached to log records of the engine
+	round uint64
+	term  chan struct{}
+	done  chan struct{}
+	wal   *voteWal
+	// restoredVotes are own votes of the current round made before restart, they are repeated instead of new ones
+	restoredVotes map[uint8]*types.Vote
+	// miningPaused is set while the node follows the chain without proposing blocks and voting
+	miningPaused int32
 }
 
 func NewEngine(chain *blockchain.Blockchain, gossipHandler *protocol.IdenaGossipHandler, proposals *pengings.Proposals, config *config.Config,
@@ -76,10 +94,9 @@ func NewEngine(chain *blockchain.Blockchain, gossipHandler *protocol.IdenaGossip
 	ipfsProxy ipfs.Proxy,
 	eventBus eventbus.Bus,
 	statsCollector collector.StatsCollector) *Engine {
-	return &Engine{
+	engine := &Engine{
 		chain:             chain,
 		pm:                gossipHandler,
-		log:               log.New(),
 		cfg:               config,
 		proposals:         proposals,
 		appState:          appState,
@@ -94,18 +111,94 @@ func NewEngine(chain *blockchain.Blockchain, gossipHandler *protocol.IdenaGossip
 		ipfsProxy:         ipfsProxy,
 		eventBus:          eventBus,
 		statsCollector:    statsCollector,
+		roundTimer:        metrics.GetOrRegisterTimer("consensus.round", nil),
+		failedRounds:      metrics.GetOrRegisterCounter("consensus.failedRounds", nil),
+		term:              make(chan struct{}),
+		done:              make(chan struct{}),
 	}
+	engine.log = log.New(log.ModuleKey, "consensus", "round", log.Lazy{Fn: engine.currentRound})
+	return engine
+}
+
+func (engine *Engine) currentRound() uint64 {
+	return atomic.LoadUint64(&engine.round)
 }
 
 func (engine *Engine) Start() {
 	engine.pubKey = engine.secStore.GetPubKey()
 	engine.addr = engine.secStore.GetAddress()
-	log.Info("Start consensus protocol", "pubKey", hexutil.Encode(engine.pubKey))
+	engine.log.Info("Start consensus protocol", "pubKey", hexutil.Encode(engine.pubKey))
 	engine.forkResolver.Start()
+	engine.openWal()
+	metrics.GetOrRegister("consensus.synced", metrics.NewFunctionalGauge(func() int64 {
+		if engine.synced {
+			return 1
+		}
+		return 0
+	}))
 	go engine.loop()
 	go engine.ntpTimeDriftUpdate()
 }
 
+// Stop interrupts the current round or sync attempt, no block is added after that, it returns when the loop is finished
+func (engine *Engine) Stop() {
+	select {
+	case <-engine.term:
+	default:
+		close(engine.term)
+	}
+	<-engine.done
+	if engine.wal != nil {
+		engine.wal.Close()
+	}
+}
+
+func (engine *Engine) stopped() bool {
+	select {
+	case <-engine.term:
+		return true
+	default:
+		return false
+	}
+}
+
+// sleep returns false if the engine is stopped before the duration elapses
+func (engine *Engine) sleep(d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-engine.term:
+		return false
+	}
+}
+
+// openWal opens the log of own votes and replays votes of the current round made before restart
+func (engine *Engine) openWal() {
+	if engine.cfg.DataDir == "" {
+		return
+	}
+	wal, err := openVoteWal(filepath.Join(engine.cfg.DataDir, walFile))
+	if err != nil {
+		engine.log.Error("Failed to open consensus wal, own votes won't be persisted", "err", err)
+		return
+	}
+	engine.wal = wal
+	head := engine.chain.Head
+	engine.restoredVotes = make(map[uint8]*types.Vote)
+	for _, vote := range wal.Votes(head.Height() + 1) {
+		if vote.Header.ParentHash != head.Hash() {
+			continue
+		}
+		engine.restoredVotes[vote.Header.Step] = vote
+		engine.votes.AddVote(vote)
+	}
+	if len(engine.restoredVotes) > 0 {
+		engine.log.Info("Own votes restored from wal", "count", len(engine.restoredVotes))
+	}
+}
+
 func (engine *Engine) GetProcess() string {
 	return engine.process
 }
@@ -144,7 +237,7 @@ func (engine *Engine) alignTime() {
 		diff := engine.cfg.Consensus.MinBlockDistance - correctedNow.Sub(headTime)
 		diff = time.Duration(math.MinInt(int(diff), int(maxDelay)))
 		if diff > 0 {
-			time.Sleep(diff)
+			engine.sleep(diff)
 		}
 	}
 }
@@ -157,10 +250,17 @@ func (engine *Engine) calculateTimeDiff(round uint64, roundStart time.Time) {
 }
 
 func (engine *Engine) loop() {
+	defer close(engine.done)
 	for {
+		select {
+		case <-engine.term:
+			engine.log.Info("Consensus engine is stopped")
+			return
+		default:
+		}
 		if err := engine.chain.EnsureIntegrity(); err != nil {
 			engine.log.Error("Failed to recover blockchain", "err", err)
-			time.Sleep(time.Second * 30)
+			engine.sleep(time.Second * 30)
 			continue
 		}
 
@@ -168,6 +268,9 @@ func (engine *Engine) loop() {
 
 		if err := engine.downloader.SyncBlockchain(engine.forkResolver); err != nil {
 			engine.synced = false
+			if engine.stopped() {
+				continue
+			}
 			if engine.forkResolver.HasLoadedFork() {
 				if revertedTxs, err := engine.forkResolver.ApplyFork(); err == nil {
 					if len(revertedTxs) > 0 {
@@ -178,18 +281,18 @@ func (engine *Engine) loop() {
 				}
 			} else {
 				engine.log.Warn("syncing error", "err", err)
-				time.Sleep(time.Second * 5)
+				engine.waitForPeerHeight(time.Second * 5)
 			}
 			continue
 		}
 
 		if !engine.cfg.Consensus.Automine && !engine.pm.HasPeers() {
-			time.Sleep(time.Second * 5)
+			engine.sleep(time.Second * 5)
 			engine.synced = false
 			continue
 		}
 		if err := engine.checkOnlineStatus(); err != nil {
-			log.Warn("error while trying to ensure online status", "err", err)
+			engine.log.Warn("error while trying to ensure online status", "err", err)
 		}
 
 		engine.synced = true
@@ -197,11 +300,14 @@ func (engine *Engine) loop() {
 
 		startMiningTime := time.Date(2022, 10, 19, 0, 0, 0, 0, time.UTC)
 		if diff := startMiningTime.Sub(time.Now().UTC()); diff > 0 {
-			log.Info("sleep before mining due to hotfix", "duration", diff.String())
-			time.Sleep(diff)
+			engine.log.Info("sleep before mining due to hotfix", "duration", diff.String())
+			if !engine.sleep(diff) {
+				continue
+			}
 		}
 
 		round := head.Height() + 1
+		atomic.StoreUint64(&engine.round, round)
 		engine.completeRound(round - 1)
 
 		engine.alignTime()
@@ -210,7 +316,7 @@ func (engine *Engine) loop() {
 		roundStart := time.Now().UTC()
 
 		shardId, _ := engine.chain.CoinbaseShard()
-		engine.log.Info("Start loop", "round", round, "head", head.Hash().Hex(), "shardId", shardId, "p2p-shardId", engine.pm.OwnPeeringShardId(), "total-peers",
+		engine.log.Info("Start loop", "head", head.Hash().Hex(), "shardId", shardId, "p2p-shardId", engine.pm.OwnPeeringShardId(), "total-peers",
 			engine.pm.PeersCount(), "own-shard-peers", engine.pm.OwnShardPeersCount(), "online-nodes", engine.appState.ValidatorsCache.OnlineSize(),
 			"network", engine.appState.ValidatorsCache.NetworkSize())
 
@@ -219,11 +325,11 @@ func (engine *Engine) loop() {
 		isProposer, proposerProof := engine.chain.GetProposerSortition()
 
 		var block *types.Block
-		if isProposer {
+		if isProposer && engine.MiningEnabled() {
 			engine.process = "Propose block"
 			block = engine.proposeBlock(proposerProof)
 			if block != nil {
-				engine.log.Info("Selected as proposer", "block", block.Hash().Hex(), "round", round, "thresholdVrf", engine.appState.State.VrfProposerThreshold())
+				engine.log.Info("Selected as proposer", "block", block.Hash().Hex(), "thresholdVrf", engine.appState.State.VrfProposerThreshold())
 			}
 		}
 
@@ -251,9 +357,16 @@ func (engine *Engine) loop() {
 		}
 
 		blockHash := engine.reduction(round, block, extraDelayForReductionOne)
+		if engine.stopped() {
+			continue
+		}
 		blockHash, cert, err := engine.binaryBa(blockHash)
+		if err == errEngineStopped {
+			continue
+		}
 		if err != nil {
 			engine.log.Info("Binary Ba is failed", "err", err)
+			engine.failedRounds.Inc(1)
 
 			if err == ForkDetected {
 				if revertedTxs, err := engine.forkResolver.ApplyFork(); err != nil {
@@ -271,6 +384,9 @@ func (engine *Engine) loop() {
 		var finalCert *types.FullBlockCert
 		if blockHash != emptyBlock.Hash() {
 			hash, finalCert, err = engine.countVotes(round, types.Final, block.Header.ParentHash(), engine.chain.GetCommitteeVotesThreshold(engine.appState.ValidatorsCache, true), engine.cfg.Consensus.WaitForStepDelay)
+			if err == errEngineStopped {
+				continue
+			}
 			if err == nil && hash != blockHash {
 				engine.log.Info("Switched to final", "prev", blockHash.Hex(), "final", hash.Hex())
 				blockHash = hash
@@ -304,6 +420,17 @@ func (engine *Engine) loop() {
 			}
 		}
 		engine.prevRoundDuration = time.Now().UTC().Sub(roundStart)
+		engine.roundTimer.Update(engine.prevRoundDuration)
+	}
+}
+
+// waitForPeerHeight sleeps for the given duration or until a peer reports a chain taller than the local one
+func (engine *Engine) waitForPeerHeight(timeout time.Duration) {
+	select {
+	case update := <-engine.pm.HeightUpdates():
+		engine.log.Debug("Peer reported a taller chain", "peer", update.Id, "height", update.Height)
+	case <-time.After(timeout):
+	case <-engine.term:
 	}
 }
 
@@ -357,14 +484,14 @@ func (engine *Engine) proposeBlock(proof []byte) *types.Block {
 }
 
 func (engine *Engine) getHighestProposerPubKey(round uint64) []byte {
-	time.Sleep(engine.cfg.Consensus.EstimatedBaVariance + engine.cfg.Consensus.WaitSortitionProofDelay)
+	engine.sleep(engine.cfg.Consensus.EstimatedBaVariance + engine.cfg.Consensus.WaitSortitionProofDelay)
 	return engine.proposals.GetProposerPubKey(round)
 }
 
 func (engine *Engine) waitForBlock(proposerPubKey []byte) (*types.Block, time.Duration) {
 	engine.log.Info("Wait for block proposal")
 	now := time.Now()
-	block, err := engine.proposals.GetProposedBlock(engine.chain.Round(), proposerPubKey, engine.cfg.Consensus.WaitBlockDelay)
+	block, err := engine.proposals.GetProposedBlock(engine.chain.Round(), proposerPubKey, engine.cfg.Consensus.WaitBlockDelay, engine.term)
 	notUsedDelay := engine.cfg.Consensus.WaitBlockDelay - time.Since(now)
 	if err != nil {
 		engine.log.Error("Proposed block is not found", "err", err.Error())
@@ -421,6 +548,9 @@ func (engine *Engine) binaryBa(blockHash common.Hash) (common.Hash, *types.FullB
 		engine.vote(round, step, hash)
 
 		hash, cert, err := engine.countVotes(round, step, emptyBlock.Header.ParentHash(), engine.chain.GetCommitteeVotesThreshold(engine.appState.ValidatorsCache, false), engine.cfg.Consensus.WaitForStepDelay)
+		if err == errEngineStopped {
+			return common.Hash{}, nil, err
+		}
 		if err != nil {
 			hash = blockHash
 		} else if hash != emptyBlockHash {
@@ -439,7 +569,9 @@ func (engine *Engine) binaryBa(blockHash common.Hash) (common.Hash, *types.FullB
 		engine.vote(round, step, hash)
 
 		hash, cert, err = engine.countVotes(round, step, emptyBlock.Header.ParentHash(), engine.chain.GetCommitteeVotesThreshold(engine.appState.ValidatorsCache, false), engine.cfg.Consensus.WaitForStepDelay)
-
+		if err == errEngineStopped {
+			return common.Hash{}, nil, err
+		}
 		if err != nil {
 			hash = emptyBlockHash
 		} else if hash == emptyBlockHash {
@@ -462,12 +594,20 @@ func (engine *Engine) binaryBa(blockHash common.Hash) (common.Hash, *types.FullB
 }
 
 func (engine *Engine) vote(round uint64, step uint8, block common.Hash) {
+	if !engine.MiningEnabled() {
+		return
+	}
 	committeeSize := engine.chain.GetCommitteeSize(engine.appState.ValidatorsCache, step == types.Final)
 	stepValidators := engine.appState.ValidatorsCache.GetOnlineValidators(engine.chain.Head.Seed(), round, step, committeeSize)
 	if stepValidators == nil {
 		return
 	}
 	if stepValidators.CanVote(engine.addr) {
+		if restored := engine.takeRestoredVote(round, step); restored != nil {
+			engine.pm.SendVote(restored)
+			engine.log.Info("Repeated vote made before restart", "step", step, "block", restored.Header.VotedHash.Hex())
+			return
+		}
 		vote := types.Vote{
 			Header: &types.VoteHeader{
 				Round:      round,
@@ -482,6 +622,11 @@ func (engine *Engine) vote(round uint64, step uint8, block common.Hash) {
 		}
 		hash := crypto.SignatureHash(&vote)
 		vote.Signature = engine.secStore.Sign(hash[:])
+		if engine.wal != nil {
+			if err := engine.wal.Write(&vote); err != nil {
+				engine.log.Error("Failed to persist own vote", "err", err)
+			}
+		}
 		engine.pm.SendVote(&vote)
 
 		engine.log.Info("Voted for", "step", step, "block", block.Hex())
@@ -492,6 +637,18 @@ func (engine *Engine) vote(round uint64, step uint8, block common.Hash) {
 	}
 }
 
+func (engine *Engine) takeRestoredVote(round uint64, step uint8) *types.Vote {
+	vote, ok := engine.restoredVotes[step]
+	if !ok {
+		return nil
+	}
+	delete(engine.restoredVotes, step)
+	if vote.Header.Round != round || vote.Header.ParentHash != engine.chain.Head.Hash() {
+		return nil
+	}
+	return vote
+}
+
 func (engine *Engine) countVotes(round uint64, step uint8, parentHash common.Hash, necessaryVotesCount int, timeout time.Duration) (common.Hash, *types.FullBlockCert, error) {
 
 	engine.log.Debug("Start count votes", "step", step, "min-votes", necessaryVotesCount)
@@ -566,7 +723,9 @@ func (engine *Engine) countVotes(round uint64, step uint8, parentHash common.Has
 				return bestHash, &cert, nil
 			}
 		}
-		time.Sleep(500 * time.Millisecond)
+		if !engine.sleep(500 * time.Millisecond) {
+			return common.Hash{}, nil, errEngineStopped
+		}
 	}
 	hash := common.Hash{}
 	err := errors.New(fmt.Sprintf("votes for step is not received, step=%v", step))
@@ -587,8 +746,8 @@ func (engine *Engine) getBlockByHash(round uint64, hash common.Hash) (*types.Blo
 		if block != nil {
 			engine.log.Info("Block was received successfully", "hash", block.Hash().Hex())
 			return block, nil
-		} else {
-			time.Sleep(100 * time.Millisecond)
+		} else if !engine.sleep(100 * time.Millisecond) {
+			return nil, errEngineStopped
 		}
 	}
 
@@ -612,7 +771,7 @@ func (engine *Engine) Synced() bool {
 
 func (engine *Engine) checkOnlineStatus() error {
 
-	if !engine.cfg.AutoOnline {
+	if !engine.cfg.AutoOnline || !engine.MiningEnabled() {
 		return nil
 	}
 
@@ -35,7 +35,7 @@ func NewForkResolver(forkDetectors []ForkDetector, downloader *protocol.Download
 		forkDetectors:  forkDetectors,
 		downloader:     downloader,
 		chain:          chain,
-		log:            log.New(),
+		log:            log.New(log.ModuleKey, "consensus", "component", "forkResolver"),
 		triedPeers:     mapset.NewSet(),
 		statsCollector: statsCollector,
 	}
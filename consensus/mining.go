@@ -0,0 +1,19 @@
+package consensus
+
+import "sync/atomic"
+
+// SetMining pauses or resumes proposing blocks and voting, the node keeps syncing while mining is paused.
+// An online identity which doesn't mine is reported offline by other validators after a while
+func (engine *Engine) SetMining(enabled bool) {
+	var paused int32
+	if !enabled {
+		paused = 1
+	}
+	if atomic.SwapInt32(&engine.miningPaused, paused) != paused {
+		engine.log.Info("Mining status changed", "enabled", enabled)
+	}
+}
+
+func (engine *Engine) MiningEnabled() bool {
+	return atomic.LoadInt32(&engine.miningPaused) == 0
+}
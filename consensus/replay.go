@@ -0,0 +1,126 @@
+package consensus
+
+import (
+	"github.com/idena-network/idena-go/blockchain"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/database"
+	"github.com/pkg/errors"
+	"path/filepath"
+)
+
+// RoundReport describes how the block of a round was agreed, it's rebuilt from the stored chain and the vote wal
+// without network and state access, so replaying the same data always gives the same reports
+type RoundReport struct {
+	Round    uint64
+	Hash     common.Hash
+	Empty    bool
+	Proposer common.Address
+	// SeedErr is the error of the seed proof check of the proposer, nil means that the proof is valid
+	SeedErr error
+	// Cert is nil if the certificate of the block isn't stored, certificates are kept for some blocks only
+	Cert *CertTally
+	// OwnVotes are the votes of the round found in the wal
+	OwnVotes []*types.Vote
+}
+
+// CertTally counts the votes of a block certificate
+type CertTally struct {
+	Step        uint8
+	VotedHash   common.Hash
+	Signatures  int
+	Voters      int
+	Invalid     int
+	TurnOffline int
+	Upgrades    map[uint32]int
+}
+
+// ReadWalVotes returns votes persisted in the wal of the data dir
+func ReadWalVotes(dataDir string) ([]*types.Vote, error) {
+	return readWalVotes(filepath.Join(dataDir, walFile))
+}
+
+// ReplayRounds walks canonical blocks from..to and passes a report of every round to the callback,
+// the proposer of a block is checked by its seed proof and votes are recovered from the certificate signatures
+func ReplayRounds(repo *database.Repo, from, to uint64, walVotes []*types.Vote, report func(*RoundReport)) error {
+	if from == 0 {
+		return errors.New("genesis block has no round")
+	}
+	prevBlock := readCanonicalHeader(repo, from-1)
+	if prevBlock == nil {
+		return errors.Errorf("block %d is not found", from-1)
+	}
+	for height := from; height <= to; height++ {
+		header := readCanonicalHeader(repo, height)
+		if header == nil {
+			return errors.Errorf("block %d is not found", height)
+		}
+		result := &RoundReport{
+			Round: height,
+			Hash:  header.Hash(),
+			Empty: header.EmptyBlockHeader != nil,
+		}
+		if !result.Empty {
+			result.Proposer = header.Coinbase()
+			result.SeedErr = blockchain.VerifySeed(prevBlock, header)
+		}
+		if cert := repo.ReadCertificate(header.Hash()); cert != nil {
+			result.Cert = tallyCert(prevBlock, cert)
+		}
+		for _, vote := range walVotes {
+			if vote.Header.Round == height {
+				result.OwnVotes = append(result.OwnVotes, vote)
+			}
+		}
+		report(result)
+		prevBlock = header
+	}
+	return nil
+}
+
+func readCanonicalHeader(repo *database.Repo, height uint64) *types.Header {
+	hash := repo.ReadCanonicalHash(height)
+	if hash == (common.Hash{}) {
+		return nil
+	}
+	return repo.ReadBlockHeader(hash)
+}
+
+// tallyCert restores votes of the certificate the same way as the certificate validation does, signatures
+// which don't recover a voter are counted as invalid
+func tallyCert(prevBlock *types.Header, cert *types.BlockCert) *CertTally {
+	tally := &CertTally{
+		Step:       cert.Step,
+		VotedHash:  cert.VotedHash,
+		Signatures: len(cert.Signatures),
+		Upgrades:   make(map[uint32]int),
+	}
+	voters := make(map[common.Address]struct{})
+	for _, signature := range cert.Signatures {
+		vote := types.Vote{
+			Header: &types.VoteHeader{
+				Step:        cert.Step,
+				Round:       cert.Round,
+				TurnOffline: signature.TurnOffline,
+				Upgrade:     signature.Upgrade,
+				VotedHash:   cert.VotedHash,
+				ParentHash:  prevBlock.Hash(),
+			},
+			Signature: signature.Signature,
+		}
+		addr := vote.VoterAddr()
+		if addr == (common.Address{}) {
+			tally.Invalid++
+			continue
+		}
+		voters[addr] = struct{}{}
+		if signature.TurnOffline {
+			tally.TurnOffline++
+		}
+		if signature.Upgrade > 0 {
+			tally.Upgrades[signature.Upgrade]++
+		}
+	}
+	tally.Voters = len(voters)
+	return tally
+}
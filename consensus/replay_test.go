@@ -0,0 +1,44 @@
+package consensus
+
+import (
+	"github.com/idena-network/idena-go/blockchain"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/crypto"
+	"github.com/idena-network/idena-go/database"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestReplayRounds(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	chain, _ := blockchain.NewCustomTestBlockchain(0, 0, key)
+	defer chain.SecStore().Destroy()
+	from := chain.Head.Height() + 1
+	chain.GenerateBlocks(2, 0).GenerateEmptyBlocks(1)
+	to := chain.Head.Height()
+	coinbase := chain.SecStore().GetAddress()
+
+	wal := []*types.Vote{walVote(from+1, types.ReductionOne), walVote(from+1, types.Final)}
+	var reports []*RoundReport
+	require.NoError(t, ReplayRounds(database.NewRepo(chain.Db()), from, to, wal, func(report *RoundReport) {
+		reports = append(reports, report)
+	}))
+	require.Len(t, reports, 3)
+	for i, report := range reports {
+		require.Equal(t, from+uint64(i), report.Round)
+		require.Equal(t, chain.GetBlockHeaderByHeight(report.Round).Hash(), report.Hash)
+		require.NotNil(t, report.Cert)
+		require.Equal(t, report.Hash, report.Cert.VotedHash)
+		require.Equal(t, 1, report.Cert.Signatures)
+		require.Equal(t, 1, report.Cert.Voters)
+		require.Zero(t, report.Cert.Invalid)
+	}
+	require.False(t, reports[0].Empty)
+	require.Equal(t, coinbase, reports[0].Proposer)
+	require.NoError(t, reports[0].SeedErr)
+	require.Empty(t, reports[0].OwnVotes)
+	require.Len(t, reports[1].OwnVotes, 2)
+	require.True(t, reports[2].Empty)
+
+	require.Error(t, ReplayRounds(database.NewRepo(chain.Db()), from, to+1, nil, func(*RoundReport) {}))
+}
@@ -0,0 +1,153 @@
+package consensus
+
+import (
+	"bufio"
+	"encoding/binary"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/pkg/errors"
+	"io"
+	"os"
+	"sync"
+)
+
+const maxWalRecordSize = 1024 * 1024
+
+// voteWal is a write-ahead log of own votes of the latest round. Votes are written before they are sent,
+// so after a crash the node repeats them instead of voting differently for the same step.
+type voteWal struct {
+	path  string
+	file  *os.File
+	round uint64
+	votes map[uint8]*types.Vote
+	mutex sync.Mutex
+}
+
+// openVoteWal reads votes of the latest round from the file and opens it to append new votes, a corrupted tail is dropped
+func openVoteWal(path string) (*voteWal, error) {
+	w := &voteWal{
+		path:  path,
+		votes: make(map[uint8]*types.Vote),
+	}
+	votes, err := readWalVotes(path)
+	if err != nil {
+		return nil, err
+	}
+	for _, vote := range votes {
+		w.remember(vote)
+	}
+	if err := w.rewrite(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func readWalVotes(path string) ([]*types.Vote, error) {
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	reader := bufio.NewReader(file)
+	var votes []*types.Vote
+	for {
+		var size uint32
+		if err := binary.Read(reader, binary.BigEndian, &size); err != nil {
+			break
+		}
+		if size > maxWalRecordSize {
+			break
+		}
+		data := make([]byte, size)
+		if _, err := io.ReadFull(reader, data); err != nil {
+			break
+		}
+		vote := new(types.Vote)
+		if err := vote.FromBytes(data); err != nil {
+			break
+		}
+		votes = append(votes, vote)
+	}
+	return votes, nil
+}
+
+func (w *voteWal) remember(vote *types.Vote) {
+	if vote.Header.Round < w.round {
+		return
+	}
+	if vote.Header.Round > w.round {
+		w.round = vote.Header.Round
+		w.votes = make(map[uint8]*types.Vote)
+	}
+	w.votes[vote.Header.Step] = vote
+}
+
+// rewrite replaces the file with remembered votes only
+func (w *voteWal) rewrite() error {
+	if w.file != nil {
+		w.file.Close()
+	}
+	file, err := os.OpenFile(w.path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	w.file = file
+	for _, vote := range w.votes {
+		if err := w.append(vote); err != nil {
+			return err
+		}
+	}
+	return w.file.Sync()
+}
+
+func (w *voteWal) append(vote *types.Vote) error {
+	data, err := vote.ToBytes()
+	if err != nil {
+		return err
+	}
+	record := make([]byte, 4+len(data))
+	binary.BigEndian.PutUint32(record, uint32(len(data)))
+	copy(record[4:], data)
+	_, err = w.file.Write(record)
+	return err
+}
+
+// Votes returns persisted own votes of the round
+func (w *voteWal) Votes(round uint64) []*types.Vote {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	if round != w.round {
+		return nil
+	}
+	votes := make([]*types.Vote, 0, len(w.votes))
+	for _, vote := range w.votes {
+		votes = append(votes, vote)
+	}
+	return votes
+}
+
+// Write persists the vote, votes of previous rounds are dropped once a vote of a new round is written
+func (w *voteWal) Write(vote *types.Vote) error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	if vote.Header.Round < w.round {
+		return errors.Errorf("vote of round %v is older than the wal round %v", vote.Header.Round, w.round)
+	}
+	newRound := vote.Header.Round > w.round
+	w.remember(vote)
+	if newRound {
+		return w.rewrite()
+	}
+	if err := w.append(vote); err != nil {
+		return err
+	}
+	return w.file.Sync()
+}
+
+func (w *voteWal) Close() error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	return w.file.Close()
+}
@@ -0,0 +1,57 @@
+package consensus
+
+import (
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func walVote(round uint64, step uint8) *types.Vote {
+	return &types.Vote{
+		Header: &types.VoteHeader{
+			Round:      round,
+			Step:       step,
+			ParentHash: common.Hash{0x1},
+			VotedHash:  common.Hash{step},
+		},
+		Signature: []byte{0x1, 0x2},
+	}
+}
+
+func TestVoteWal(t *testing.T) {
+	path := filepath.Join(t.TempDir(), walFile)
+
+	wal, err := openVoteWal(path)
+	require.NoError(t, err)
+	require.Empty(t, wal.Votes(1))
+	require.NoError(t, wal.Write(walVote(1, types.ReductionOne)))
+	require.NoError(t, wal.Write(walVote(2, types.ReductionOne)))
+	require.NoError(t, wal.Write(walVote(2, types.ReductionTwo)))
+	require.Error(t, wal.Write(walVote(1, types.Final)))
+	require.NoError(t, wal.Close())
+
+	wal, err = openVoteWal(path)
+	require.NoError(t, err)
+	require.Empty(t, wal.Votes(1))
+	votes := wal.Votes(2)
+	require.Len(t, votes, 2)
+	for _, vote := range votes {
+		require.Equal(t, common.Hash{vote.Header.Step}, vote.Header.VotedHash)
+	}
+
+	// a torn record at the end of the file is dropped
+	require.NoError(t, wal.Close())
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0600)
+	require.NoError(t, err)
+	_, err = file.Write([]byte{0, 0, 0, 10, 1})
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	wal, err = openVoteWal(path)
+	require.NoError(t, err)
+	require.Len(t, wal.Votes(2), 2)
+	require.NoError(t, wal.Close())
+}
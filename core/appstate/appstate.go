@@ -42,6 +42,12 @@ func NewAppState(db dbm.DB, bus eventbus.Bus) (*AppState, error) {
 	}, nil
 }
 
+// SetStatesRetention sets the number of latest versions of both state trees kept on commit
+func (s *AppState) SetStatesRetention(count int) {
+	s.State.SetSavedStatesCount(count)
+	s.IdentityState.SetSavedStatesCount(count)
+}
+
 func (s *AppState) ProvideIdentityUpdateHook(hook state.IdentityUpdateHook) {
 	s.State.ProvideIdentityUpdateHook(hook)
 }
@@ -83,6 +83,8 @@ type ValidationCeremony struct {
 	newTxQueue               chan *types.Transaction
 	lottery                  *lottery
 	allFlipsIsLoading        bool
+	// participationPaused is set while the node doesn't send keys, answers and evidence of its identity
+	participationPaused int32
 }
 
 type flipWordsInfo struct {
@@ -119,7 +121,7 @@ type blockHandler func(block *types.Block)
 
 func NewValidationCeremony(appState *appstate.AppState, bus eventbus.Bus, flipper *flip.Flipper, secStore *secstore.SecStore, db dbm.DB, mempool *mempool.TxPool,
 	chain *blockchain.Blockchain, syncer protocol.Syncer, keysPool *mempool.KeysPool, config *config.Config) *ValidationCeremony {
-	logger := log.New()
+	logger := log.New(log.ModuleKey, "ceremony")
 	throttlingLogger := log.NewThrottlingLogger(logger)
 	vc := &ValidationCeremony{
 		flipper:            flipper,
@@ -201,6 +203,11 @@ func (vc *ValidationCeremony) Initialize(currentBlock *types.Block) {
 	vc.addBlock(currentBlock)
 }
 
+// Stop waits for the flip lottery calculations which write to the epoch database
+func (vc *ValidationCeremony) Stop() {
+	vc.lottery.wg.Wait()
+}
+
 func (vc *ValidationCeremony) addBlock(block *types.Block) {
 	vc.handleBlock(block)
 	vc.qualification.persist()
@@ -593,6 +600,9 @@ func (vc *ValidationCeremony) calculateCeremonyCandidates(restore bool) {
 }
 
 func (vc *ValidationCeremony) shouldInteractWithNetwork() bool {
+	if !vc.ParticipationEnabled() {
+		return false
+	}
 
 	if !vc.syncer.IsSyncing() {
 		return true
@@ -0,0 +1,19 @@
+package ceremony
+
+import "sync/atomic"
+
+// SetParticipation pauses or resumes participation of the node identity in validation, the ceremony state is still
+// tracked while participation is paused, so it can be resumed during the ceremony
+func (vc *ValidationCeremony) SetParticipation(enabled bool) {
+	var paused int32
+	if !enabled {
+		paused = 1
+	}
+	if atomic.SwapInt32(&vc.participationPaused, paused) != paused {
+		vc.log.Info("Validation participation changed", "enabled", enabled)
+	}
+}
+
+func (vc *ValidationCeremony) ParticipationEnabled() bool {
+	return atomic.LoadInt32(&vc.participationPaused) == 0
+}
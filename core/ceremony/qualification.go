@@ -31,7 +31,7 @@ func NewQualification(config *config.Config, epochDb *database.EpochDb) *qualifi
 	return &qualification{
 		config:       config,
 		epochDb:      epochDb,
-		log:          log.New(),
+		log:          log.New(log.ModuleKey, "ceremony"),
 		shortAnswers: make(map[common.Address][]byte),
 		longAnswers:  make(map[common.Address][]byte),
 	}
@@ -50,6 +50,7 @@ type Flipper struct {
 	flipsQueue       chan *types.Flip
 	flipPublicKey    *ecies.PrivateKey
 	flipPrivateKey   *ecies.PrivateKey
+	flipRequester    func(cid []byte)
 }
 
 type IpfsFlip struct {
@@ -302,10 +303,16 @@ func (fp *Flipper) LoadInMemory(cids [][]byte) {
 
 		cid, _ := cid.Cast(key)
 
+		// the flip may have been received from a peer while it was retried
+		if fp.IsFlipAvailable(key) {
+			continue
+		}
+
 		data, err := fp.ipfsProxy.Get(key, ipfs.Flip)
 
 		if err != nil {
 			fp.log.Warn("Can't get flip by cid", "cid", cid.String(), "err", err)
+			fp.requestFlip(key)
 			cids = append(cids, key)
 			continue
 		}
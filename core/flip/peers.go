@@ -0,0 +1,67 @@
+package flip
+
+import (
+	"bytes"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/crypto"
+	"github.com/idena-network/idena-go/ipfs"
+	"github.com/pkg/errors"
+)
+
+// SetFlipRequester sets the function which asks peers for flips missing in ipfs while they are loaded in memory
+func (fp *Flipper) SetFlipRequester(requester func(cid []byte)) {
+	fp.mutex.Lock()
+	defer fp.mutex.Unlock()
+	fp.flipRequester = requester
+}
+
+func (fp *Flipper) requestFlip(cid []byte) {
+	fp.mutex.RLock()
+	requester := fp.flipRequester
+	fp.mutex.RUnlock()
+	if requester != nil {
+		requester(cid)
+	}
+}
+
+// GetRawFlipFromMemory returns the encoded flip loaded for the validation ceremony, peers are served only from memory,
+// so a request never makes the node fetch the flip from ipfs
+func (fp *Flipper) GetRawFlipFromMemory(key []byte) ([]byte, bool) {
+	fp.mutex.RLock()
+	ipfsFlip := fp.flips[common.Hash(crypto.Hash(key))]
+	fp.mutex.RUnlock()
+	if ipfsFlip == nil {
+		return nil, false
+	}
+	data, err := ipfsFlip.ToBytes()
+	if err != nil {
+		return nil, false
+	}
+	return data, true
+}
+
+// AddFlipFromPeer checks that the data matches the requested cid and loads the flip in memory, the flip is also added
+// to ipfs, so it's kept in the datadir like the flips received by gossip
+func (fp *Flipper) AddFlipFromPeer(key []byte, data []byte) error {
+	if len(data) > common.MaxFlipSize {
+		return errors.Errorf("flip is too big, max expected size %v, actual %v", common.MaxFlipSize, len(data))
+	}
+	c, err := fp.ipfsProxy.Cid(data)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(c.Bytes(), key) {
+		return errors.New("flip cid mismatch")
+	}
+	ipfsFlip := new(IpfsFlip)
+	if err := ipfsFlip.FromBytes(data); err != nil {
+		return err
+	}
+	if _, err := fp.ipfsProxy.Add(data, fp.ipfsProxy.ShouldPin(ipfs.Flip)); err != nil {
+		fp.log.Warn("Failed to add flip received from peer to ipfs", "cid", c.String(), "err", err)
+	}
+	fp.mutex.Lock()
+	fp.flips[common.Hash(crypto.Hash(key))] = ipfsFlip
+	fp.mutex.Unlock()
+	return nil
+}
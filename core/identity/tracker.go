@@ -0,0 +1,104 @@
+package identity
+
+import (
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/eventbus"
+	"github.com/idena-network/idena-go/core/appstate"
+	"github.com/idena-network/idena-go/core/state"
+	"github.com/idena-network/idena-go/events"
+	"github.com/idena-network/idena-go/log"
+	"sync"
+)
+
+// maxTransitions is the number of latest state transitions kept by the tracker
+const maxTransitions = 50
+
+// Transition is a change of the identity state made by a block
+type Transition struct {
+	From   state.IdentityState
+	To     state.IdentityState
+	Height uint64
+	Epoch  uint16
+	Time   int64
+}
+
+// Tracker follows state transitions of the node identity as blocks are applied
+type Tracker struct {
+	address     common.Address
+	appState    *appstate.AppState
+	current     state.IdentityState
+	transitions []Transition
+	initialized bool
+	log         log.Logger
+	mutex       sync.RWMutex
+}
+
+// NewTracker subscribes to applied blocks, they are handled in the order the chain applies them, so the app state
+// matches the block of the event
+func NewTracker(bus eventbus.Bus, appState *appstate.AppState) *Tracker {
+	t := &Tracker{
+		appState: appState,
+		log:      log.New(log.ModuleKey, "identity"),
+	}
+	bus.Subscribe(events.AddBlockEventID, func(e eventbus.Event) {
+		t.onBlock(e.(*events.NewBlockEvent).Block.Header)
+	})
+	return t
+}
+
+// Initialize starts tracking the identity from its state at the current head, the app state has to be initialized
+func (t *Tracker) Initialize(address common.Address) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.address = address
+	t.current = t.appState.State.GetIdentityState(address)
+	t.initialized = true
+}
+
+func (t *Tracker) onBlock(header *types.Header) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if !t.initialized {
+		return
+	}
+	next := t.appState.State.GetIdentityState(t.address)
+	if next == t.current {
+		return
+	}
+	transition := Transition{
+		From:   t.current,
+		To:     next,
+		Height: header.Height(),
+		Epoch:  t.appState.State.Epoch(),
+		Time:   header.Time(),
+	}
+	t.current = next
+	t.transitions = append(t.transitions, transition)
+	if len(t.transitions) > maxTransitions {
+		t.transitions = t.transitions[len(t.transitions)-maxTransitions:]
+	}
+	t.log.Info("Identity state changed", "from", transition.From, "to", transition.To, "height", transition.Height)
+}
+
+func (t *Tracker) Address() common.Address {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	return t.address
+}
+
+// State returns the identity state at the latest applied block
+func (t *Tracker) State() state.IdentityState {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	return t.current
+}
+
+// Transitions returns the latest state transitions starting from the oldest one
+func (t *Tracker) Transitions() []Transition {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	result := make([]Transition, len(t.transitions))
+	copy(result, t.transitions)
+	return result
+}
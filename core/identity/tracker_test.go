@@ -0,0 +1,63 @@
+package identity
+
+import (
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/eventbus"
+	"github.com/idena-network/idena-go/core/appstate"
+	"github.com/idena-network/idena-go/core/state"
+	"github.com/idena-network/idena-go/events"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tm-db"
+	"testing"
+)
+
+func TestTracker_transitions(t *testing.T) {
+	bus := eventbus.New()
+	appState, _ := appstate.NewAppState(db.NewMemDB(), bus)
+	address := common.Address{0x1}
+	appState.State.SetState(address, state.Candidate)
+	require.NoError(t, appState.Commit(nil))
+	require.NoError(t, appState.Initialize(1))
+
+	tracker := NewTracker(bus, appState)
+	addBlock := func(height uint64) {
+		block := &types.Block{Header: &types.Header{ProposedHeader: &types.ProposedHeader{Height: height, Time: int64(height * 20)}}}
+		bus.Publish(&events.NewBlockEvent{Block: block})
+	}
+
+	// blocks are ignored until the tracker is initialized
+	appState.State.SetState(address, state.Newbie)
+	addBlock(2)
+	require.Empty(t, tracker.Transitions())
+
+	appState.State.SetState(address, state.Candidate)
+	tracker.Initialize(address)
+	require.Equal(t, address, tracker.Address())
+	require.Equal(t, state.Candidate, tracker.State())
+
+	addBlock(3)
+	require.Empty(t, tracker.Transitions())
+
+	appState.State.SetState(address, state.Newbie)
+	addBlock(4)
+	appState.State.SetState(address, state.Verified)
+	addBlock(5)
+	require.Equal(t, state.Verified, tracker.State())
+	require.Equal(t, []Transition{
+		{From: state.Candidate, To: state.Newbie, Height: 4, Time: 80},
+		{From: state.Newbie, To: state.Verified, Height: 5, Time: 100},
+	}, tracker.Transitions())
+
+	for i := 0; i < maxTransitions; i++ {
+		if i%2 == 0 {
+			appState.State.SetState(address, state.Suspended)
+		} else {
+			appState.State.SetState(address, state.Verified)
+		}
+		addBlock(uint64(6 + i))
+	}
+	transitions := tracker.Transitions()
+	require.Len(t, transitions, maxTransitions)
+	require.Equal(t, uint64(6), transitions[0].Height)
+}
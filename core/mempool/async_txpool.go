@@ -55,6 +55,14 @@ func (pool *AsyncTxPool) GetPendingTransaction(noFilter bool, addHighPriority bo
 	return pool.txPool.GetPendingTransaction(noFilter, addHighPriority, shardId, count)
 }
 
+func (pool *AsyncTxPool) GetTx(hash common.Hash) *types.Transaction {
+	return pool.txPool.GetTx(hash)
+}
+
+func (pool *AsyncTxPool) IsRelayable(tx *types.Transaction) bool {
+	return pool.txPool.IsRelayable(tx)
+}
+
 func (pool *AsyncTxPool) loop() {
 	for {
 
@@ -91,7 +91,7 @@ func NewKeysPool(db dbm.DB, appState *appstate.AppState, bus eventbus.Bus, secSt
 		db:                        db,
 		appState:                  appState,
 		bus:                       bus,
-		log:                       log.New(),
+		log:                       log.New(log.ModuleKey, "mempool", "component", "keysPool"),
 		flipKeys:                  make(map[common.Address]*types.PublicFlipKey),
 		flipKeysSyncCounts:        make(map[common.Address]int),
 		flipKeyPackages:           make(map[common.Address]*types.PrivateFlipKeysPackage),
@@ -34,10 +34,12 @@ type txKeeper struct {
 	hasChanges bool
 	datadir    string
 	mutex      sync.RWMutex
+	term       chan struct{}
+	wg         sync.WaitGroup
 }
 
 func NewTxKeeper(datadir string) *txKeeper {
-	return &txKeeper{datadir: datadir, txs: make(map[common.Hash]hexutil.Bytes), ch: make(chan interface{}, 20000)}
+	return &txKeeper{datadir: datadir, txs: make(map[common.Hash]hexutil.Bytes), ch: make(chan interface{}, 20000), term: make(chan struct{})}
 }
 
 func (k *txKeeper) persist() error {
@@ -85,10 +87,23 @@ func (k *txKeeper) Load() {
 	}
 	k.mutex.Unlock()
 
+	k.wg.Add(2)
 	go k.loop()
 	go k.persistLoop()
 }
 
+// Stop stops the keeper loops and persists not saved changes
+func (k *txKeeper) Stop() error {
+	close(k.term)
+	k.wg.Wait()
+	k.mutex.RLock()
+	defer k.mutex.RUnlock()
+	if !k.hasChanges {
+		return nil
+	}
+	return k.persist()
+}
+
 func (k *txKeeper) openFile() (file *os.File, err error) {
 	newpath := filepath.Join(k.datadir, Folder)
 	if err := os.MkdirAll(newpath, os.ModePerm); err != nil {
@@ -163,9 +178,14 @@ func (k *txKeeper) Clear() {
 }
 
 func (k *txKeeper) loop() {
-
+	defer k.wg.Done()
 	for {
-		cmd := <-k.ch
+		var cmd interface{}
+		select {
+		case cmd = <-k.ch:
+		case <-k.term:
+			return
+		}
 
 		if add, ok := cmd.(*addCommand); ok {
 			k.addTx(add.tx)
@@ -178,15 +198,30 @@ func (k *txKeeper) loop() {
 }
 
 func (k *txKeeper) persistLoop() {
+	defer k.wg.Done()
 	for {
-		time.Sleep(time.Millisecond * 100)
+		if !k.sleep(time.Millisecond * 100) {
+			return
+		}
 		if k.hasChanges {
 			k.mutex.RLock()
 			err := k.persist()
 			k.mutex.RUnlock()
-			if err == nil {
-				time.Sleep(txKeeperPersistInterval)
+			if err == nil && !k.sleep(txKeeperPersistInterval) {
+				return
 			}
 		}
 	}
 }
+
+// sleep returns false if the keeper is stopped before the duration elapses
+func (k *txKeeper) sleep(d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-k.term:
+		return false
+	}
+}
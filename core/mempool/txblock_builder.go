@@ -1,6 +1,7 @@
 package mempool
 
 import (
+	"container/heap"
 	"github.com/idena-network/idena-go/blockchain/fee"
 	"github.com/idena-network/idena-go/blockchain/types"
 	"github.com/idena-network/idena-go/blockchain/validation"
@@ -87,9 +88,30 @@ func (ctx *buildingContext) addNextPriorityTxToBlock() {
 	ctx.sortedTxsPerSender[sender] = ctx.sortedTxsPerSender[sender][i:]
 }
 
+// addTxsToBlock takes transactions paying the highest tips per gas first, transactions of a sender are taken in nonce order
 func (ctx *buildingContext) addTxsToBlock() {
-	txs := ctx.sortedTxs
-	for _, tx := range txs {
+	queues := make(map[common.Address][]*txByTips)
+	var senders []common.Address
+	for i, tx := range ctx.sortedTxs {
+		sender, _ := types.Sender(tx)
+		if tx.AccountNonce <= ctx.curNoncesPerSender[sender] {
+			continue
+		}
+		if _, ok := queues[sender]; !ok {
+			senders = append(senders, sender)
+		}
+		queues[sender] = append(queues[sender], newTxByTips(tx, i))
+	}
+	heads := make(txsByTips, 0, len(senders))
+	for _, sender := range senders {
+		heads = append(heads, queues[sender][0])
+		queues[sender] = queues[sender][1:]
+	}
+	heap.Init(&heads)
+
+	for heads.Len() > 0 {
+		tx := heap.Pop(&heads).(*txByTips).tx
+		// the next transactions of the sender are dropped as well if this one can't be added
 		if !ctx.checkFee(tx) {
 			continue
 		}
@@ -103,9 +125,59 @@ func (ctx *buildingContext) addTxsToBlock() {
 		ctx.blockTxs = append(ctx.blockTxs, tx)
 		ctx.blockGas += fee.CalculateGas(tx)
 		ctx.curNoncesPerSender[sender] = tx.AccountNonce
+		if queue := queues[sender]; len(queue) > 0 {
+			heap.Push(&heads, queue[0])
+			queues[sender] = queue[1:]
+		}
 	}
 }
 
 func (ctx *buildingContext) checkFee(tx *types.Transaction) bool {
 	return validation.ValidateFee(ctx.appState, tx, validation.InBlockTx, ctx.minFeePerGas) == nil
 }
+
+type txByTips struct {
+	tx         *types.Transaction
+	tipsPerGas *big.Int
+	// index keeps the nonce order of transactions with equal tips
+	index int
+}
+
+func newTxByTips(tx *types.Transaction, index int) *txByTips {
+	return &txByTips{
+		tx:         tx,
+		tipsPerGas: new(big.Int).Div(tx.TipsOrZero(), big.NewInt(int64(fee.CalculateGas(tx)))),
+		index:      index,
+	}
+}
+
+// txsByTips is a heap of transactions ordered by tips per gas in descending order
+type txsByTips []*txByTips
+
+func (h txsByTips) Len() int {
+	return len(h)
+}
+
+func (h txsByTips) Less(i, j int) bool {
+	if cmp := h[i].tipsPerGas.Cmp(h[j].tipsPerGas); cmp != 0 {
+		return cmp > 0
+	}
+	return h[i].index < h[j].index
+}
+
+func (h txsByTips) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+}
+
+func (h *txsByTips) Push(x interface{}) {
+	*h = append(*h, x.(*txByTips))
+}
+
+func (h *txsByTips) Pop() interface{} {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return item
+}
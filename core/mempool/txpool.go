@@ -16,6 +16,7 @@ import (
 	"github.com/idena-network/idena-go/log"
 	"github.com/idena-network/idena-go/stats/collector"
 	"github.com/pkg/errors"
+	"math/big"
 	"sort"
 	"sync"
 	"time"
@@ -27,9 +28,11 @@ const (
 )
 
 var (
-	DuplicateTxError = errors.New("tx with same hash already exists")
-	MempoolFullError = errors.New("mempool is full")
-	priorityTypes    = validation.CeremonialTxs
+	DuplicateTxError            = errors.New("tx with same hash already exists")
+	MempoolFullError            = errors.New("mempool is full")
+	ReplacementUnderpricedError = errors.New("replacement tx is underpriced")
+	UnderpricedTxError          = errors.New("tx fee per byte is below the node minimum")
+	priorityTypes               = validation.CeremonialTxs
 )
 
 type TransactionPool interface {
@@ -37,7 +40,9 @@ type TransactionPool interface {
 	AddExternalTxs(txType validation.TxType, txs ...*types.Transaction) error
 	GetPendingTransaction(noFilter bool, addHighPriority bool, shardId common.ShardId, count bool) []*types.Transaction
 	GetPriorityTransaction() []*types.Transaction
+	GetTx(hash common.Hash) *types.Transaction
 	IsSyncing() bool
+	IsRelayable(tx *types.Transaction) bool
 }
 
 type TxPool struct {
@@ -46,6 +51,7 @@ type TxPool struct {
 	all              *txMap
 	shortHashAll     *shortHashTxMap
 	txSyncCounts     map[common.Hash]int
+	addedAt          map[common.Hash]time.Time
 	executableTxs    map[common.Address]*sortedTxs
 	pendingTxs       map[common.Address]*txMap
 	mempoolCfg       *config.Mempool
@@ -71,12 +77,13 @@ func NewTxPool(appState *appstate.AppState, bus eventbus.Bus, cfg *config.Config
 		executableTxs:    make(map[common.Address]*sortedTxs),
 		pendingTxs:       make(map[common.Address]*txMap),
 		txSyncCounts:     map[common.Hash]int{},
+		addedAt:          map[common.Hash]time.Time{},
 		knownDeferredTxs: mapset.NewSet(),
 		mempoolCfg:       cfg.Mempool,
 		cfg:              cfg,
 		mutex:            &sync.Mutex{},
 		appState:         appState,
-		log:              log.New(),
+		log:              log.New(log.ModuleKey, "mempool"),
 		bus:              bus,
 		statsCollector:   statsCollector,
 		deferredTxs:      make(chan *types.Transaction, MaxDeferredTxs),
@@ -151,6 +158,14 @@ func (pool *TxPool) Initialize(head *types.Header, coinbase common.Address, useT
 	}
 }
 
+// Stop saves transactions of the pool to the datadir if they are kept there
+func (pool *TxPool) Stop() error {
+	if pool.txKeeper == nil {
+		return nil
+	}
+	return pool.txKeeper.Stop()
+}
+
 func (pool *TxPool) addDeferredTx(tx *types.Transaction) {
 	if pool.knownDeferredTxs.Contains(tx.Hash()) {
 		return
@@ -244,6 +259,31 @@ func (pool *TxPool) checkRegularTxLimits(tx *types.Transaction) error {
 	return nil
 }
 
+// SetSizeLimits changes the number of pending and executable slots, pooled txs over the new limits are kept
+// until they are mined or expire. The config is copied, so readers of the previous one aren't affected
+func (pool *TxPool) SetSizeLimits(queueSlots, executableSlots int) {
+	pool.mutex.Lock()
+	defer pool.mutex.Unlock()
+	cfg := *pool.mempoolCfg
+	cfg.TxPoolQueueSlots = queueSlots
+	cfg.TxPoolExecutableSlots = executableSlots
+	pool.mempoolCfg = &cfg
+}
+
+// isUnderpriced checks the tx against the local minimum fee per byte, ceremony and other fee free txs are never underpriced
+func (pool *TxPool) isUnderpriced(tx *types.Transaction) bool {
+	minFeePerByte := pool.mempoolCfg.MinFeePerByte
+	if common.ZeroOrNil(minFeePerByte) || fee.IsFeeFree(tx) {
+		return false
+	}
+	return fee.FeePerByte(tx).Cmp(minFeePerByte) < 0
+}
+
+// IsRelayable reports whether the tx may be gossiped to peers, underpriced txs are never relayed
+func (pool *TxPool) IsRelayable(tx *types.Transaction) bool {
+	return !pool.isUnderpriced(tx)
+}
+
 func (pool *TxPool) validate(tx *types.Transaction, appState *appstate.AppState, txType validation.TxType) error {
 	minFeePerGas := fee.GetFeePerGasForNetwork(appState.ValidatorsCache.NetworkSize())
 	return validation.ValidateTx(appState, tx, minFeePerGas, txType)
@@ -288,6 +328,10 @@ func (pool *TxPool) AddExternalTxs(txType validation.TxType, txs ...*types.Trans
 func (pool *TxPool) AddInternalTx(tx *types.Transaction) error {
 	tx.SetHighPriority(true)
 	if pool.IsSyncing() {
+		// own txs are deferred while syncing, an underpriced one would be dropped silently later
+		if pool.isUnderpriced(tx) {
+			return UnderpricedTxError
+		}
 		pool.addDeferredTx(tx)
 		if pool.txKeeper != nil {
 			pool.txKeeper.AddTx(tx)
@@ -353,6 +397,14 @@ func (pool *TxPool) add(tx *types.Transaction, appState *appstate.AppState, own
 		return err
 	}
 
+	if txType == validation.InboundTx && pool.isUnderpriced(tx) {
+		unlock()
+		if own {
+			log.Warn("Own tx is underpriced", "hash", tx.Hash().Hex(), "feePerByte", fee.FeePerByte(tx), "min", pool.mempoolCfg.MinFeePerByte)
+		}
+		return UnderpricedTxError
+	}
+
 	sender, _ := types.Sender(tx)
 
 	if err := pool.validate(tx, appState, txType); err != nil {
@@ -401,6 +453,10 @@ func (pool *TxPool) put(tx *types.Transaction) error {
 
 	sender, _ := types.Sender(tx)
 
+	if existing := pool.findByNonce(sender, tx.Epoch, tx.AccountNonce); existing != nil {
+		return pool.replace(sender, existing, tx)
+	}
+
 	executable, ok := pool.executableTxs[sender]
 	if !ok {
 		executable = newSortedTxs(pool.mempoolCfg.TxPoolAddrExecutableLimit)
@@ -437,12 +493,76 @@ func (pool *TxPool) put(tx *types.Transaction) error {
 
 	pool.all.Add(tx)
 	pool.shortHashAll.Add(tx)
+	pool.addedAt[tx.Hash()] = time.Now()
 
 	pool.appState.NonceCache.SetNonce(sender, tx.Epoch, tx.AccountNonce)
 
 	return nil
 }
 
+// findByNonce returns a pooled tx of the sender with the same epoch and nonce or nil
+func (pool *TxPool) findByNonce(sender common.Address, epoch uint16, nonce uint32) *types.Transaction {
+	if executable, ok := pool.executableTxs[sender]; ok {
+		for _, tx := range executable.txs {
+			if tx.Epoch == epoch && tx.AccountNonce == nonce {
+				return tx
+			}
+		}
+	}
+	if pending, ok := pool.pendingTxs[sender]; ok {
+		for _, tx := range pending.txs {
+			if tx.Epoch == epoch && tx.AccountNonce == nonce {
+				return tx
+			}
+		}
+	}
+	return nil
+}
+
+// replace puts tx in place of the pooled one with the same nonce if it pays enough more
+func (pool *TxPool) replace(sender common.Address, old *types.Transaction, tx *types.Transaction) error {
+	if !isReplacementPriced(old, tx, pool.mempoolCfg.TxReplacementBump) {
+		return errors.Wrapf(ReplacementUnderpricedError, "pooled tx: %v", old.Hash().Hex())
+	}
+	if executable, ok := pool.executableTxs[sender]; !ok || !executable.Replace(old, tx) {
+		pending := pool.pendingTxs[sender]
+		pending.Remove(old.Hash())
+		if err := pending.Add(tx); err != nil {
+			return err
+		}
+	}
+
+	pool.all.Remove(old.Hash())
+	pool.shortHashAll.Remove(old.Hash128())
+	delete(pool.txSyncCounts, old.Hash())
+	delete(pool.addedAt, old.Hash())
+	pool.statsCollector.RemoveMemPoolTx(old)
+	if pool.txKeeper != nil {
+		pool.txKeeper.RemoveTxs([]common.Hash{old.Hash()})
+	}
+
+	pool.all.Add(tx)
+	pool.shortHashAll.Add(tx)
+	pool.addedAt[tx.Hash()] = time.Now()
+	pool.log.Debug("Tx replaced", "old", old.Hash().Hex(), "new", tx.Hash().Hex())
+	return nil
+}
+
+// isReplacementPriced checks that both max fee and tips of tx are at least bump percent higher than the ones of old
+func isReplacementPriced(old, tx *types.Transaction, bump int) bool {
+	raised := func(prev, next *big.Int) bool {
+		if next == nil {
+			return false
+		}
+		if prev == nil {
+			prev = common.Big0
+		}
+		threshold := new(big.Int).Mul(prev, big.NewInt(int64(100+bump)))
+		return new(big.Int).Mul(next, big.NewInt(100)).Cmp(threshold) >= 0 && next.Cmp(prev) > 0
+	}
+	return raised(old.MaxFee, tx.MaxFee) && raised(old.Tips, tx.Tips)
+}
+
 func (pool *TxPool) GetPriorityTransaction() []*types.Transaction {
 	all := pool.all.List(Priority)
 	var result []*types.Transaction
@@ -524,6 +644,7 @@ func (pool *TxPool) Remove(transaction *types.Transaction) {
 	pool.shortHashAll.Remove(transaction.Hash128())
 
 	delete(pool.txSyncCounts, transaction.Hash())
+	delete(pool.addedAt, transaction.Hash())
 	sender, _ := types.Sender(transaction)
 
 	if executable, ok := pool.executableTxs[sender]; ok {
@@ -543,6 +664,51 @@ func (pool *TxPool) Remove(transaction *types.Transaction) {
 	pool.statsCollector.RemoveMemPoolTx(transaction)
 }
 
+// removeExpiredTxs evicts foreign txs which stay in the pool longer than TxLifetime along with the following txs of their senders
+func (pool *TxPool) removeExpiredTxs() {
+	if pool.mempoolCfg.TxLifetime <= 0 {
+		return
+	}
+	pool.mutex.Lock()
+	expiredNonces := make(map[common.Address]*types.Transaction)
+	for hash, addedAt := range pool.addedAt {
+		if time.Since(addedAt) < pool.mempoolCfg.TxLifetime {
+			continue
+		}
+		tx, ok := pool.all.Get(hash)
+		if !ok {
+			continue
+		}
+		sender, _ := types.Sender(tx)
+		if sender == pool.coinbase {
+			continue
+		}
+		if prev, ok := expiredNonces[sender]; !ok || tx.Epoch < prev.Epoch || tx.Epoch == prev.Epoch && tx.AccountNonce < prev.AccountNonce {
+			expiredNonces[sender] = tx
+		}
+	}
+	pool.mutex.Unlock()
+
+	if len(expiredNonces) == 0 {
+		return
+	}
+	removedTxs := make([]common.Hash, 0)
+	for _, tx := range pool.all.List(All) {
+		sender, _ := types.Sender(tx)
+		expired, ok := expiredNonces[sender]
+		if !ok || tx.Epoch != expired.Epoch || tx.AccountNonce < expired.AccountNonce {
+			continue
+		}
+		pool.Remove(tx)
+		removedTxs = append(removedTxs, tx.Hash())
+		pool.log.Debug("Tx expired", "tx", tx.Hash().Hex())
+	}
+	// expired txs must not be restored from the keeper after restart
+	if pool.txKeeper != nil {
+		pool.txKeeper.RemoveTxs(removedTxs)
+	}
+}
+
 func (pool *TxPool) movePendingTxsToExecutable() {
 	pool.mutex.Lock()
 	defer pool.mutex.Unlock()
@@ -590,6 +756,8 @@ func (pool *TxPool) ResetTo(block *types.Block) {
 		pool.txKeeper.RemoveTxs(removedTxs)
 	}
 
+	pool.removeExpiredTxs()
+
 	pool.movePendingTxsToExecutable()
 
 	if !pool.cfg.Mempool.ResetInCeremony && pool.appState.State.ValidationPeriod() > state.FlipLotteryPeriod {
@@ -901,6 +1069,17 @@ func (s *sortedTxs) Remove(transaction *types.Transaction) {
 	}
 }
 
+// Replace puts tx in place of old keeping the nonce order, returns false if old is not found
+func (s *sortedTxs) Replace(old *types.Transaction, tx *types.Transaction) bool {
+	for i, existing := range s.txs {
+		if existing.Hash() == old.Hash() {
+			s.txs[i] = tx
+			return true
+		}
+	}
+	return false
+}
+
 func (s *sortedTxs) Empty() bool {
 	return len(s.txs) == 0
 }
@@ -3,6 +3,7 @@ package mempool
 import (
 	"crypto/ecdsa"
 	"github.com/idena-network/idena-go/blockchain/attachments"
+	"github.com/idena-network/idena-go/blockchain/fee"
 	"github.com/idena-network/idena-go/blockchain/types"
 	"github.com/idena-network/idena-go/blockchain/validation"
 	"github.com/idena-network/idena-go/common"
@@ -13,6 +14,7 @@ import (
 	"github.com/idena-network/idena-go/crypto"
 	"github.com/idena-network/idena-go/secstore"
 	"github.com/idena-network/idena-go/stats/collector"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tm-db"
 	"math/big"
@@ -447,3 +449,211 @@ func TestTxPool_RecoverValidationTxs_OnAfterLongSession(t *testing.T) {
 	require.Len(t, pool.all.txs, 1)
 	require.NoError(t, pool.AddExternalTxs(validation.InBlockTx, getTx(key2)))
 }
+
+func TestTxPool_Replace(t *testing.T) {
+	pool := getPool()
+	key, _ := crypto.GenerateKey()
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	pool.appState.State.SetBalance(address, new(big.Int).Mul(big.NewInt(10000), common.DnaBase))
+	pool.appState.Commit(nil)
+	pool.appState.Initialize(1)
+	pool.head = &types.Header{
+		EmptyBlockHeader: &types.EmptyBlockHeader{
+			Height: 1,
+		},
+	}
+	getTx := func(nonce uint32, maxFee, tips int64) *types.Transaction {
+		tx := &types.Transaction{
+			AccountNonce: nonce,
+			To:           &address,
+			Type:         types.SendTx,
+			Amount:       common.DnaBase,
+			MaxFee:       new(big.Int).Mul(big.NewInt(maxFee), common.DnaBase),
+			Tips:         new(big.Int).Mul(big.NewInt(tips), common.DnaBase),
+		}
+		tx, _ = types.SignTx(tx, key)
+		return tx
+	}
+
+	executable := getTx(1, 10, 10)
+	pending := getTx(3, 10, 10)
+	require.NoError(t, pool.AddInternalTx(executable))
+	require.NoError(t, pool.AddInternalTx(pending))
+
+	err := pool.AddInternalTx(getTx(1, 10, 20))
+	require.Equal(t, ReplacementUnderpricedError, errors.Cause(err))
+	err = pool.AddInternalTx(getTx(1, 20, 10))
+	require.Equal(t, ReplacementUnderpricedError, errors.Cause(err))
+
+	replacement := getTx(1, 11, 11)
+	require.NoError(t, pool.AddInternalTx(replacement))
+	require.Nil(t, pool.GetTx(executable.Hash()))
+	require.Equal(t, replacement, pool.GetTx(replacement.Hash()))
+	require.Equal(t, []*types.Transaction{replacement}, pool.executableTxs[address].txs)
+
+	pendingReplacement := getTx(3, 20, 20)
+	require.NoError(t, pool.AddInternalTx(pendingReplacement))
+	require.Nil(t, pool.GetTx(pending.Hash()))
+	require.Len(t, pool.pendingTxs[address].txs, 1)
+	require.Len(t, pool.all.txs, 2)
+	require.Len(t, pool.addedAt, 2)
+}
+
+func TestTxPool_removeExpiredTxs(t *testing.T) {
+	pool := getPool()
+	keys := make([]*ecdsa.PrivateKey, 2)
+	for i := range keys {
+		keys[i], _ = crypto.GenerateKey()
+		pool.appState.State.SetBalance(crypto.PubkeyToAddress(keys[i].PublicKey), new(big.Int).Mul(big.NewInt(10000), common.DnaBase))
+	}
+	pool.appState.Commit(nil)
+	pool.appState.Initialize(1)
+	pool.head = &types.Header{
+		EmptyBlockHeader: &types.EmptyBlockHeader{
+			Height: 1,
+		},
+	}
+	getTx := func(key *ecdsa.PrivateKey, nonce uint32) *types.Transaction {
+		address := crypto.PubkeyToAddress(key.PublicKey)
+		tx := &types.Transaction{
+			AccountNonce: nonce,
+			To:           &address,
+			Type:         types.SendTx,
+			Amount:       common.DnaBase,
+		}
+		tx, _ = types.SignTx(tx, key)
+		return tx
+	}
+	var txs []*types.Transaction
+	for _, key := range keys {
+		for nonce := uint32(1); nonce <= 3; nonce++ {
+			tx := getTx(key, nonce)
+			require.NoError(t, pool.AddInternalTx(tx))
+			txs = append(txs, tx)
+		}
+	}
+
+	// the second tx of the first sender expires so the third one can't be executed as well
+	pool.addedAt[txs[1].Hash()] = time.Now().Add(-pool.mempoolCfg.TxLifetime)
+	pool.removeExpiredTxs()
+
+	require.Len(t, pool.all.txs, 4)
+	require.NotNil(t, pool.GetTx(txs[0].Hash()))
+	require.Nil(t, pool.GetTx(txs[1].Hash()))
+	require.Nil(t, pool.GetTx(txs[2].Hash()))
+	require.Len(t, pool.executableTxs[crypto.PubkeyToAddress(keys[0].PublicKey)].txs, 1)
+	require.Len(t, pool.executableTxs[crypto.PubkeyToAddress(keys[1].PublicKey)].txs, 3)
+}
+
+func TestTxPool_MinFeePerByte(t *testing.T) {
+	pool := getPool()
+	key, _ := crypto.GenerateKey()
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	pool.appState.State.SetBalance(address, new(big.Int).Mul(big.NewInt(10000), common.DnaBase))
+	pool.appState.Commit(nil)
+	pool.appState.Initialize(1)
+	pool.head = &types.Header{
+		EmptyBlockHeader: &types.EmptyBlockHeader{
+			Height: 1,
+		},
+	}
+	pool.mempoolCfg.MinFeePerByte = big.NewInt(1e15)
+	getTx := func(nonce uint32, maxFee int64) *types.Transaction {
+		tx := &types.Transaction{
+			AccountNonce: nonce,
+			To:           &address,
+			Type:         types.SendTx,
+			Amount:       common.DnaBase,
+			MaxFee:       big.NewInt(maxFee),
+		}
+		tx, _ = types.SignTx(tx, key)
+		return tx
+	}
+
+	underpriced := getTx(1, 1e15)
+	require.True(t, fee.FeePerByte(underpriced).Cmp(pool.mempoolCfg.MinFeePerByte) < 0)
+	require.Equal(t, UnderpricedTxError, pool.AddExternalTxs(validation.InboundTx, underpriced))
+	require.False(t, pool.IsRelayable(underpriced))
+
+	// own transactions are rejected as well
+	require.Equal(t, UnderpricedTxError, pool.AddInternalTx(underpriced))
+	require.Nil(t, pool.GetTx(underpriced.Hash()))
+
+	priced := getTx(2, 1e18)
+	require.NoError(t, pool.AddExternalTxs(validation.InboundTx, priced))
+	require.True(t, pool.IsRelayable(priced))
+}
+
+func TestTxPool_SetSizeLimits(t *testing.T) {
+	pool := getPool()
+	cfg := pool.mempoolCfg
+	cfg.TxPoolExecutableSlots, cfg.TxPoolAddrExecutableLimit, cfg.TxPoolQueueSlots = 1, 1, 0
+	pool.head = &types.Header{
+		EmptyBlockHeader: &types.EmptyBlockHeader{
+			Height: 1,
+		},
+	}
+	getTx := func() *types.Transaction {
+		key, _ := crypto.GenerateKey()
+		address := crypto.PubkeyToAddress(key.PublicKey)
+		pool.appState.State.SetBalance(address, new(big.Int).Mul(big.NewInt(10000), common.DnaBase))
+		tx := &types.Transaction{
+			AccountNonce: 1,
+			To:           &address,
+			Type:         types.SendTx,
+			Amount:       common.DnaBase,
+			MaxFee:       new(big.Int).Mul(big.NewInt(1), common.DnaBase),
+		}
+		tx, _ = types.SignTx(tx, key)
+		return tx
+	}
+	txs := []*types.Transaction{getTx(), getTx()}
+	pool.appState.Commit(nil)
+	pool.appState.Initialize(1)
+
+	require.NoError(t, pool.AddExternalTxs(validation.InboundTx, txs[0]))
+	require.Error(t, pool.AddExternalTxs(validation.InboundTx, txs[1]))
+
+	pool.SetSizeLimits(0, 2)
+	require.NoError(t, pool.AddExternalTxs(validation.InboundTx, txs[1]))
+	// the previous config isn't changed
+	require.Equal(t, 1, cfg.TxPoolExecutableSlots)
+}
+
+func TestTxPool_BuildBlockTransactions_tipsOrder(t *testing.T) {
+	pool := getPool()
+	keys := make([]*ecdsa.PrivateKey, 3)
+	for i := range keys {
+		keys[i], _ = crypto.GenerateKey()
+		pool.appState.State.SetBalance(crypto.PubkeyToAddress(keys[i].PublicKey), new(big.Int).Mul(big.NewInt(10000), common.DnaBase))
+	}
+	pool.appState.Commit(nil)
+	pool.appState.Initialize(1)
+	pool.head = &types.Header{
+		EmptyBlockHeader: &types.EmptyBlockHeader{
+			Height: 1,
+		},
+	}
+	getTx := func(key *ecdsa.PrivateKey, nonce uint32, tips int64) *types.Transaction {
+		address := crypto.PubkeyToAddress(key.PublicKey)
+		tx := &types.Transaction{
+			AccountNonce: nonce,
+			To:           &address,
+			Type:         types.SendTx,
+			Amount:       common.DnaBase,
+			Tips:         new(big.Int).Mul(big.NewInt(tips), common.DnaBase),
+		}
+		tx, _ = types.SignTx(tx, key)
+		return tx
+	}
+	lowTips1 := getTx(keys[0], 1, 1)
+	highTips2 := getTx(keys[0], 2, 10)
+	middleTips := getTx(keys[1], 1, 5)
+	noTips := getTx(keys[2], 1, 0)
+	for _, tx := range []*types.Transaction{lowTips1, highTips2, middleTips, noTips} {
+		require.NoError(t, pool.AddInternalTx(tx))
+	}
+
+	// a transaction with higher tips waits for previous transactions of its sender
+	require.Equal(t, []*types.Transaction{middleTips, lowTips1, highTips2, noTips}, pool.BuildBlockTransactions())
+}
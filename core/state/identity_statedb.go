@@ -22,6 +22,9 @@ type IdentityStateDB struct {
 	stateIdentities      map[common.Address]*stateApprovedIdentity
 	stateIdentitiesDirty map[common.Address]struct{}
 
+	// savedStatesCount is the number of latest versions kept in the tree, MaxSavedStatesCount if zero
+	savedStatesCount int
+
 	log  log.Logger
 	lock sync.Mutex
 }
@@ -159,11 +162,12 @@ func (s *IdentityStateDB) Commit(deleteEmptyObjects bool) (root []byte, version
 
 func (s *IdentityStateDB) CommitTree(newVersion int64) (root []byte, version int64, err error) {
 	hash, version, err := s.tree.SaveVersionAt(newVersion)
-	if version > MaxSavedStatesCount {
+	savedStatesCount := s.SavedStatesCount()
+	if version > int64(savedStatesCount) {
 
 		versions := s.tree.AvailableVersions()
 
-		for i := 0; i < len(versions)-MaxSavedStatesCount; i++ {
+		for i := 0; i < len(versions)-savedStatesCount; i++ {
 			if s.tree.ExistVersion(int64(versions[i])) {
 				err = s.tree.DeleteVersion(int64(versions[i]))
 				if err != nil {
@@ -353,6 +357,18 @@ func (s *IdentityStateDB) HasVersion(height uint64) bool {
 	return s.tree.ExistVersion(int64(height))
 }
 
+// SetSavedStatesCount sets the number of latest state versions kept on commit, older versions are pruned
+func (s *IdentityStateDB) SetSavedStatesCount(count int) {
+	s.savedStatesCount = count
+}
+
+func (s *IdentityStateDB) SavedStatesCount() int {
+	if s.savedStatesCount > 0 {
+		return s.savedStatesCount
+	}
+	return MaxSavedStatesCount
+}
+
 func (s *IdentityStateDB) IterateIdentities(fn func(key []byte, value []byte) bool) bool {
 	return s.tree.GetImmutable().IterateRange(nil, nil, true, fn)
 }
@@ -1,6 +1,7 @@
 package state
 
 import (
+	"bytes"
 	"encoding/binary"
 	"github.com/idena-network/idena-go/common"
 	"github.com/pkg/errors"
@@ -85,6 +86,11 @@ func (s *stateDbKeys) AddressKeyToAddress(key []byte) common.Address {
 	return addr
 }
 
+// IsAddressKey reports whether the key of the state tree belongs to an account
+func (s *stateDbKeys) IsAddressKey(key []byte) bool {
+	return len(key) == len(addressPrefix)+common.AddressLength && bytes.HasPrefix(key, addressPrefix)
+}
+
 func (s *stateDbKeys) GlobalKey() []byte {
 	return globalKey
 }
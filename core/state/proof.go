@@ -0,0 +1,99 @@
+package state
+
+import (
+	"github.com/cosmos/iavl"
+	"github.com/golang/protobuf/proto"
+	"github.com/idena-network/idena-go/common"
+	models "github.com/idena-network/idena-go/protobuf"
+	"github.com/pkg/errors"
+)
+
+// StateProof holds Merkle proofs of the account and the identity of an address against the state root at the height,
+// a proof is empty if the state has no such value
+type StateProof struct {
+	Height   uint64
+	Root     common.Hash
+	Account  []byte
+	Identity []byte
+}
+
+// GetProof returns proofs of the address state at the last committed version
+func (s *StateDB) GetProof(addr common.Address) (*StateProof, error) {
+	tree := s.tree.GetImmutable()
+	account, err := tree.GetWithProof(StateDbKeys.AddressKey(addr))
+	if err != nil {
+		return nil, err
+	}
+	identity, err := tree.GetWithProof(StateDbKeys.IdentityKey(addr))
+	if err != nil {
+		return nil, err
+	}
+	return &StateProof{
+		Height:   uint64(tree.Version()),
+		Root:     tree.Hash(),
+		Account:  account,
+		Identity: identity,
+	}, nil
+}
+
+// Verify checks the proofs against the root and returns the proved account and identity, nil if a proof is empty,
+// a light client has to check that the root matches the block header at the height
+func (p *StateProof) Verify(addr common.Address) (*Account, *Identity, error) {
+	var account *Account
+	if len(p.Account) > 0 {
+		value, err := VerifyValueWithProof(p.Root, StateDbKeys.AddressKey(addr), p.Account)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "invalid account proof")
+		}
+		account = new(Account)
+		if err := account.FromBytes(value); err != nil {
+			return nil, nil, err
+		}
+	}
+	var identity *Identity
+	if len(p.Identity) > 0 {
+		value, err := VerifyValueWithProof(p.Root, StateDbKeys.IdentityKey(addr), p.Identity)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "invalid identity proof")
+		}
+		identity = new(Identity)
+		if err := identity.FromBytes(value); err != nil {
+			return nil, nil, err
+		}
+	}
+	return account, identity, nil
+}
+
+// VerifyValueWithProof checks the value with proof returned by ImmutableTree.GetWithProof against the root and returns the value
+func VerifyValueWithProof(root common.Hash, key []byte, data []byte) ([]byte, error) {
+	protoObj := new(models.ValueWithProof)
+	if err := proto.Unmarshal(data, protoObj); err != nil {
+		return nil, err
+	}
+	if protoObj.Leaf == nil {
+		return nil, errors.New("proof leaf is missing")
+	}
+	proof := &iavl.RangeProof{
+		Leaves: []iavl.ProofLeafNode{{
+			Key:       protoObj.Leaf.Key,
+			ValueHash: protoObj.Leaf.ValueHash,
+			Version:   int64(protoObj.Leaf.Version),
+		}},
+	}
+	for _, item := range protoObj.Proof {
+		proof.LeftPath = append(proof.LeftPath, iavl.ProofInnerNode{
+			Height:  int8(item.Height),
+			Size:    int64(item.Size),
+			Version: int64(item.Version),
+			Left:    item.Left,
+			Right:   item.Right,
+		})
+	}
+	if err := proof.Verify(root[:]); err != nil {
+		return nil, err
+	}
+	if err := proof.VerifyItem(key, protoObj.Value); err != nil {
+		return nil, err
+	}
+	return protoObj.Value, nil
+}
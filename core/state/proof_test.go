@@ -0,0 +1,47 @@
+package state
+
+import (
+	"github.com/idena-network/idena-go/common"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tm-db"
+	"math/big"
+	"testing"
+)
+
+func TestStateDB_GetProof(t *testing.T) {
+	stateDb, _ := NewLazy(db.NewMemDB())
+	addr := common.Address{0x1}
+	for i := 0; i < 20; i++ {
+		stateDb.SetBalance(common.Address{byte(i + 2)}, big.NewInt(int64(i+1)))
+	}
+	stateDb.SetBalance(addr, big.NewInt(100))
+	stateDb.SetState(addr, Verified)
+	_, _, _, err := stateDb.Commit(true)
+	require.NoError(t, err)
+
+	proof, err := stateDb.GetProof(addr)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), proof.Height)
+	require.Equal(t, stateDb.Root(), proof.Root)
+	account, identity, err := proof.Verify(addr)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(100), account.Balance)
+	require.Equal(t, Verified, identity.State)
+
+	// the proof doesn't prove the state of another address
+	_, _, err = proof.Verify(common.Address{0x2})
+	require.Error(t, err)
+
+	wrongRoot := *proof
+	wrongRoot.Root = common.Hash{0x1}
+	_, _, err = wrongRoot.Verify(addr)
+	require.Error(t, err)
+
+	// an address without identity
+	proof, err = stateDb.GetProof(common.Address{0x2})
+	require.NoError(t, err)
+	account, identity, err = proof.Verify(common.Address{0x2})
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(1), account.Balance)
+	require.Nil(t, identity)
+}
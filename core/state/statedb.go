@@ -87,6 +87,9 @@ type StateDB struct {
 
 	identityUpdateHook IdentityUpdateHook
 
+	// savedStatesCount is the number of latest versions kept in the tree, MaxSavedStatesCount if zero
+	savedStatesCount int
+
 	log  log.Logger
 	lock sync.Mutex
 }
@@ -1305,11 +1308,12 @@ func (s *StateDB) SaveForcedVersion(height uint64) (root []byte, version int64,
 
 func (s *StateDB) CommitTree(newVersion int64) (root []byte, version int64, err error) {
 	hash, version, err := s.tree.SaveVersionAt(newVersion)
-	if version > MaxSavedStatesCount {
+	savedStatesCount := s.SavedStatesCount()
+	if version > int64(savedStatesCount) {
 
 		versions := s.tree.AvailableVersions()
 
-		for i := 0; i < len(versions)-MaxSavedStatesCount; i++ {
+		for i := 0; i < len(versions)-savedStatesCount; i++ {
 			if s.tree.ExistVersion(int64(versions[i])) {
 				err = s.tree.DeleteVersion(int64(versions[i]))
 				if err != nil {
@@ -2037,6 +2041,18 @@ func (s *StateDB) HasVersion(h uint64) bool {
 	return s.tree.ExistVersion(int64(h))
 }
 
+// SetSavedStatesCount sets the number of latest state versions kept on commit, older versions are pruned
+func (s *StateDB) SetSavedStatesCount(count int) {
+	s.savedStatesCount = count
+}
+
+func (s *StateDB) SavedStatesCount() int {
+	if s.savedStatesCount > 0 {
+		return s.savedStatesCount
+	}
+	return MaxSavedStatesCount
+}
+
 func (s *StateDB) ShardsNum() uint32 {
 	return s.GetOrNewGlobalObject().ShardsNum()
 }
@@ -34,6 +34,24 @@ func TestStateDB_Version(t *testing.T) {
 	require.Equal(t, int64(1), stateDb.Version())
 }
 
+func TestStateDB_SetSavedStatesCount(t *testing.T) {
+	database := db.NewMemDB()
+	stateDb, _ := NewLazy(database)
+	require.Equal(t, MaxSavedStatesCount, stateDb.SavedStatesCount())
+	stateDb.SetSavedStatesCount(3)
+
+	for i := 1; i <= 5; i++ {
+		stateDb.SetBalance(common.Address{}, new(big.Int).SetInt64(int64(i)))
+		_, _, _, err := stateDb.Commit(true)
+		require.NoError(t, err)
+	}
+
+	require.False(t, stateDb.HasVersion(2))
+	for h := uint64(3); h <= 5; h++ {
+		require.True(t, stateDb.HasVersion(h))
+	}
+}
+
 func TestStateDB_CheckForkValidation(t *testing.T) {
 
 	require := require.New(t)
@@ -23,10 +23,12 @@ type Upgrader struct {
 	mutex            sync.RWMutex
 	votes            *types.UpgradeVotes
 	throttlingLogger log.ThrottlingLogger
+	term             chan struct{}
+	done             chan struct{}
 }
 
 func NewUpgrader(config *config.Config, appState *appstate.AppState, db dbm.DB) *Upgrader {
-	throttlingLogger := log.NewThrottlingLogger(log.New("component", "upgrader"))
+	throttlingLogger := log.NewThrottlingLogger(log.New(log.ModuleKey, "upgrade", "component", "upgrader"))
 	return &Upgrader{
 		config:           config,
 		appState:         appState,
@@ -34,6 +36,8 @@ func NewUpgrader(config *config.Config, appState *appstate.AppState, db dbm.DB)
 		votesChan:        make(chan *types.Vote, 10000),
 		votes:            types.NewUpgradeVotes(),
 		throttlingLogger: throttlingLogger,
+		term:             make(chan struct{}),
+		done:             make(chan struct{}),
 	}
 }
 
@@ -50,14 +54,25 @@ func (u *Upgrader) ProcessVote(vote *types.Vote) {
 	}
 }
 
+// Stop persists collected votes and stops listening, it must be called after Start
+func (u *Upgrader) Stop() {
+	close(u.term)
+	<-u.done
+}
+
 func (u *Upgrader) startListening() {
+	defer close(u.done)
 	t := time.NewTicker(time.Minute)
+	defer t.Stop()
 	for {
 		select {
 		case vote := <-u.votesChan:
 			u.processVote(vote)
 		case <-t.C:
 			u.persist()
+		case <-u.term:
+			u.persist()
+			return
 		}
 	}
 }
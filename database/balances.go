@@ -0,0 +1,72 @@
+package database
+
+import (
+	"encoding/binary"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/log"
+	"math"
+	"math/big"
+)
+
+// BalanceRecord is the balance of an address at the end of an epoch or at the latest change within the current one
+type BalanceRecord struct {
+	Epoch   uint16
+	Height  uint64
+	Balance *big.Int
+	// Change is the difference with the balance of the previous recorded epoch
+	Change *big.Int
+}
+
+func addressBalanceKey(address common.Address, epoch uint16) []byte {
+	key := append(addressBalancePrefix, address[:]...)
+	enc := make([]byte, 2)
+	binary.BigEndian.PutUint16(enc, epoch)
+	return append(key, enc...)
+}
+
+// SaveBalance records the balance of the address, the record of the epoch is overwritten by later changes
+func (r *Repo) SaveBalance(address common.Address, epoch uint16, height uint64, balance *big.Int) {
+	data := append(encodeUint64Number(height), balance.Bytes()...)
+	r.db.Set(addressBalanceKey(address, epoch), data)
+}
+
+// GetBalanceHistory returns balance records of the address starting from the latest epoch, the token is the epoch
+// of the first record of the next page
+func (r *Repo) GetBalanceHistory(address common.Address, count int, token []byte) (records []*BalanceRecord, nextToken []byte) {
+	lastEpoch := uint16(math.MaxUint16)
+	if len(token) == 2 {
+		lastEpoch = binary.BigEndian.Uint16(token)
+	}
+	// the end of the iterator is exclusive, keys have the same length, so the suffix makes the last epoch inclusive
+	end := append(addressBalanceKey(address, lastEpoch), 0)
+	it, err := r.db.ReverseIterator(addressBalanceKey(address, 0), end)
+	assertNoError(err)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		key, value := it.Key(), it.Value()
+		if len(value) < 8 {
+			log.Error("cannot parse balance record", "key", key)
+			continue
+		}
+		record := &BalanceRecord{
+			Epoch:   binary.BigEndian.Uint16(key[len(key)-2:]),
+			Height:  binary.BigEndian.Uint64(value[:8]),
+			Balance: new(big.Int).SetBytes(value[8:]),
+		}
+		if len(records) > 0 {
+			last := records[len(records)-1]
+			last.Change = new(big.Int).Sub(last.Balance, record.Balance)
+		}
+		if len(records) == count {
+			nextToken = make([]byte, 2)
+			binary.BigEndian.PutUint16(nextToken, record.Epoch)
+			return records, nextToken
+		}
+		records = append(records, record)
+	}
+	if len(records) > 0 {
+		last := records[len(records)-1]
+		last.Change = new(big.Int).Set(last.Balance)
+	}
+	return records, nil
+}
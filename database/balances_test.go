@@ -0,0 +1,35 @@
+package database
+
+import (
+	"github.com/idena-network/idena-go/common"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tm-db"
+	"math/big"
+	"testing"
+)
+
+func TestRepo_GetBalanceHistory(t *testing.T) {
+	repo := NewRepo(db.NewMemDB())
+	addr := common.Address{0x1}
+	other := common.Address{0x2}
+
+	repo.SaveBalance(addr, 1, 10, big.NewInt(100))
+	repo.SaveBalance(addr, 2, 20, big.NewInt(50))
+	repo.SaveBalance(addr, 2, 25, big.NewInt(70))
+	repo.SaveBalance(addr, 5, 50, big.NewInt(0))
+	repo.SaveBalance(other, 3, 30, big.NewInt(1000))
+
+	records, token := repo.GetBalanceHistory(addr, 2, nil)
+	require.Len(t, records, 2)
+	require.NotNil(t, token)
+	require.Equal(t, &BalanceRecord{Epoch: 5, Height: 50, Balance: big.NewInt(0), Change: big.NewInt(-70)}, records[0])
+	require.Equal(t, &BalanceRecord{Epoch: 2, Height: 25, Balance: big.NewInt(70), Change: big.NewInt(-30)}, records[1])
+
+	records, token = repo.GetBalanceHistory(addr, 2, token)
+	require.Nil(t, token)
+	require.Equal(t, []*BalanceRecord{{Epoch: 1, Height: 10, Balance: big.NewInt(100), Change: big.NewInt(100)}}, records)
+
+	records, token = repo.GetBalanceHistory(common.Address{0x3}, 2, nil)
+	require.Nil(t, token)
+	require.Empty(t, records)
+}
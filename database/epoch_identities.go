@@ -0,0 +1,26 @@
+package database
+
+import (
+	"encoding/binary"
+)
+
+func epochIdentitiesKey(epoch uint16) []byte {
+	enc := make([]byte, 2)
+	binary.BigEndian.PutUint16(enc, epoch)
+	return append(epochIdentitiesPrefix, enc...)
+}
+
+// WriteEpochIdentities saves the identity set materialized at the start of the epoch
+func (r *Repo) WriteEpochIdentities(epoch uint16, data []byte) {
+	assertNoError(r.db.Set(epochIdentitiesKey(epoch), data))
+}
+
+func (r *Repo) ReadEpochIdentities(epoch uint16) []byte {
+	data, err := r.db.Get(epochIdentitiesKey(epoch))
+	assertNoError(err)
+	return data
+}
+
+func (r *Repo) DeleteEpochIdentities(epoch uint16) {
+	assertNoError(r.db.Delete(epochIdentitiesKey(epoch)))
+}
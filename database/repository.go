@@ -29,6 +29,20 @@ func NewRepo(db dbm.DB) *Repo {
 	}
 }
 
+// NewBatch creates a batch which is used to write several records atomically
+func (r *Repo) NewBatch() dbm.Batch {
+	return r.db.NewBatch()
+}
+
+// set writes the value to the batch if it's provided, otherwise directly to the db
+func (r *Repo) set(batch dbm.Batch, key, value []byte) {
+	if batch != nil {
+		batch.Set(key, value)
+	} else {
+		r.db.Set(key, value)
+	}
+}
+
 func encodeUint32Number(number uint32) []byte {
 	enc := make([]byte, 4)
 	binary.BigEndian.PutUint32(enc, number)
@@ -144,13 +158,12 @@ func (r *Repo) WriteHead(batch dbm.Batch, header *types.Header) {
 	}
 }
 
-func (r *Repo) WriteBlockHeader(header *types.Header) {
+func (r *Repo) WriteBlockHeader(batch dbm.Batch, header *types.Header) {
 	data, err := header.ToBytes()
 	if err != nil {
 		log.Crit("Failed to proto encode header", "err", err)
 	}
-
-	r.db.Set(headerKey(header.Hash()), data)
+	r.set(batch, headerKey(header.Hash()), data)
 }
 
 func (r *Repo) RemoveHeader(hash common.Hash) {
@@ -165,9 +178,9 @@ func (r *Repo) WriteCertificate(hash common.Hash, cert *types.BlockCert) {
 	r.db.Set(certKey(hash), data)
 }
 
-func (r *Repo) WriteCanonicalHash(height uint64, hash common.Hash) {
+func (r *Repo) WriteCanonicalHash(batch dbm.Batch, height uint64, hash common.Hash) {
 	key := headerHashKey(height)
-	r.db.Set(key, hash.Bytes())
+	r.set(batch, key, hash.Bytes())
 }
 
 func (r *Repo) RemoveCanonicalHash(height uint64) {
@@ -200,13 +213,13 @@ func (r *Repo) SetHead(batch dbm.Batch, height uint64) {
 	}
 }
 
-func (r *Repo) WriteTxIndex(txHash common.Hash, index *types.TransactionIndex) {
+func (r *Repo) WriteTxIndex(batch dbm.Batch, txHash common.Hash, index *types.TransactionIndex) {
 	data, err := index.ToBytes()
 	if err != nil {
 		log.Crit("failed to proto encode transaction index", "err", err)
 		return
 	}
-	r.db.Set(txIndexKey(txHash), data)
+	r.set(batch, txIndexKey(txHash), data)
 }
 
 func (r *Repo) ReadTxIndex(hash common.Hash) *types.TransactionIndex {
@@ -224,13 +237,13 @@ func (r *Repo) ReadTxIndex(hash common.Hash) *types.TransactionIndex {
 	return index
 }
 
-func (r *Repo) WriteReceiptIndex(hash common.Hash, idx *types.TxReceiptIndex) {
+func (r *Repo) WriteReceiptIndex(batch dbm.Batch, hash common.Hash, idx *types.TxReceiptIndex) {
 	data, err := idx.ToBytes()
 	if err != nil {
 		log.Crit("failed to proto encode receipt index", "err", err)
 		return
 	}
-	r.db.Set(receiptIndexKey(hash), data)
+	r.set(batch, receiptIndexKey(hash), data)
 }
 
 func (r *Repo) ReadReceiptIndex(hash common.Hash) *types.TxReceiptIndex {
@@ -333,8 +346,8 @@ func (r *Repo) WriteLastSnapshotManifest(cidV2 []byte, root common.Hash, height
 	return nil
 }
 
-func (r *Repo) WriteIdentityStateDiff(height uint64, diff []byte) {
-	r.db.Set(identityStateDiffKey(height), diff)
+func (r *Repo) WriteIdentityStateDiff(batch dbm.Batch, height uint64, diff []byte) {
+	r.set(batch, identityStateDiffKey(height), diff)
 }
 
 func (r *Repo) ReadIdentityStateDiff(height uint64) []byte {
@@ -511,7 +524,7 @@ func (r *Repo) GetTotalBurntCoins() []*types.BurntCoins {
 	return res
 }
 
-func (r *Repo) WriteEvent(contract common.Address, txHash common.Hash, idx uint32, event *types.TxEvent) {
+func (r *Repo) WriteEvent(batch dbm.Batch, contract common.Address, txHash common.Hash, idx uint32, event *types.TxEvent) {
 	e := types.SavedEvent{
 		Contract: contract,
 		Event:    event.EventName,
@@ -522,7 +535,7 @@ func (r *Repo) WriteEvent(contract common.Address, txHash common.Hash, idx uint3
 		log.Crit("failed to proto encode saved event", "err", err)
 		return
 	}
-	r.db.Set(savedEventKey(contract, txHash.Bytes(), idx, event.EventName), data)
+	r.set(batch, savedEventKey(contract, txHash.Bytes(), idx, event.EventName), data)
 }
 
 func (r *Repo) GetSavedEvents(contract common.Address) (events []*types.SavedEvent) {
@@ -84,27 +84,27 @@ func TestRepo_GetSavedEvents(t *testing.T) {
 	tx1 := common.Hash{0x1}
 	tx2 := common.Hash{0x2}
 
-	repo.WriteEvent(addr1, tx1, 1, &types.TxEvent{
+	repo.WriteEvent(nil, addr1, tx1, 1, &types.TxEvent{
 		EventName: "event2",
 		Data:      [][]byte{{0x1}},
 	})
-	repo.WriteEvent(addr1, tx1, 2, &types.TxEvent{
+	repo.WriteEvent(nil, addr1, tx1, 2, &types.TxEvent{
 		EventName: "event1",
 		Data:      [][]byte{{0x1}},
 	})
-	repo.WriteEvent(addr1, tx1, 3, &types.TxEvent{
+	repo.WriteEvent(nil, addr1, tx1, 3, &types.TxEvent{
 		EventName: "event1",
 		Data:      [][]byte{{0x1}},
 	})
-	repo.WriteEvent(addr2, tx2, 1, &types.TxEvent{
+	repo.WriteEvent(nil, addr2, tx2, 1, &types.TxEvent{
 		EventName: "ZZZZZZZZZZZZZZZ ZZZZZZZZZZZZZZZZZZ",
 		Data:      [][]byte{{0x1}},
 	})
-	repo.WriteEvent(addr2, tx2, 2, &types.TxEvent{
+	repo.WriteEvent(nil, addr2, tx2, 2, &types.TxEvent{
 		EventName: "e",
 		Data:      [][]byte{{0x1}},
 	})
-	repo.WriteEvent(addr2, tx2, 3, &types.TxEvent{
+	repo.WriteEvent(nil, addr2, tx2, 3, &types.TxEvent{
 		EventName: "ev2",
 		Data:      [][]byte{{0x1}},
 	})
@@ -20,6 +20,8 @@ var (
 
 	burntCoinsPrefix = []byte("bc")
 
+	addressBalancePrefix = []byte("ab") // addressBalancePrefix + address + epoch (uint16 big endian) -> height + balance
+
 	certPrefix = []byte("c")
 
 	flipEncryptionPrefix = []byte("key")
@@ -49,4 +51,6 @@ var (
 	applyTxLogPrefix = []byte("applytxlog")
 
 	blackListedTxPrefix = []byte("blacktx")
+
+	epochIdentitiesPrefix = []byte("idset") // epochIdentitiesPrefix + epoch (uint16 big endian) -> identity set
 )
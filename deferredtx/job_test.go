@@ -65,6 +65,14 @@ func (f fakeTxPool) IsSyncing() bool {
 	return false
 }
 
+func (f fakeTxPool) IsRelayable(tx *types.Transaction) bool {
+	return true
+}
+
+func (f fakeTxPool) GetTx(common.Hash) *types.Transaction {
+	return nil
+}
+
 func TestJob_tryLater(t *testing.T) {
 
 	fakeVmError = embedded.NewContractError("", true)
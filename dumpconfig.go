@@ -0,0 +1,36 @@
+package main
+
+import (
+	"github.com/idena-network/idena-go/config"
+	"github.com/urfave/cli"
+	"io/ioutil"
+)
+
+var dumpConfigCommand = cli.Command{
+	Name:      "dumpconfig",
+	Usage:     "Print the node config with applied flags in TOML, or write it to a file in the format of its extension",
+	ArgsUsage: "[<file>]",
+	Flags:     nodeFlags,
+	Action:    dumpConfig,
+}
+
+func dumpConfig(ctx *cli.Context) error {
+	cfg, err := config.MakeConfig(ctx, func(cfg *config.Config) {})
+	if err != nil {
+		return err
+	}
+	path := ctx.Args().First()
+	format := config.FileFormatToml
+	if path != "" {
+		format = config.FileFormat(path)
+	}
+	data, err := config.MarshalConfig(cfg, format)
+	if err != nil {
+		return err
+	}
+	if path == "" {
+		_, err = ctx.App.Writer.Write(data)
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0644)
+}
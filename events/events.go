@@ -24,6 +24,7 @@ const (
 	DatabaseInitEventId          = eventbus.EventID("db-init")
 	DatabaseInitCompletedEventId = eventbus.EventID("db-init-completed")
 	IpfsGcEventId                = eventbus.EventID("ipfc-gc")
+	NewVoteEventID               = eventbus.EventID("vote-new")
 )
 
 type NewTxEvent struct {
@@ -138,3 +139,11 @@ type IpfsGcEvent struct {
 func (e *IpfsGcEvent) EventID() eventbus.EventID {
 	return IpfsGcEventId
 }
+
+type NewVoteEvent struct {
+	Vote *types.Vote
+}
+
+func (e *NewVoteEvent) EventID() eventbus.EventID {
+	return NewVoteEventID
+}
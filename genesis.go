@@ -0,0 +1,38 @@
+package main
+
+import (
+	"fmt"
+	"github.com/idena-network/idena-go/config"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+var initCommand = cli.Command{
+	Name:  "init",
+	Usage: "Initialize the datadir for a private network described by the genesis spec",
+	Flags: []cli.Flag{
+		config.CfgFileFlag,
+		config.DataDirFlag,
+		config.GenesisFlag,
+	},
+	Action: initGenesis,
+}
+
+func initGenesis(ctx *cli.Context) error {
+	if !ctx.IsSet(config.GenesisFlag.Name) {
+		return errors.Errorf("--%v is required", config.GenesisFlag.Name)
+	}
+	cfg, err := config.MakeConfigFromFile(ctx.String(config.CfgFileFlag.Name))
+	if err != nil {
+		return err
+	}
+	if ctx.IsSet(config.DataDirFlag.Name) {
+		cfg.DataDir = ctx.String(config.DataDirFlag.Name)
+	}
+	spec, err := config.InitGenesis(cfg.DataDir, ctx.String(config.GenesisFlag.Name))
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(ctx.App.Writer, "Datadir %v is initialized for network %v, the genesis block is generated on the first start\n", cfg.DataDir, spec.Network)
+	return nil
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"github.com/idena-network/idena-go/config"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestInitCommand(t *testing.T) {
+	run := func(args ...string) error {
+		app := cli.NewApp()
+		app.Commands = []cli.Command{initCommand}
+		app.Writer = ioutil.Discard
+		return app.Run(append([]string{"idena", "init"}, args...))
+	}
+	dataDir := t.TempDir()
+	genesisFile := filepath.Join(t.TempDir(), "genesis.json")
+	require.NoError(t, ioutil.WriteFile(genesisFile, []byte(`{
+  "network": 100,
+  "godAddress": "0x0000000000000000000000000000000000000001",
+  "firstCeremonyTime": 1700000000,
+  "alloc": {
+    "0x0000000000000000000000000000000000000002": {"balance": 1000000000000000000000, "state": 3}
+  }
+}`), 0644))
+
+	require.Error(t, run("--datadir", dataDir))
+	require.NoError(t, run("--datadir", dataDir, "--genesis", genesisFile))
+
+	spec, err := config.LoadGenesisSpec(filepath.Join(dataDir, config.GenesisFileName))
+	require.NoError(t, err)
+	require.Equal(t, uint32(100), spec.Network)
+	require.Len(t, spec.Alloc, 1)
+
+	require.NoError(t, os.Mkdir(filepath.Join(dataDir, ChainDir), 0755))
+	require.Error(t, run("--datadir", dataDir, "--genesis", genesisFile))
+}
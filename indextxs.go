@@ -0,0 +1,81 @@
+package main
+
+import (
+	"fmt"
+	"github.com/idena-network/idena-go/blockchain"
+	"github.com/idena-network/idena-go/common/eventbus"
+	"github.com/idena-network/idena-go/config"
+	"github.com/idena-network/idena-go/database"
+	"github.com/idena-network/idena-go/ipfs"
+	"github.com/idena-network/idena-go/node"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+const indexTxsProgressInterval = 10000
+
+var (
+	indexFromFlag = cli.Uint64Flag{
+		Name:  "from",
+		Usage: "First block height to index",
+		Value: 1,
+	}
+	indexToFlag = cli.Uint64Flag{
+		Name:  "to",
+		Usage: "Last block height to index, the head is used by default",
+	}
+)
+
+var indexTxsCommand = cli.Command{
+	Name:  "indextxs",
+	Usage: "Build tx and receipt indexes of synced blocks, so bcn_transaction finds txs of a fast synced chain, the node must be stopped",
+	Flags: []cli.Flag{
+		config.CfgFileFlag,
+		config.DataDirFlag,
+		config.DbBackendFlag,
+		indexFromFlag,
+		indexToFlag,
+	},
+	Action: indexTransactions,
+}
+
+func indexTransactions(ctx *cli.Context) error {
+	cfg, err := config.MakeConfig(ctx, func(cfg *config.Config) {})
+	if err != nil {
+		return err
+	}
+	db, err := node.OpenDatabase(cfg.DataDir, node.ChainDbName, cfg.Database.Backend, 16, 16, false)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	repo := database.NewRepo(db)
+	head := repo.ReadHead()
+	if head == nil {
+		return errors.New("chain is empty")
+	}
+	from, to := ctx.Uint64(indexFromFlag.Name), head.Height()
+	if ctx.IsSet(indexToFlag.Name) && ctx.Uint64(indexToFlag.Name) < to {
+		to = ctx.Uint64(indexToFlag.Name)
+	}
+	if from > to {
+		return errors.Errorf("invalid range %d-%d, head %d", from, to, head.Height())
+	}
+
+	// block bodies are kept by ipfs, missing ones are loaded from the network
+	proxy, err := ipfs.NewIpfsProxy(cfg.IpfsConf, eventbus.New())
+	if err != nil {
+		return err
+	}
+	defer proxy.Close()
+	indexed, err := blockchain.IndexTransactions(repo, proxy, from, to, func(height uint64) {
+		if (height-from)%indexTxsProgressInterval == 0 {
+			fmt.Fprintf(ctx.App.Writer, "Indexing block %d of %d\n", height, to)
+		}
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(ctx.App.Writer, "Blocks %d-%d are indexed, new indexed txs: %d\n", from, to, indexed)
+	return nil
+}
@@ -92,6 +92,8 @@ type Proxy interface {
 	GetWithSizeLimit(key []byte, dataType DataType, size int64) ([]byte, error)
 	PubSub() *pubsub.PubSub
 	GC() (ctx context.Context, cancel context.CancelFunc)
+	// Close stops the IPFS node and closes its repo
+	Close() error
 }
 
 type ipfsProxy struct {
@@ -125,9 +127,9 @@ func NewIpfsProxy(cfg *config.IpfsConfig, bus eventbus.Bus) (Proxy, error) {
 		return nil, err
 	}
 
-	logger := log.New()
+	logger := log.New(log.ModuleKey, "ipfs")
 
-	node, ctx, cancelCtx, err := createNode(cfg, bus)
+	node, ctx, cancelCtx, err := createNode(cfg, bus, logger)
 	if err != nil {
 		return nil, err
 	}
@@ -158,22 +160,21 @@ func NewIpfsProxy(cfg *config.IpfsConfig, bus eventbus.Bus) (Proxy, error) {
 	return p, nil
 }
 
-func createNode(cfg *config.IpfsConfig, eventBus eventbus.Bus) (*core.IpfsNode, context.Context, context.CancelFunc, error) {
+func createNode(cfg *config.IpfsConfig, eventBus eventbus.Bus, logger log.Logger) (*core.IpfsNode, context.Context, context.CancelFunc, error) {
 	dataDir, _ := filepath.Abs(cfg.DataDir)
 
-	if ln, err := net.Listen("tcp", ":"+strconv.Itoa(cfg.IpfsPort)); err == nil {
-		ln.Close()
-	} else {
+	if err := checkListenPort(cfg); err != nil {
 		return nil, nil, func() {}, errors.Errorf("cannot start IPFS node on port %v, err: %v", cfg.IpfsPort, err.Error())
 	}
 
-	_, err := configureIpfs(cfg, eventBus)
+	ctx, cancelCtx := context.WithCancel(context.Background())
+
+	_, err := configureIpfs(cfg, eventBus, natAnnounceAddrs(ctx, cfg, logger))
 	if err != nil {
+		cancelCtx()
 		return nil, nil, func() {}, err
 	}
 
-	ctx, cancelCtx := context.WithCancel(context.Background())
-
 	node, err := core.NewNode(ctx, getNodeConfig(dataDir))
 	if err != nil {
 		cancelCtx()
@@ -198,6 +199,13 @@ func (p *ipfsProxy) gc(ctx context.Context, cancel context.CancelFunc) {
 	cancel()
 }
 
+func (p *ipfsProxy) Close() error {
+	p.rwLock.Lock()
+	defer p.rwLock.Unlock()
+	p.nodeCtxCancel()
+	return p.node.Close()
+}
+
 func (p *ipfsProxy) changePort() {
 	p.rwLock.Lock()
 	defer p.rwLock.Unlock()
@@ -218,7 +226,7 @@ func (p *ipfsProxy) changePort() {
 	for {
 		p.cfg.IpfsPort += 1
 
-		node, ctx, cancelCtx, err := createNode(p.cfg, p.bus)
+		node, ctx, cancelCtx, err := createNode(p.cfg, p.bus, p.log)
 
 		if err != nil {
 			continue
@@ -235,7 +243,7 @@ func (p *ipfsProxy) changePort() {
 
 func (p *ipfsProxy) watchPeers() {
 	api, _ := coreapi.NewCoreAPI(p.node)
-	logger := log.New("component", "ipfs watch")
+	logger := log.New(log.ModuleKey, "ipfs", "component", "ipfs watch")
 
 	for {
 		if !p.cfg.StaticPort && time.Now().UTC().Sub(p.lastPeersUpdatedTime) > ZeroPeersTimeout {
@@ -598,12 +606,50 @@ func (p *ipfsProxy) Cid(data []byte) (cid.Cid, error) {
 	return nd.Cid(), nil
 }
 
-func configureIpfs(cfg *config.IpfsConfig, eventBus eventbus.Bus) (*ipfsConf.Config, error) {
+// swarmAddrs returns addresses the host listens on, an address per listen IP with the IPFS port
+func swarmAddrs(cfg *config.IpfsConfig) ([]string, error) {
+	ips, err := config.ParseListenIps(cfg.ListenIps)
+	if err != nil {
+		return nil, err
+	}
+	addrs := make([]string, 0, len(ips))
+	for _, ip := range ips {
+		addrs = append(addrs, tcpAddr(ip, cfg.IpfsPort))
+	}
+	return addrs, nil
+}
+
+// checkListenPort checks that the IPFS port is free, the port is checked on each listen IP if they are set, otherwise
+// on all interfaces at once, so the node starts on hosts without IPv6
+func checkListenPort(cfg *config.IpfsConfig) error {
+	hosts := []string{""}
+	if len(cfg.ListenIps) > 0 {
+		ips, err := config.ParseListenIps(cfg.ListenIps)
+		if err != nil {
+			return err
+		}
+		hosts = hosts[:0]
+		for _, ip := range ips {
+			hosts = append(hosts, ip.String())
+		}
+	}
+	for _, host := range hosts {
+		ln, err := net.Listen("tcp", net.JoinHostPort(host, strconv.Itoa(cfg.IpfsPort)))
+		if err != nil {
+			return err
+		}
+		ln.Close()
+	}
+	return nil
+}
+
+func configureIpfs(cfg *config.IpfsConfig, eventBus eventbus.Bus, announce []string) (*ipfsConf.Config, error) {
 	updateIpfsConfig := func(ipfsConfig *ipfsConf.Config) error {
-		ipfsConfig.Addresses.Swarm = []string{
-			fmt.Sprintf("/ip4/0.0.0.0/tcp/%d", cfg.IpfsPort),
-			fmt.Sprintf("/ip6/::/tcp/%d", cfg.IpfsPort),
+		swarm, err := swarmAddrs(cfg)
+		if err != nil {
+			return err
 		}
+		ipfsConfig.Addresses.Swarm = swarm
 
 		bps, err := ipfsConf.ParseBootstrapPeers(cfg.BootNodes)
 		if err != nil {
@@ -633,6 +679,7 @@ func configureIpfs(cfg *config.IpfsConfig, eventBus eventbus.Bus) (*ipfsConf.Con
 				return err
 			}
 		}
+		applyNat(ipfsConfig, cfg, announce)
 
 		return nil
 	}
@@ -671,7 +718,7 @@ func configureIpfs(cfg *config.IpfsConfig, eventBus eventbus.Bus) (*ipfsConf.Con
 				if err := os.Remove(configFilename); err != nil {
 					return nil, err
 				}
-				return configureIpfs(cfg, eventBus)
+				return configureIpfs(cfg, eventBus, announce)
 			}
 			return nil, err
 		}
@@ -849,3 +896,7 @@ func configAt(repoPath string) (*ipfsConf.Config, error) {
 func (i *memoryIpfs) GC() (ctx context.Context, cancel context.CancelFunc) {
 	panic("implement me")
 }
+
+func (i *memoryIpfs) Close() error {
+	return nil
+}
@@ -111,3 +111,16 @@ func TestIpfsProxy_Get_Limit(t *testing.T) {
 	_, err = proxy.Get(cid.Bytes(), Block)
 	require.NoError(err)
 }
+
+func Test_swarmAddrs(t *testing.T) {
+	addrs, err := swarmAddrs(&config.IpfsConfig{IpfsPort: 40405})
+	require.NoError(t, err)
+	require.Equal(t, []string{"/ip4/0.0.0.0/tcp/40405", "/ip6/::/tcp/40405"}, addrs)
+
+	addrs, err = swarmAddrs(&config.IpfsConfig{IpfsPort: 40405, ListenIps: []string{"10.0.0.1", "2001:db8::1"}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"/ip4/10.0.0.1/tcp/40405", "/ip6/2001:db8::1/tcp/40405"}, addrs)
+
+	_, err = swarmAddrs(&config.IpfsConfig{IpfsPort: 40405, ListenIps: []string{"host"}})
+	require.Error(t, err)
+}
@@ -0,0 +1,99 @@
+package ipfs
+
+import (
+	"context"
+	"fmt"
+	"github.com/idena-network/idena-go/config"
+	"github.com/idena-network/idena-go/log"
+	ipfsConf "github.com/ipfs/kubo/config"
+	nat "github.com/libp2p/go-nat"
+	"net"
+	"time"
+)
+
+const (
+	natDiscoveryTimeout     = 10 * time.Second
+	natMappingTimeout       = 20 * time.Minute
+	natMappingRenewInterval = 15 * time.Minute
+	natMappingDescription   = "idena"
+)
+
+// natAnnounceAddrs maps the IPFS port on the gateway of the upnp and pmp modes and returns the external address
+// to announce, the mapping is renewed until the node context is done. The extip mode only announces the given IP
+func natAnnounceAddrs(ctx context.Context, cfg *config.IpfsConfig, logger log.Logger) []string {
+	mode, extIp, _ := config.ParseNat(cfg.Nat)
+	port := cfg.IpfsPort
+	switch mode {
+	case config.NatUpnp, config.NatPmp:
+		gateway := discoverNat(ctx, mode)
+		if gateway == nil {
+			logger.Warn("NAT gateway is not found", "mode", mode)
+			return nil
+		}
+		extPort, err := gateway.AddPortMapping("tcp", cfg.IpfsPort, natMappingDescription, natMappingTimeout)
+		if err != nil {
+			logger.Warn("Failed to map port", "type", gateway.Type(), "port", cfg.IpfsPort, "err", err)
+			return nil
+		}
+		go renewNatMapping(ctx, gateway, cfg.IpfsPort, logger)
+		extIp, err = gateway.GetExternalAddress()
+		if err != nil {
+			logger.Warn("Failed to get external IP", "type", gateway.Type(), "err", err)
+			return nil
+		}
+		port = extPort
+		logger.Info("Port mapped", "type", gateway.Type(), "externalIp", extIp, "externalPort", extPort)
+	case config.NatExtIp:
+	default:
+		return nil
+	}
+	return []string{tcpAddr(extIp, port)}
+}
+
+func discoverNat(ctx context.Context, mode string) nat.NAT {
+	ctx, cancel := context.WithTimeout(ctx, natDiscoveryTimeout)
+	defer cancel()
+	for gateway := range nat.DiscoverNATs(ctx) {
+		if (mode == config.NatPmp) == (gateway.Type() == "NAT-PMP") {
+			return gateway
+		}
+	}
+	return nil
+}
+
+func renewNatMapping(ctx context.Context, gateway nat.NAT, port int, logger log.Logger) {
+	ticker := time.NewTicker(natMappingRenewInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			if err := gateway.DeletePortMapping("tcp", port); err != nil {
+				logger.Debug("Failed to delete port mapping", "port", port, "err", err)
+			}
+			return
+		case <-ticker.C:
+			if _, err := gateway.AddPortMapping("tcp", port, natMappingDescription, natMappingTimeout); err != nil {
+				logger.Warn("Failed to renew port mapping", "type", gateway.Type(), "port", port, "err", err)
+			}
+		}
+	}
+}
+
+func tcpAddr(ip net.IP, port int) string {
+	if ip4 := ip.To4(); ip4 != nil {
+		return fmt.Sprintf("/ip4/%v/tcp/%d", ip4, port)
+	}
+	return fmt.Sprintf("/ip6/%v/tcp/%d", ip, port)
+}
+
+// applyNat overrides the port mapping of the IPFS profile, the node maps the port itself in the upnp and pmp modes
+func applyNat(ipfsConfig *ipfsConf.Config, cfg *config.IpfsConfig, announce []string) {
+	if cfg.Nat == "" {
+		return
+	}
+	ipfsConfig.Swarm.DisableNatPortMap = true
+	ipfsConfig.Addresses.AppendAnnounce = announce
+	if ipfsConfig.Addresses.AppendAnnounce == nil {
+		ipfsConfig.Addresses.AppendAnnounce = []string{}
+	}
+}
@@ -0,0 +1,81 @@
+package log
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ModuleKey is the context key naming the subsystem which writes a record, e.g. log.New(log.ModuleKey, "p2p")
+const ModuleKey = "module"
+
+// ModuleLevels holds verbosity levels of modules, records of other modules are filtered with the default level
+type ModuleLevels struct {
+	Default Lvl
+	Modules map[string]Lvl
+}
+
+// ParseModuleLevels parses a comma separated list like "p2p=debug,consensus=info".
+// An entry without a module name overrides the default level. Levels are names or numbers as in --verbosity.
+func ParseModuleLevels(spec string, defaultLvl Lvl) (ModuleLevels, error) {
+	levels := ModuleLevels{
+		Default: defaultLvl,
+		Modules: make(map[string]Lvl),
+	}
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		module, value := "", entry
+		if i := strings.Index(entry, "="); i >= 0 {
+			module, value = strings.TrimSpace(entry[:i]), strings.TrimSpace(entry[i+1:])
+			if module == "" {
+				return ModuleLevels{}, fmt.Errorf("empty module name in %q", entry)
+			}
+		}
+		lvl, err := parseLvl(value)
+		if err != nil {
+			return ModuleLevels{}, err
+		}
+		if module == "" {
+			levels.Default = lvl
+		} else {
+			levels.Modules[module] = lvl
+		}
+	}
+	return levels, nil
+}
+
+func parseLvl(value string) (Lvl, error) {
+	if n, err := strconv.Atoi(value); err == nil {
+		if n < int(LvlCrit) || n > int(LvlTrace) {
+			return LvlInfo, fmt.Errorf("unknown level: %v", value)
+		}
+		return Lvl(n), nil
+	}
+	return LvlFromString(strings.ToLower(value))
+}
+
+// ModuleLvlFilterHandler passes records with levels not more verbose than the level of their module to the wrapped handler
+func ModuleLvlFilterHandler(levels ModuleLevels, h Handler) Handler {
+	return FilterHandler(func(r *Record) bool {
+		maxLvl := levels.Default
+		if module, ok := recordModule(r); ok {
+			if lvl, ok := levels.Modules[module]; ok {
+				maxLvl = lvl
+			}
+		}
+		return r.Lvl <= maxLvl
+	}, h)
+}
+
+func recordModule(r *Record) (string, bool) {
+	for i := 0; i+1 < len(r.Ctx); i += 2 {
+		if r.Ctx[i] == ModuleKey {
+			module, ok := r.Ctx[i+1].(string)
+			return module, ok
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,77 @@
+package log
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestParseModuleLevels(t *testing.T) {
+	tests := []struct {
+		spec     string
+		expected ModuleLevels
+		err      bool
+	}{
+		{
+			spec:     "",
+			expected: ModuleLevels{Default: LvlInfo, Modules: map[string]Lvl{}},
+		},
+		{
+			spec:     "p2p=debug,consensus=warn",
+			expected: ModuleLevels{Default: LvlInfo, Modules: map[string]Lvl{"p2p": LvlDebug, "consensus": LvlWarn}},
+		},
+		{
+			spec:     " error , p2p = 5 ,",
+			expected: ModuleLevels{Default: LvlError, Modules: map[string]Lvl{"p2p": LvlTrace}},
+		},
+		{
+			spec:     "P2P=DEBUG",
+			expected: ModuleLevels{Default: LvlInfo, Modules: map[string]Lvl{"P2P": LvlDebug}},
+		},
+		{spec: "p2p=verbose", err: true},
+		{spec: "p2p=6", err: true},
+		{spec: "-1", err: true},
+		{spec: "=debug", err: true},
+		{spec: "p2p=", err: true},
+	}
+	for _, test := range tests {
+		levels, err := ParseModuleLevels(test.spec, LvlInfo)
+		if test.err {
+			require.Error(t, err, test.spec)
+			continue
+		}
+		require.NoError(t, err, test.spec)
+		require.Equal(t, test.expected, levels, test.spec)
+	}
+}
+
+func TestModuleLvlFilterHandler(t *testing.T) {
+	levels := ModuleLevels{
+		Default: LvlInfo,
+		Modules: map[string]Lvl{"p2p": LvlDebug, "consensus": LvlError},
+	}
+	var passed []*Record
+	h := ModuleLvlFilterHandler(levels, FuncHandler(func(r *Record) error {
+		passed = append(passed, r)
+		return nil
+	}))
+
+	tests := []struct {
+		lvl    Lvl
+		ctx    []interface{}
+		passed bool
+	}{
+		{lvl: LvlDebug, ctx: []interface{}{ModuleKey, "p2p"}, passed: true},
+		{lvl: LvlTrace, ctx: []interface{}{ModuleKey, "p2p"}, passed: false},
+		{lvl: LvlWarn, ctx: []interface{}{ModuleKey, "consensus"}, passed: false},
+		{lvl: LvlError, ctx: []interface{}{"peer", "id", ModuleKey, "consensus"}, passed: true},
+		{lvl: LvlInfo, ctx: []interface{}{ModuleKey, "mempool"}, passed: true},
+		{lvl: LvlDebug, ctx: []interface{}{ModuleKey, "mempool"}, passed: false},
+		{lvl: LvlDebug, ctx: nil, passed: false},
+		{lvl: LvlInfo, ctx: []interface{}{ModuleKey, 1}, passed: true},
+	}
+	for i, test := range tests {
+		passed = nil
+		require.NoError(t, h.Log(&Record{Lvl: test.lvl, Ctx: test.ctx}))
+		require.Equal(t, test.passed, len(passed) == 1, "case %v", i)
+	}
+}
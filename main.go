@@ -6,11 +6,14 @@ import (
 	"github.com/idena-network/idena-go/database"
 	"github.com/idena-network/idena-go/log"
 	"github.com/idena-network/idena-go/node"
+	"github.com/pkg/errors"
 	"github.com/urfave/cli"
 	"io/ioutil"
 	"os"
+	"os/signal"
 	"path/filepath"
 	"runtime"
+	"syscall"
 )
 
 const (
@@ -23,50 +26,82 @@ var (
 	version = "0.0.1"
 )
 
+var nodeFlags = []cli.Flag{
+	config.CfgFileFlag,
+	config.DataDirFlag,
+	config.TcpPortFlag,
+	config.RpcHostFlag,
+	config.RpcPortFlag,
+	config.WsHostFlag,
+	config.WsPortFlag,
+	config.MetricsHostFlag,
+	config.MetricsPortFlag,
+	config.PprofHostFlag,
+	config.PprofPortFlag,
+	config.ResourceLogIntervalFlag,
+	config.BootNodeFlag,
+	config.AutomineFlag,
+	config.IpfsBootNodeFlag,
+	config.IpfsPortFlag,
+	config.NatFlag,
+	config.ListenIpsFlag,
+	config.NoDiscoveryFlag,
+	config.VerbosityFlag,
+	config.GodAddressFlag,
+	config.CeremonyTimeFlag,
+	config.MaxNetworkDelayFlag,
+	config.FastSyncFlag,
+	config.ForceFullSyncFlag,
+	config.ProfileFlag,
+	config.IpfsPortStaticFlag,
+	config.UnlockFlag,
+	config.PasswordFileFlag,
+	config.ApiKeyFlag,
+	config.RpcTLSCertFlag,
+	config.RpcTLSKeyFlag,
+	config.IpcPathFlag,
+	config.LogFileSizeFlag,
+	config.LogColoring,
+	config.LogLevelFlag,
+	config.LogFormatFlag,
+	config.AutoOnline,
+	config.ArchiveFlag,
+	config.StatesRetentionFlag,
+	config.IndexAddressesFlag,
+	config.MinFeePerByteFlag,
+	config.CheckpointsFlag,
+	config.DbBackendFlag,
+}
+
 func main() {
 	app := cli.NewApp()
 	app.Version = version
 
-	app.Flags = []cli.Flag{
-		config.CfgFileFlag,
-		config.DataDirFlag,
-		config.TcpPortFlag,
-		config.RpcHostFlag,
-		config.RpcPortFlag,
-		config.BootNodeFlag,
-		config.AutomineFlag,
-		config.IpfsBootNodeFlag,
-		config.IpfsPortFlag,
-		config.NoDiscoveryFlag,
-		config.VerbosityFlag,
-		config.GodAddressFlag,
-		config.CeremonyTimeFlag,
-		config.MaxNetworkDelayFlag,
-		config.FastSyncFlag,
-		config.ForceFullSyncFlag,
-		config.ProfileFlag,
-		config.IpfsPortStaticFlag,
-		config.ApiKeyFlag,
-		config.LogFileSizeFlag,
-		config.LogColoring,
-		config.AutoOnline,
+	app.Flags = nodeFlags
+
+	app.Commands = []cli.Command{
+		accountCommand,
+		dumpConfigCommand,
+		exportCommand,
+		importCommand,
+		initCommand,
+		indexTxsCommand,
+		repairCommand,
+		replayCommand,
 	}
 
 	app.Action = func(context *cli.Context) error {
-		logLvl := log.Lvl(context.Int(config.VerbosityFlag.Name))
-		logFileSize := context.Int(config.LogFileSizeFlag.Name)
-
-		useLogColor := true
-		if runtime.GOOS == "windows" {
-			useLogColor = context.Bool(config.LogColoring.Name)
+		// flags are applied before the config file is loaded, so messages of loading are logged as well
+		logCfg := config.GetDefaultLogConfig()
+		config.ApplyLogFlags(context, logCfg)
+		handler, logLevels, _, err := makeLogHandler(logCfg)
+		if err != nil {
+			return err
 		}
-
-		handler := log.LvlFilterHandler(logLvl, log.StreamHandler(os.Stdout, log.TerminalFormat(useLogColor)))
-
-		log.Root().SetHandler(handler)
+		log.Root().SetHandler(log.ModuleLvlFilterHandler(logLevels, handler))
 
 		cfg, err := config.MakeConfig(context, func(cfg *config.Config) {
-			db, err := node.OpenDatabase(cfg.DataDir, "idenachain", 16, 16, false)
+			db, err := node.OpenDatabase(cfg.DataDir, node.ChainDbName, cfg.Database.Backend, 16, 16, false)
 			if err != nil {
 				log.Error("Cannot transform consensus config", "err", err)
 				return
@@ -86,19 +121,29 @@ func main() {
 		if err != nil {
 			return err
 		}
+		if context.IsSet(config.UnlockFlag.Name) {
+			if err := unlockNodeKey(cfg, context.String(config.UnlockFlag.Name), context.String(config.PasswordFileFlag.Name)); err != nil {
+				return err
+			}
+		}
 		/*
 			err = dropOldDirOnFork(cfg)
 			if err != nil {
 				return err
 			} */
 
-		fileHandler, err := getLogFileHandler(cfg, logFileSize)
+		handler, logLevels, fileFormat, err := makeLogHandler(cfg.Log)
+		if err != nil {
+			return err
+		}
+		fileHandler, err := getLogFileHandler(cfg, cfg.Log.FileSize, fileFormat)
 
 		if err != nil {
 			return err
 		}
 
-		log.Root().SetHandler(log.LvlFilterHandler(logLvl, log.MultiHandler(handler, fileHandler)))
+		logSink := log.MultiHandler(handler, fileHandler)
+		log.Root().SetHandler(log.ModuleLvlFilterHandler(logLevels, logSink))
 
 		log.Info("Idena node is starting", "version", version)
 
@@ -106,9 +151,25 @@ func main() {
 		if err != nil {
 			return err
 		}
+		n.SetConfigReloader(node.ConfigReloader{
+			// the consensus config isn't reloaded, so the transformation which reads the chain db is skipped
+			Load: func() (*config.Config, error) {
+				return config.MakeConfig(context, func(cfg *config.Config) {})
+			},
+			ApplyLog: func(cfg *config.LogConfig) error {
+				levels, err := cfg.ModuleLevels()
+				if err != nil {
+					return err
+				}
+				log.Root().SetHandler(log.ModuleLvlFilterHandler(levels, logSink))
+				return nil
+			},
+		})
 		if err := n.Start(); err != nil {
 			return err
 		}
+		go stopOnSignal(n)
+		go reloadOnSignal(n)
 		n.WaitForStop()
 		return nil
 	}
@@ -120,7 +181,51 @@ func main() {
 	}
 }
 
-func getLogFileHandler(cfg *config.Config, logFileSize int) (log.Handler, error) {
+// stopOnSignal stops the node on SIGINT or SIGTERM, the process exits immediately on a repeated signal
+func stopOnSignal(n *node.Node) {
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	sig := <-sigs
+	log.Info("Got signal, shutting down", "signal", sig)
+	go n.Stop()
+	<-sigs
+	log.Warn("Got repeated signal, exiting without graceful shutdown")
+	os.Exit(1)
+}
+
+// reloadOnSignal applies the changed config file on SIGHUP
+func reloadOnSignal(n *node.Node) {
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGHUP)
+	for range sigs {
+		if _, err := n.ReloadConfig(); err != nil {
+			log.Error("Failed to reload config", "err", err)
+		}
+	}
+}
+
+// makeLogHandler returns the stdout handler, log levels and the format of log files defined by the config
+func makeLogHandler(cfg *config.LogConfig) (log.Handler, log.ModuleLevels, log.Format, error) {
+	logLevels, err := cfg.ModuleLevels()
+	if err != nil {
+		return nil, logLevels, nil, err
+	}
+	useLogColor := true
+	if runtime.GOOS == "windows" {
+		useLogColor = cfg.Coloring
+	}
+	stdoutFormat, fileFormat := log.TerminalFormat(useLogColor), log.TerminalFormat(false)
+	switch cfg.Format {
+	case config.LogFormatTerminal:
+	case config.LogFormatJson:
+		stdoutFormat, fileFormat = log.JSONFormat(), log.JSONFormat()
+	default:
+		return nil, logLevels, nil, errors.Errorf("unknown log format %v", cfg.Format)
+	}
+	return log.StreamHandler(os.Stdout, stdoutFormat), logLevels, fileFormat, nil
+}
+
+func getLogFileHandler(cfg *config.Config, logFileSize int, format log.Format) (log.Handler, error) {
 	path := filepath.Join(cfg.DataDir, LogDir)
 	if _, err := os.Stat(path); os.IsNotExist(err) {
 		if err := os.MkdirAll(path, 0755); err != nil {
@@ -128,7 +233,7 @@ func getLogFileHandler(cfg *config.Config, logFileSize int) (log.Handler, error)
 		}
 	}
 
-	fileHandler, _ := log.RotatingFileHandler(filepath.Join(path, "output.log"), uint32(logFileSize*1024), log.TerminalFormat(false))
+	fileHandler, _ := log.RotatingFileHandler(filepath.Join(path, "output.log"), uint32(logFileSize*1024), format)
 
 	return fileHandler, nil
 }
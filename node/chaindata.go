@@ -0,0 +1,28 @@
+package node
+
+import (
+	"github.com/idena-network/idena-go/common/eventbus"
+	"github.com/idena-network/idena-go/config"
+	"github.com/idena-network/idena-go/stats/collector"
+	"io"
+)
+
+// ImportChain inserts exported blocks into the chain of the data dir, blocks are processed by the same components
+// as blocks of a running node, so epoch switches and validation results are applied as during network sync
+func ImportChain(cfg *config.Config, appVersion string, r io.Reader, onBlock func(height uint64)) (int, error) {
+	nodeCtx, err := NewNodeWithInjections(cfg, eventbus.New(), collector.NewStatsCollector(), appVersion)
+	if err != nil {
+		return 0, err
+	}
+	node := nodeCtx.Node
+	defer func() {
+		node.stopInitialRPC()
+		node.ipfsProxy.Close()
+		node.db.Close()
+		node.secStore.Destroy()
+	}()
+	if err := node.initialize(0); err != nil {
+		return 0, err
+	}
+	return node.blockchain.ImportBlocks(r, onBlock)
+}
@@ -0,0 +1,109 @@
+package node
+
+import (
+	"github.com/idena-network/idena-go/config"
+	"github.com/idena-network/idena-go/log"
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/filter"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/tendermint/tm-db"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// ChainDbName is the name of the db which keeps the chain and its state in the datadir
+const ChainDbName = "idenachain"
+
+// dbRecoverers rebuild the files of a db after a crash, backends without a recoverer keep their files consistent
+// by themselves
+var dbRecoverers = map[string]func(path string) error{
+	config.DbBackendGoLevelDb: recoverGoLevelDb,
+}
+
+func dbPath(datadir string, name string) string {
+	return filepath.Join(datadir, name+".db")
+}
+
+func OpenDatabase(datadir string, name string, backend string, cache int, handles int, compact bool) (db.DB, error) {
+	if existing := detectDbBackend(datadir, name); existing != "" && existing != backend {
+		return nil, errors.Errorf("%v db is created by %v backend, it can't be opened by %v", name, existing, backend)
+	}
+	if backend != config.DbBackendGoLevelDb {
+		dbName := name
+		if backend == config.DbBackendBadgerDb {
+			// badger doesn't add the extension to the name, so it's added here to keep the db at the same path
+			dbName = filepath.Base(dbPath(datadir, name))
+		}
+		res, err := db.NewDB(dbName, db.BackendType(backend), datadir)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot open %v db, the node may be built without the %v tag", name, backend)
+		}
+		return res, nil
+	}
+	res, err := db.NewGoLevelDBWithOpts(name, datadir, &opt.Options{
+		OpenFilesCacheCapacity: handles,
+		BlockCacheCapacity:     cache / 2 * opt.MiB,
+		WriteBuffer:            cache / 4 * opt.MiB,
+		Filter:                 filter.NewBloomFilter(10),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if compact {
+		if err := compactDb(res); err != nil {
+			res.Close()
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// detectDbBackend returns the backend which created the db by the files it keeps, an empty string means that the db
+// doesn't exist or its backend is unknown
+func detectDbBackend(datadir string, name string) string {
+	path := dbPath(datadir, name)
+	info, err := os.Stat(path)
+	if err != nil {
+		return ""
+	}
+	if !info.IsDir() {
+		return config.DbBackendBoltDb
+	}
+	if _, err := os.Stat(filepath.Join(path, "CURRENT")); err == nil {
+		return config.DbBackendGoLevelDb
+	}
+	if _, err := os.Stat(filepath.Join(path, "KEYREGISTRY")); err == nil {
+		return config.DbBackendBadgerDb
+	}
+	return ""
+}
+
+// recoverGoLevelDb rebuilds the manifest from the table files, so the db is opened even if the manifest or the journal
+// was partially written
+func recoverGoLevelDb(path string) error {
+	res, err := leveldb.RecoverFile(path, nil)
+	if err != nil {
+		return err
+	}
+	return res.Close()
+}
+
+func compactDb(goLevelDB *db.GoLevelDB) error {
+	start := time.Now()
+	logTimeout := time.After(time.Second)
+	completed := make(chan struct{})
+	go func() {
+		select {
+		case <-completed:
+		case <-logTimeout:
+			log.Info("Start compacting DB")
+			<-completed
+			log.Info("DB compacted", "d", time.Since(start))
+		}
+	}()
+	err := goLevelDB.ForceCompact(nil, nil)
+	completed <- struct{}{}
+	return err
+}
@@ -0,0 +1,22 @@
+//go:build badgerdb
+// +build badgerdb
+
+package node
+
+import (
+	"github.com/dgraph-io/badger/v2"
+	"github.com/idena-network/idena-go/config"
+)
+
+func init() {
+	dbRecoverers[config.DbBackendBadgerDb] = recoverBadgerDb
+}
+
+// recoverBadgerDb opens the db with truncation of the value log, so entries partially written before a crash are dropped
+func recoverBadgerDb(path string) error {
+	res, err := badger.Open(badger.DefaultOptions(path).WithTruncate(true).WithLogger(nil))
+	if err != nil {
+		return err
+	}
+	return res.Close()
+}
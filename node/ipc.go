@@ -0,0 +1,54 @@
+package node
+
+import (
+	"github.com/idena-network/idena-go/rpc"
+	"path/filepath"
+)
+
+// ipcPath returns the path of the IPC socket, relative paths are resolved in the data dir
+func (node *Node) ipcPath() string {
+	path := node.config.RPC.IPCPath
+	if path == "" || filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(node.config.DataDir, path)
+}
+
+// startIPC opens the Unix domain socket serving all namespaces, local clients are trusted by the file permissions
+// of the socket, so no key is required
+func (node *Node) startIPC(path string, apis []rpc.API) error {
+	if path == "" {
+		return nil
+	}
+	listener, handler, err := rpc.StartIPCEndpoint(path, apis)
+	if err != nil {
+		return err
+	}
+	node.log.Info("IPC endpoint opened", "url", path)
+
+	node.ipcListener = listener
+	node.ipcHandler = handler
+	return nil
+}
+
+// stopIPC terminates the IPC RPC endpoint
+func (node *Node) stopIPC() {
+	if node.ipcListener != nil {
+		node.ipcListener.Close()
+		node.ipcListener = nil
+
+		node.log.Info("IPC endpoint closed", "url", node.ipcPath())
+	}
+	if node.ipcHandler != nil {
+		node.ipcHandler.Stop()
+		node.ipcHandler = nil
+	}
+}
+
+// endpointScheme returns the scheme of an endpoint URL, the secure one is used if TLS is enabled
+func endpointScheme(scheme string, auth rpc.EndpointAuth) string {
+	if auth.TLSCertFile != "" && auth.TLSKeyFile != "" {
+		return scheme + "s"
+	}
+	return scheme
+}
@@ -0,0 +1,82 @@
+package node
+
+import (
+	"fmt"
+	"github.com/idena-network/idena-go/common/prometheus"
+	"github.com/rcrowley/go-metrics"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const dbSizeUpdateInterval = time.Minute
+
+// registerMetrics registers gauges of the chain, sync and database state, protocol and consensus metrics are registered by their packages
+func (node *Node) registerMetrics() {
+	metrics.GetOrRegister("chain.height", metrics.NewFunctionalGauge(func() int64 {
+		return int64(node.blockchain.Head.Height())
+	}))
+	metrics.GetOrRegister("sync.syncing", metrics.NewFunctionalGauge(func() int64 {
+		if node.downloader.IsSyncing() {
+			return 1
+		}
+		return 0
+	}))
+	metrics.GetOrRegister("sync.head", metrics.NewFunctionalGauge(func() int64 {
+		head, _ := node.downloader.SyncProgress()
+		return int64(head)
+	}))
+	metrics.GetOrRegister("sync.top", metrics.NewFunctionalGauge(func() int64 {
+		_, top := node.downloader.SyncProgress()
+		return int64(top)
+	}))
+	// walking the database directory is too slow to be done on every scrape, so the size is refreshed periodically
+	dbDir := filepath.Join(node.config.DataDir, "idenachain.db")
+	dbSize := metrics.GetOrRegisterGauge("db.size", nil)
+	go func() {
+		for {
+			dbSize.Update(dirSize(dbDir))
+			time.Sleep(dbSizeUpdateInterval)
+		}
+	}()
+}
+
+// startMetrics serves the metrics registry in the Prometheus format
+func (node *Node) startMetrics(endpoint string) error {
+	// Short circuit if metrics aren't being exposed
+	if endpoint == "" {
+		return nil
+	}
+	listener, err := net.Listen("tcp", endpoint)
+	if err != nil {
+		return err
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", prometheus.Handler(metrics.DefaultRegistry))
+	node.metricsServer = &http.Server{Handler: mux}
+	go node.metricsServer.Serve(listener)
+	node.log.Info("Metrics endpoint opened", "url", fmt.Sprintf("http://%s/metrics", listener.Addr()))
+	return nil
+}
+
+func (node *Node) stopMetrics() error {
+	if node.metricsServer == nil {
+		return nil
+	}
+	err := node.metricsServer.Close()
+	node.metricsServer = nil
+	return err
+}
+
+func dirSize(dir string) int64 {
+	var size int64
+	filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err == nil && !info.IsDir() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size
+}
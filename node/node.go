@@ -12,6 +12,7 @@ import (
 	"github.com/idena-network/idena-go/core/appstate"
 	"github.com/idena-network/idena-go/core/ceremony"
 	"github.com/idena-network/idena-go/core/flip"
+	"github.com/idena-network/idena-go/core/identity"
 	"github.com/idena-network/idena-go/core/mempool"
 	"github.com/idena-network/idena-go/core/profile"
 	"github.com/idena-network/idena-go/core/state"
@@ -29,10 +30,9 @@ import (
 	state2 "github.com/idena-network/idena-go/state"
 	"github.com/idena-network/idena-go/stats/collector"
 	"github.com/idena-network/idena-go/subscriptions"
+	"github.com/idena-network/idena-go/txtracker"
 	"github.com/idena-network/idena-go/vm"
 	"github.com/pkg/errors"
-	"github.com/syndtr/goleveldb/leveldb/filter"
-	"github.com/syndtr/goleveldb/leveldb/opt"
 	"github.com/tendermint/tm-db"
 	"net"
 	"net/http"
@@ -40,7 +40,6 @@ import (
 	"path/filepath"
 	"strings"
 	"sync"
-	"time"
 )
 
 type Node struct {
@@ -59,6 +58,10 @@ type Node struct {
 	httpListener    net.Listener // HTTP RPC listener socket to server API requests
 	httpHandler     *rpc.Server  // HTTP RPC request handler to process the API requests
 	httpServer      *http.Server
+	wsListener      net.Listener // WebSocket RPC listener socket to server API requests
+	wsHandler       *rpc.Server  // WebSocket RPC request handler to process the API requests
+	ipcListener     net.Listener // IPC RPC listener socket to serve local API requests
+	ipcHandler      *rpc.Server  // IPC RPC request handler to process the API requests
 	log             log.Logger
 	keyStore        *keystore.KeyStore
 	fp              *flip.Flipper
@@ -73,6 +76,17 @@ type Node struct {
 	subManager      *subscriptions.Manager
 	upgrader        *upgrade.Upgrader
 	nodeState       *state2.NodeState
+	db              db.DB
+	metricsServer   *http.Server
+	pprofServer     *http.Server
+	resourceLogStop chan struct{}
+	profiler        *profiler
+	services        services
+	stopOnce        sync.Once
+	reloader        ConfigReloader
+	reloadMutex     sync.Mutex
+	identityTracker *identity.Tracker
+	txTracker       *txtracker.Tracker
 }
 
 type NodeCtx struct {
@@ -147,7 +161,7 @@ func NewNode(config *config.Config, appVersion string) (*Node, error) {
 
 func NewNodeWithInjections(config *config.Config, bus eventbus.Bus, statsCollector collector.StatsCollector, appVersion string) (*NodeCtx, error) {
 
-	logger := log.New()
+	logger := log.New(log.ModuleKey, "node")
 	nodeState := state2.NewNodeState(bus)
 	httpListener, httpHandler, httpServer, err := startInitialRPC(config, nodeState)
 	if err != nil {
@@ -155,7 +169,7 @@ func NewNodeWithInjections(config *config.Config, bus eventbus.Bus, statsCollect
 	}
 
 	bus.Publish(&events.DatabaseInitEvent{})
-	db, err := OpenDatabase(config.DataDir, "idenachain", 16, 16, true)
+	db, err := OpenDatabase(config.DataDir, ChainDbName, config.Database.Backend, 16, 16, true)
 	bus.Publish(&events.DatabaseInitCompletedEvent{})
 
 	if err != nil {
@@ -184,6 +198,7 @@ func NewNodeWithInjections(config *config.Config, bus eventbus.Bus, statsCollect
 	if err != nil {
 		return nil, err
 	}
+	appState.SetStatesRetention(config.Blockchain.StatesRetention)
 
 	offlineDetector := blockchain.NewOfflineDetector(config, db, appState, secStore, bus)
 
@@ -202,16 +217,26 @@ func NewNodeWithInjections(config *config.Config, bus eventbus.Bus, statsCollect
 	chain := blockchain.NewBlockchain(config, db, txpool, appState, ipfsProxy, secStore, bus, offlineDetector, keyStore, subManager, upgrader)
 	proposals, pendingProofs := pengings.NewProposals(chain, appState, offlineDetector, upgrader, statsCollector)
 	flipper := flip.NewFlipper(db, ipfsProxy, flipKeyPool, txpool, secStore, appState, bus)
+	if config.P2P.BansFile == "" && config.DataDir != "" {
+		config.P2P.BansFile = filepath.Join(config.DataDir, "bans.json")
+	}
+	if config.P2P.PeersFile == "" && config.DataDir != "" {
+		config.P2P.PeersFile = filepath.Join(config.DataDir, "peers.json")
+	}
 	pm := protocol.NewIdenaGossipHandler(ipfsProxy.Host(), ipfsProxy.PubSub(), config.P2P, chain, proposals, votes, txpool, flipper, bus, flipKeyPool, appVersion, &ceremonyChecker{
 		appState: appState,
 		chain:    chain,
 	})
+	flipper.SetFlipRequester(pm.RequestFlip)
 	sm := state.NewSnapshotManager(db, appState.State, bus, ipfsProxy, config)
 	downloader := protocol.NewDownloader(pm, config, chain, ipfsProxy, appState, sm, bus, secStore, statsCollector, subManager, keyStore, upgrader)
 	consensusEngine := consensus.NewEngine(chain, pm, proposals, config, appState, votes, txpool, secStore,
 		downloader, offlineDetector, upgrader, ipfsProxy, bus, statsCollector)
 	ceremony := ceremony.NewValidationCeremony(appState, bus, flipper, secStore, db, txpool, chain, downloader, flipKeyPool, config)
 	profileManager := profile.NewProfileManager(ipfsProxy)
+	identityTracker := identity.NewTracker(bus, appState)
+	txTracker := txtracker.NewTracker(bus, txpool, appState, chain)
+	txTracker.SetBroadcaster(pm.RebroadcastTx)
 
 	deferJob, err := deferredtx.NewJob(bus, config.DataDir, appState, chain, txpool, keyStore, secStore, vm.NewVmImpl)
 	if err != nil {
@@ -219,6 +244,8 @@ func NewNodeWithInjections(config *config.Config, bus eventbus.Bus, statsCollect
 	}
 
 	node := &Node{
+		identityTracker: identityTracker,
+		txTracker:       txTracker,
 		config:          config,
 		blockchain:      chain,
 		pm:              pm,
@@ -246,7 +273,11 @@ func NewNodeWithInjections(config *config.Config, bus eventbus.Bus, statsCollect
 		httpListener:    httpListener,
 		httpHandler:     httpHandler,
 		httpServer:      httpServer,
+		db:              db,
+		profiler:        &profiler{dir: filepath.Join(config.DataDir, profilesDir)},
+		stop:            make(chan struct{}),
 	}
+	node.registerServices()
 	return &NodeCtx{
 		Node:            node,
 		AppState:        appState,
@@ -266,6 +297,16 @@ func (node *Node) Start() error {
 }
 
 func (node *Node) StartWithHeight(height uint64) error {
+	if err := node.initialize(height); err != nil {
+		return err
+	}
+	node.stopInitialRPC()
+	node.registerMetrics()
+	return node.services.start()
+}
+
+// initialize loads the chain and the state and prepares components which process blocks, services aren't started
+func (node *Node) initialize(height uint64) error {
 	if privateKey, err := node.config.NodeKey(); err != nil {
 		return errors.Wrap(err, "cannot initialize node key")
 	} else {
@@ -305,20 +346,108 @@ func (node *Node) StartWithHeight(height uint64) error {
 	node.votes.Initialize(node.blockchain.Head)
 	node.fp.Initialize()
 	node.ceremony.Initialize(node.blockchain.GetBlock(node.blockchain.Head.Hash()))
+	node.identityTracker.Initialize(node.secStore.GetAddress())
+	node.txTracker.Initialize(node.blockchain.Head)
 	node.blockchain.ProvideApplyNewEpochFunc(node.ceremony.ApplyNewEpoch)
-	node.offlineDetector.Start(node.blockchain.Head)
-	node.consensusEngine.Start()
-	node.pm.Start()
-	node.upgrader.Start()
-
-	node.stopInitialRPC()
-	// Configure RPC
-	if err := node.startRPC(); err != nil {
-		return errors.Wrap(err, "cannot start RPC endpoint")
-	}
 	return nil
 }
 
+// registerServices defines the order in which node services are started, they are stopped in reverse order
+func (node *Node) registerServices() {
+	node.services.register("p2p", serviceFuncs{
+		stop: node.ipfsProxy.Close,
+	})
+	node.services.register("offline detector", serviceFuncs{
+		start: func() error {
+			node.offlineDetector.Start(node.blockchain.Head)
+			return nil
+		},
+		stop: func() error {
+			node.offlineDetector.Stop()
+			return nil
+		},
+	})
+	node.services.register("ceremony", serviceFuncs{
+		stop: func() error {
+			node.ceremony.Stop()
+			return nil
+		},
+	})
+	node.services.register("mempool", serviceFuncs{
+		stop: node.txpool.Stop,
+	})
+	node.services.register("consensus", serviceFuncs{
+		start: func() error {
+			node.consensusEngine.Start()
+			return nil
+		},
+		stop: func() error {
+			node.consensusEngine.Stop()
+			return nil
+		},
+	})
+	node.services.register("downloader", serviceFuncs{
+		stop: func() error {
+			node.downloader.Stop()
+			return nil
+		},
+	})
+	node.services.register("protocol", serviceFuncs{
+		start: func() error {
+			node.pm.Start()
+			return nil
+		},
+		stop: func() error {
+			node.pm.Stop()
+			return nil
+		},
+	})
+	node.services.register("upgrader", serviceFuncs{
+		start: func() error {
+			node.upgrader.Start()
+			return nil
+		},
+		stop: func() error {
+			node.upgrader.Stop()
+			return nil
+		},
+	})
+	node.services.register("RPC endpoint", serviceFuncs{
+		start: node.startRPC,
+		stop: func() error {
+			node.stopRPC()
+			return nil
+		},
+	})
+	node.services.register("metrics endpoint", serviceFuncs{
+		start: func() error {
+			return node.startMetrics(node.config.RPC.MetricsEndpoint())
+		},
+		stop: node.stopMetrics,
+	})
+	node.services.register("profiling", serviceFuncs{
+		start: node.startProfiling,
+		stop:  node.stopProfiling,
+	})
+}
+
+// Stop stops node services and flushes the database, WaitForStop returns once the node is stopped
+func (node *Node) Stop() {
+	node.stopOnce.Do(func() {
+		node.log.Info("Stopping node")
+		allStopped := node.services.stop(serviceStopTimeout, func(name string, err error) {
+			node.log.Warn("Failed to stop service", "service", name, "err", err)
+		})
+		if !allStopped {
+			node.log.Error("Database is not closed as some services didn't stop")
+		} else if err := node.db.Close(); err != nil {
+			node.log.Error("Failed to close database", "err", err)
+		}
+		node.log.Info("Node is stopped")
+		close(node.stop)
+	})
+}
+
 func (node *Node) WaitForStop() {
 	<-node.stop
 	node.secStore.Destroy()
@@ -326,7 +455,7 @@ func (node *Node) WaitForStop() {
 
 func startInitialRPC(nodeConfig *config.Config, nodeState *state2.NodeState) (net.Listener, *rpc.Server, *http.Server, error) {
 	apis := initialApis(nodeState)
-	listener, handler, httpServer, err := startInitialHTTP(nodeConfig.RPC.HTTPEndpoint(), apis, nodeConfig.RPC.HTTPModules, nodeConfig.RPC.HTTPCors, nodeConfig.RPC.HTTPVirtualHosts, nodeConfig.RPC.HTTPTimeouts, nodeConfig.RPC.APIKey)
+	listener, handler, httpServer, err := startInitialHTTP(nodeConfig.RPC.HTTPEndpoint(), apis, nodeConfig.RPC.HTTPModules, nodeConfig.RPC.HTTPCors, nodeConfig.RPC.HTTPVirtualHosts, nodeConfig.RPC.HTTPTimeouts, nodeConfig.RPC.EndpointAuth())
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -344,11 +473,11 @@ func initialApis(nodeState *state2.NodeState) []rpc.API {
 	}
 }
 
-func startInitialHTTP(endpoint string, apis []rpc.API, modules []string, cors []string, vhosts []string, timeouts rpc.HTTPTimeouts, apiKey string) (net.Listener, *rpc.Server, *http.Server, error) {
+func startInitialHTTP(endpoint string, apis []rpc.API, modules []string, cors []string, vhosts []string, timeouts rpc.HTTPTimeouts, auth rpc.EndpointAuth) (net.Listener, *rpc.Server, *http.Server, error) {
 	if endpoint == "" {
 		return nil, nil, nil, nil
 	}
-	listener, handler, httpServer, err := rpc.StartHTTPEndpoint(endpoint, apis, modules, cors, vhosts, timeouts, apiKey)
+	listener, handler, httpServer, err := rpc.StartHTTPEndpoint(endpoint, apis, modules, cors, vhosts, timeouts, auth)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -364,7 +493,16 @@ func (node *Node) startRPC() error {
 	// Gather all the possible APIs to surface
 	apis := node.apis()
 
-	if err := node.startHTTP(node.config.RPC.HTTPEndpoint(), apis, node.config.RPC.HTTPModules, node.config.RPC.HTTPCors, node.config.RPC.HTTPVirtualHosts, node.config.RPC.HTTPTimeouts, node.config.RPC.APIKey); err != nil {
+	if err := node.startHTTP(node.config.RPC.HTTPEndpoint(), apis, node.config.RPC.HTTPModules, node.config.RPC.HTTPCors, node.config.RPC.HTTPVirtualHosts, node.config.RPC.HTTPTimeouts, node.config.RPC.EndpointAuth()); err != nil {
+		return err
+	}
+	if err := node.startWS(node.config.RPC.WSEndpoint(), apis, node.config.RPC.WSModules, node.config.RPC.WSOrigins, node.config.RPC.EndpointAuth()); err != nil {
+		node.stopHTTP()
+		return err
+	}
+	if err := node.startIPC(node.ipcPath(), apis); err != nil {
+		node.stopHTTP()
+		node.stopWS()
 		return err
 	}
 
@@ -373,16 +511,16 @@ func (node *Node) startRPC() error {
 }
 
 // startHTTP initializes and starts the HTTP RPC endpoint.
-func (node *Node) startHTTP(endpoint string, apis []rpc.API, modules []string, cors []string, vhosts []string, timeouts rpc.HTTPTimeouts, apiKey string) error {
+func (node *Node) startHTTP(endpoint string, apis []rpc.API, modules []string, cors []string, vhosts []string, timeouts rpc.HTTPTimeouts, auth rpc.EndpointAuth) error {
 	// Short circuit if the HTTP endpoint isn't being exposed
 	if endpoint == "" {
 		return nil
 	}
-	listener, handler, httpServer, err := rpc.StartHTTPEndpoint(endpoint, apis, modules, cors, vhosts, timeouts, apiKey)
+	listener, handler, httpServer, err := rpc.StartHTTPEndpoint(endpoint, apis, modules, cors, vhosts, timeouts, auth)
 	if err != nil {
 		return err
 	}
-	node.log.Info("HTTP endpoint opened", "url", fmt.Sprintf("http://%s", endpoint), "cors", strings.Join(cors, ","), "vhosts", strings.Join(vhosts, ","))
+	node.log.Info("HTTP endpoint opened", "url", fmt.Sprintf("%s://%s", endpointScheme("http", auth), endpoint), "cors", strings.Join(cors, ","), "vhosts", strings.Join(vhosts, ","))
 
 	node.httpListener = listener
 	node.httpHandler = handler
@@ -391,10 +529,49 @@ func (node *Node) startHTTP(endpoint string, apis []rpc.API, modules []string, c
 	return nil
 }
 
+// startWS initializes and starts the WebSocket RPC endpoint.
+func (node *Node) startWS(endpoint string, apis []rpc.API, modules []string, wsOrigins []string, auth rpc.EndpointAuth) error {
+	// Short circuit if the WS endpoint isn't being exposed
+	if endpoint == "" {
+		return nil
+	}
+	listener, handler, err := rpc.StartWSEndpoint(endpoint, apis, modules, wsOrigins, false, auth)
+	if err != nil {
+		return err
+	}
+	node.log.Info("WebSocket endpoint opened", "url", fmt.Sprintf("%s://%s", endpointScheme("ws", auth), listener.Addr()))
+
+	node.wsListener = listener
+	node.wsHandler = handler
+
+	return nil
+}
+
 func (node *Node) stopInitialRPC() {
 	node.stopHTTP()
 }
 
+// stopRPC terminates the HTTP and WebSocket RPC endpoints.
+func (node *Node) stopRPC() {
+	node.stopHTTP()
+	node.stopWS()
+	node.stopIPC()
+}
+
+// stopWS terminates the WebSocket RPC endpoint.
+func (node *Node) stopWS() {
+	if node.wsListener != nil {
+		node.wsListener.Close()
+		node.wsListener = nil
+
+		node.log.Info("WebSocket endpoint closed", "url", fmt.Sprintf("ws://%s", node.config.RPC.WSEndpoint()))
+	}
+	if node.wsHandler != nil {
+		node.wsHandler.Stop()
+		node.wsHandler = nil
+	}
+}
+
 // stopHTTP terminates the HTTP RPC endpoint.
 func (node *Node) stopHTTP() {
 	if node.httpListener != nil {
@@ -413,47 +590,10 @@ func (node *Node) stopHTTP() {
 	}
 }
 
-func OpenDatabase(datadir string, name string, cache int, handles int, compact bool) (db.DB, error) {
-	res, err := db.NewGoLevelDBWithOpts(name, datadir, &opt.Options{
-		OpenFilesCacheCapacity: handles,
-		BlockCacheCapacity:     cache / 2 * opt.MiB,
-		WriteBuffer:            cache / 4 * opt.MiB,
-		Filter:                 filter.NewBloomFilter(10),
-	})
-	if err != nil {
-		return nil, err
-	}
-	if compact {
-		if err := compactDb(res); err != nil {
-			res.Close()
-			return nil, err
-		}
-	}
-	return res, nil
-}
-
-func compactDb(goLevelDB *db.GoLevelDB) error {
-	start := time.Now()
-	logTimeout := time.After(time.Second)
-	completed := make(chan struct{})
-	go func() {
-		select {
-		case <-completed:
-		case <-logTimeout:
-			log.Info("Start compacting DB")
-			<-completed
-			log.Info("DB compacted", "d", time.Since(start))
-		}
-	}()
-	err := goLevelDB.ForceCompact(nil, nil)
-	completed <- struct{}{}
-	return err
-}
-
 // apis returns the collection of RPC descriptors this node offers.
 func (node *Node) apis() []rpc.API {
 
-	baseApi := api.NewBaseApi(node.consensusEngine, node.txpool, node.keyStore, node.secStore, node.ipfsProxy)
+	baseApi := api.NewBaseApi(node.consensusEngine, node.txpool, node.keyStore, node.secStore, node.ipfsProxy, node.txTracker)
 
 	return []rpc.API{
 		{
@@ -465,7 +605,7 @@ func (node *Node) apis() []rpc.API {
 		{
 			Namespace: "dna",
 			Version:   "1.0",
-			Service:   api.NewDnaApi(baseApi, node.blockchain, node.ceremony, node.appVersion, node.profileManager),
+			Service:   api.NewDnaApi(baseApi, node.blockchain, node.ceremony, node.appVersion, node.profileManager, node.identityTracker),
 			Public:    true,
 		},
 		{
@@ -486,6 +626,12 @@ func (node *Node) apis() []rpc.API {
 			Service:   api.NewBlockchainApi(baseApi, node.blockchain, node.ipfsProxy, node.txpool, node.downloader, node.pm, node.nodeState),
 			Public:    true,
 		},
+		{
+			Namespace: "bcn",
+			Version:   "1.0",
+			Service:   api.NewEventsApi(node.bus),
+			Public:    true,
+		},
 		{
 			Namespace: "ipfs",
 			Version:   "1.0",
@@ -498,5 +644,11 @@ func (node *Node) apis() []rpc.API {
 			Service:   api.NewContractApi(baseApi, node.blockchain, node.deferJob, node.subManager),
 			Public:    true,
 		},
+		{
+			Namespace: "admin",
+			Version:   "1.0",
+			Service:   api.NewAdminApi(node, node, node.pm, node.consensusEngine, node.ceremony),
+			Public:    false,
+		},
 	}
 }
@@ -0,0 +1,181 @@
+package node
+
+import (
+	"fmt"
+	"github.com/idena-network/idena-go/common"
+	"github.com/pkg/errors"
+	"net"
+	"net/http"
+	httppprof "net/http/pprof"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/pprof"
+	"runtime/trace"
+	"sync"
+	"time"
+)
+
+const (
+	ProfileCPU   = "cpu"
+	ProfileTrace = "trace"
+
+	profilesDir = "profiles"
+)
+
+// profiler captures a single CPU profile or runtime trace at a time to a file of the profiles directory
+type profiler struct {
+	dir   string
+	kind  string
+	file  *os.File
+	mutex sync.Mutex
+}
+
+func (p *profiler) start(kind string) (string, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.file != nil {
+		return "", errors.Errorf("%v profile is already running", p.kind)
+	}
+	var ext string
+	switch kind {
+	case ProfileCPU:
+		ext = "pprof"
+	case ProfileTrace:
+		ext = "trace"
+	default:
+		return "", errors.Errorf("unknown profile %v, expected %v or %v", kind, ProfileCPU, ProfileTrace)
+	}
+	if err := os.MkdirAll(p.dir, 0700); err != nil {
+		return "", err
+	}
+	path := filepath.Join(p.dir, fmt.Sprintf("%v-%v.%v", kind, time.Now().Format("20060102-150405"), ext))
+	file, err := os.Create(path)
+	if err != nil {
+		return "", err
+	}
+	if kind == ProfileCPU {
+		err = pprof.StartCPUProfile(file)
+	} else {
+		err = trace.Start(file)
+	}
+	if err != nil {
+		file.Close()
+		os.Remove(path)
+		return "", err
+	}
+	p.kind, p.file = kind, file
+	return path, nil
+}
+
+func (p *profiler) stop() (string, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.file == nil {
+		return "", errors.New("profile is not running")
+	}
+	if p.kind == ProfileCPU {
+		pprof.StopCPUProfile()
+	} else {
+		trace.Stop()
+	}
+	path := p.file.Name()
+	err := p.file.Close()
+	p.kind, p.file = "", nil
+	return path, err
+}
+
+func (p *profiler) running() bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return p.file != nil
+}
+
+// StartProfile starts capturing a CPU profile or a runtime trace to the profiles directory of the datadir,
+// it returns the path of the file
+func (node *Node) StartProfile(kind string) (string, error) {
+	path, err := node.profiler.start(kind)
+	if err == nil {
+		node.log.Info("Profile started", "kind", kind, "path", path)
+	}
+	return path, err
+}
+
+// StopProfile stops the running profile and returns the path of its file
+func (node *Node) StopProfile() (string, error) {
+	path, err := node.profiler.stop()
+	if err == nil {
+		node.log.Info("Profile stopped", "path", path)
+	}
+	return path, err
+}
+
+// startProfiling opens the pprof endpoint and starts logging of resource usage if they are enabled in the config
+func (node *Node) startProfiling() error {
+	cfg := node.config.Profiling
+	if cfg == nil {
+		return nil
+	}
+	if cfg.ResourceLogInterval > 0 {
+		node.resourceLogStop = make(chan struct{})
+		go node.logResources(cfg.ResourceLogInterval, node.resourceLogStop)
+	}
+	endpoint := cfg.PprofEndpoint()
+	if endpoint == "" {
+		return nil
+	}
+	listener, err := net.Listen("tcp", endpoint)
+	if err != nil {
+		return err
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", httppprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", httppprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", httppprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", httppprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", httppprof.Trace)
+	node.pprofServer = &http.Server{Handler: mux}
+	go node.pprofServer.Serve(listener)
+	node.log.Info("pprof endpoint opened", "url", fmt.Sprintf("http://%s/debug/pprof/", listener.Addr()))
+	return nil
+}
+
+func (node *Node) stopProfiling() error {
+	if node.profiler.running() {
+		if _, err := node.StopProfile(); err != nil {
+			node.log.Warn("Failed to stop profile", "err", err)
+		}
+	}
+	if node.resourceLogStop != nil {
+		close(node.resourceLogStop)
+		node.resourceLogStop = nil
+	}
+	if node.pprofServer == nil {
+		return nil
+	}
+	err := node.pprofServer.Close()
+	node.pprofServer = nil
+	return err
+}
+
+func (node *Node) logResources(interval time.Duration, stop chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			var mem runtime.MemStats
+			runtime.ReadMemStats(&mem)
+			node.log.Info("Resource usage",
+				"goroutines", runtime.NumGoroutine(),
+				"heap", common.StorageSize(mem.HeapAlloc),
+				"heapSys", common.StorageSize(mem.HeapSys),
+				"gc", mem.NumGC,
+				"conns", len(node.ipfsProxy.Host().Network().Conns()),
+				"peers", node.pm.PeersCount(),
+			)
+		case <-stop:
+			return
+		}
+	}
+}
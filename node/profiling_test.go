@@ -0,0 +1,36 @@
+package node
+
+import (
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestProfiler(t *testing.T) {
+	p := &profiler{dir: filepath.Join(t.TempDir(), profilesDir)}
+
+	_, err := p.stop()
+	require.Error(t, err)
+	_, err = p.start("heap")
+	require.Error(t, err)
+
+	for _, kind := range []string{ProfileCPU, ProfileTrace} {
+		path, err := p.start(kind)
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(filepath.Base(path), kind+"-"))
+		require.True(t, p.running())
+
+		_, err = p.start(ProfileCPU)
+		require.Error(t, err)
+
+		stopped, err := p.stop()
+		require.NoError(t, err)
+		require.Equal(t, path, stopped)
+		require.False(t, p.running())
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		require.NotZero(t, info.Size())
+	}
+}
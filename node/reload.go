@@ -0,0 +1,47 @@
+package node
+
+import (
+	"github.com/idena-network/idena-go/config"
+	"github.com/pkg/errors"
+)
+
+// ConfigReloader provides the node with a fresh config, e.g. read again from the changed config file
+type ConfigReloader struct {
+	Load func() (*config.Config, error)
+	// ApplyLog replaces log handlers of the process with ones built for the config, the handlers aren't owned by the node
+	ApplyLog func(cfg *config.LogConfig) error
+}
+
+// SetConfigReloader enables ReloadConfig, it's called by the command which has loaded the config
+func (node *Node) SetConfigReloader(reloader ConfigReloader) {
+	node.reloadMutex.Lock()
+	defer node.reloadMutex.Unlock()
+	node.reloader = reloader
+}
+
+// ReloadConfig loads the config again and applies the sections which can be changed at runtime: log levels, peer limits
+// and mempool size, it returns names of the applied sections. Other changes require restart
+func (node *Node) ReloadConfig() ([]string, error) {
+	node.reloadMutex.Lock()
+	defer node.reloadMutex.Unlock()
+	if node.reloader.Load == nil {
+		return nil, errors.New("config reload is not supported")
+	}
+	cfg, err := node.reloader.Load()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load config")
+	}
+	var applied []string
+	if node.reloader.ApplyLog != nil {
+		if err := node.reloader.ApplyLog(cfg.Log); err != nil {
+			return applied, errors.Wrap(err, "failed to apply log config")
+		}
+		applied = append(applied, "log")
+	}
+	node.pm.SetPeerLimits(cfg.P2P)
+	applied = append(applied, "p2p")
+	node.txpool.SetSizeLimits(cfg.Mempool.TxPoolQueueSlots, cfg.Mempool.TxPoolExecutableSlots)
+	applied = append(applied, "mempool")
+	node.log.Info("Config reloaded", "sections", applied)
+	return applied, nil
+}
@@ -0,0 +1,112 @@
+package node
+
+import (
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/core/state"
+	"github.com/idena-network/idena-go/database"
+	"github.com/pkg/errors"
+	"os"
+)
+
+// RepairResult describes the changes made by RepairDatabase
+type RepairResult struct {
+	// FilesRecovered is true if the files of the db were rebuilt by its backend
+	FilesRecovered bool
+	// PrevHead is the height of the head before the repair, zero means that the chain is empty
+	PrevHead uint64
+	Head     uint64
+}
+
+// RepairDatabase brings the chain db into a consistent state after a crash, the files of the db are recovered
+// by the backend and the head is moved back to the last block which is stored along with both state trees,
+// records of the blocks above it are removed, the node must be stopped
+func RepairDatabase(datadir string, backend string) (*RepairResult, error) {
+	result := &RepairResult{}
+	if _, err := os.Stat(dbPath(datadir, ChainDbName)); os.IsNotExist(err) {
+		return result, nil
+	}
+	if existing := detectDbBackend(datadir, ChainDbName); existing != "" && existing != backend {
+		return nil, errors.Errorf("%v db is created by %v backend, it can't be repaired by %v", ChainDbName, existing, backend)
+	}
+	if recoverFiles, ok := dbRecoverers[backend]; ok {
+		if err := recoverFiles(dbPath(datadir, ChainDbName)); err != nil {
+			return nil, errors.Wrap(err, "cannot recover db files")
+		}
+		result.FilesRecovered = true
+	}
+
+	db, err := OpenDatabase(datadir, ChainDbName, backend, 16, 16, false)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+	repo := database.NewRepo(db)
+	head := repo.ReadHead()
+	if head == nil {
+		return result, nil
+	}
+	result.PrevHead = head.Height()
+
+	stateDb, err := state.NewLazy(db)
+	if err != nil {
+		return nil, err
+	}
+	identityStateDb, err := state.NewLazyIdentityState(db)
+	if err != nil {
+		return nil, err
+	}
+	height := head.Height()
+	for ; height > 0; height-- {
+		if ok, err := isBlockComplete(repo, stateDb, identityStateDb, height); err != nil {
+			return nil, err
+		} else if ok {
+			break
+		}
+	}
+	if height == 0 {
+		return nil, errors.New("no block is stored along with its state, try to delete idenachain.db folder from your data directory and sync from scratch")
+	}
+	result.Head = height
+	if height == head.Height() {
+		return result, nil
+	}
+
+	// newer state versions are dropped, so the next blocks are committed over them
+	if err := stateDb.ResetTo(height); err != nil {
+		return nil, err
+	}
+	if err := identityStateDb.ResetTo(height); err != nil {
+		return nil, err
+	}
+	repo.SetHead(nil, height)
+	for h := height + 1; h <= head.Height(); h++ {
+		if hash := repo.ReadCanonicalHash(h); hash != (common.Hash{}) {
+			repo.RemoveHeader(hash)
+		}
+		repo.RemoveCanonicalHash(h)
+	}
+	return result, nil
+}
+
+// isBlockComplete checks that the canonical block at the height is stored and both state trees have its version
+// with the roots of the block
+func isBlockComplete(repo *database.Repo, stateDb *state.StateDB, identityStateDb *state.IdentityStateDB, height uint64) (bool, error) {
+	hash := repo.ReadCanonicalHash(height)
+	if hash == (common.Hash{}) {
+		return false, nil
+	}
+	header := repo.ReadBlockHeader(hash)
+	if header == nil || header.Height() != height {
+		return false, nil
+	}
+	if !stateDb.HasVersion(height) || !identityStateDb.HasVersion(height) {
+		return false, nil
+	}
+	if err := stateDb.Load(height); err != nil {
+		return false, err
+	}
+	if err := identityStateDb.Load(height); err != nil {
+		return false, err
+	}
+	return stateDb.Root() == header.Root() && identityStateDb.Root() == header.IdentityRoot(), nil
+}
@@ -0,0 +1,77 @@
+package node
+
+import (
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/eventbus"
+	"github.com/idena-network/idena-go/config"
+	"github.com/idena-network/idena-go/core/appstate"
+	"github.com/idena-network/idena-go/database"
+	"github.com/stretchr/testify/require"
+	"math/big"
+	"testing"
+)
+
+func TestRepairDatabase(t *testing.T) {
+	dataDir := t.TempDir()
+	result, err := RepairDatabase(dataDir, config.DbBackendGoLevelDb)
+	require.NoError(t, err)
+	require.Equal(t, &RepairResult{}, result)
+
+	db, err := OpenDatabase(dataDir, ChainDbName, config.DbBackendGoLevelDb, 16, 16, false)
+	require.NoError(t, err)
+	repo := database.NewRepo(db)
+	appState, err := appstate.NewAppState(db, eventbus.New())
+	require.NoError(t, err)
+	require.NoError(t, appState.Initialize(0))
+	var hashes []common.Hash
+	for h := uint64(1); h <= 4; h++ {
+		appState.State.SetBalance(common.Address{byte(h)}, big.NewInt(int64(h)))
+		// the state of the last block isn't committed as if the node crashed after the block was written
+		if h < 4 {
+			require.NoError(t, appState.Commit(nil))
+		}
+		header := &types.Header{
+			ProposedHeader: &types.ProposedHeader{
+				Height:       h,
+				Root:         appState.State.Root(),
+				IdentityRoot: appState.IdentityState.Root(),
+			},
+		}
+		repo.WriteBlockHeader(nil, header)
+		repo.WriteCanonicalHash(nil, h, header.Hash())
+		repo.WriteHead(nil, header)
+		hashes = append(hashes, header.Hash())
+	}
+	require.NoError(t, db.Close())
+
+	_, err = RepairDatabase(dataDir, config.DbBackendBoltDb)
+	require.Error(t, err)
+
+	result, err = RepairDatabase(dataDir, config.DbBackendGoLevelDb)
+	require.NoError(t, err)
+	require.Equal(t, &RepairResult{FilesRecovered: true, PrevHead: 4, Head: 3}, result)
+
+	result, err = RepairDatabase(dataDir, config.DbBackendGoLevelDb)
+	require.NoError(t, err)
+	require.Equal(t, &RepairResult{FilesRecovered: true, PrevHead: 3, Head: 3}, result)
+
+	db, err = OpenDatabase(dataDir, ChainDbName, config.DbBackendGoLevelDb, 16, 16, false)
+	require.NoError(t, err)
+	defer db.Close()
+	repo = database.NewRepo(db)
+	require.Equal(t, hashes[2], repo.ReadHead().Hash())
+	require.Equal(t, common.Hash{}, repo.ReadCanonicalHash(4))
+	require.Nil(t, repo.ReadBlockHeader(hashes[3]))
+}
+
+func TestOpenDatabase_backendMismatch(t *testing.T) {
+	dataDir := t.TempDir()
+	db, err := OpenDatabase(dataDir, ChainDbName, config.DbBackendGoLevelDb, 16, 16, false)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+	require.Equal(t, config.DbBackendGoLevelDb, detectDbBackend(dataDir, ChainDbName))
+
+	_, err = OpenDatabase(dataDir, ChainDbName, config.DbBackendBadgerDb, 16, 16, false)
+	require.Error(t, err)
+}
@@ -0,0 +1,89 @@
+package node
+
+import (
+	"github.com/pkg/errors"
+	"time"
+)
+
+// serviceStopTimeout bounds the time given to a single service to stop
+const serviceStopTimeout = time.Second * 30
+
+// Service is a node subsystem which is started with the node and stopped on shutdown
+type Service interface {
+	Start() error
+	Stop() error
+}
+
+// serviceFuncs adapts start and stop functions to the Service interface, nil functions are no-ops
+type serviceFuncs struct {
+	start func() error
+	stop  func() error
+}
+
+func (s serviceFuncs) Start() error {
+	if s.start == nil {
+		return nil
+	}
+	return s.start()
+}
+
+func (s serviceFuncs) Stop() error {
+	if s.stop == nil {
+		return nil
+	}
+	return s.stop()
+}
+
+type namedService struct {
+	name    string
+	service Service
+}
+
+// services starts registered services in order and stops started ones in reverse order
+type services struct {
+	registered []namedService
+	started    []namedService
+}
+
+func (s *services) register(name string, service Service) {
+	s.registered = append(s.registered, namedService{name, service})
+}
+
+// start starts services one by one, services which are already started are stopped if one of them fails
+func (s *services) start() error {
+	for _, service := range s.registered {
+		if err := service.service.Start(); err != nil {
+			s.stop(serviceStopTimeout, nil)
+			return errors.Wrapf(err, "cannot start %v", service.name)
+		}
+		s.started = append(s.started, service)
+	}
+	return nil
+}
+
+// stop stops started services in reverse order, a service which doesn't stop in time is abandoned and the rest are stopped anyway,
+// it returns false if any service is abandoned
+func (s *services) stop(timeout time.Duration, onError func(name string, err error)) bool {
+	allStopped := true
+	for i := len(s.started) - 1; i >= 0; i-- {
+		service := s.started[i]
+		stopped := make(chan error, 1)
+		go func() {
+			stopped <- service.service.Stop()
+		}()
+		var err error
+		timer := time.NewTimer(timeout)
+		select {
+		case err = <-stopped:
+		case <-timer.C:
+			err = errors.New("timeout")
+			allStopped = false
+		}
+		timer.Stop()
+		if err != nil && onError != nil {
+			onError(service.name, err)
+		}
+	}
+	s.started = nil
+	return allStopped
+}
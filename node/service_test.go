@@ -0,0 +1,64 @@
+package node
+
+import (
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestServices(t *testing.T) {
+	var calls []string
+	service := func(name string, startErr error) Service {
+		return serviceFuncs{
+			start: func() error {
+				calls = append(calls, "start "+name)
+				return startErr
+			},
+			stop: func() error {
+				calls = append(calls, "stop "+name)
+				return nil
+			},
+		}
+	}
+
+	s := &services{}
+	s.register("a", service("a", nil))
+	s.register("b", service("b", nil))
+	require.NoError(t, s.start())
+	require.True(t, s.stop(time.Second, nil))
+	require.Equal(t, []string{"start a", "start b", "stop b", "stop a"}, calls)
+
+	calls = nil
+	s = &services{}
+	s.register("a", service("a", nil))
+	s.register("b", service("b", errors.New("failed")))
+	s.register("c", service("c", nil))
+	require.Error(t, s.start())
+	require.Equal(t, []string{"start a", "start b", "stop a"}, calls)
+}
+
+func TestServices_stopTimeout(t *testing.T) {
+	stopped := false
+	s := &services{}
+	s.register("a", serviceFuncs{
+		stop: func() error {
+			stopped = true
+			return nil
+		},
+	})
+	s.register("b", serviceFuncs{
+		stop: func() error {
+			time.Sleep(time.Minute)
+			return nil
+		},
+	})
+	require.NoError(t, s.start())
+
+	var failed []string
+	require.False(t, s.stop(time.Millisecond*50, func(name string, err error) {
+		failed = append(failed, name)
+	}))
+	require.Equal(t, []string{"b"}, failed)
+	require.True(t, stopped)
+}
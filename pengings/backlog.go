@@ -0,0 +1,106 @@
+package pengings
+
+import (
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/rcrowley/go-metrics"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// MaxPendingProposals limits the number of deferred proposed blocks and proofs waiting for their round
+	MaxPendingProposals = 1000
+)
+
+// Backlog returns the number of deferred proposed blocks and proofs waiting to be processed
+func (proposals *Proposals) Backlog() int {
+	return int(atomic.LoadInt32(&proposals.backlog))
+}
+
+// ShedCount returns the number of deferred proposals dropped because the backlog was full
+func (proposals *Proposals) ShedCount() uint64 {
+	return atomic.LoadUint64(&proposals.shed)
+}
+
+func (proposals *Proposals) registerMetrics() {
+	metrics.GetOrRegister("pengings.backlog", metrics.NewFunctionalGauge(func() int64 {
+		return int64(proposals.Backlog())
+	}))
+	metrics.GetOrRegister("pengings.shed", metrics.NewFunctionalGauge(func() int64 {
+		return int64(proposals.ShedCount())
+	}))
+	metrics.GetOrRegister("pengings.prevalidated", metrics.NewFunctionalGauge(func() int64 {
+		return int64(proposals.PrevalidatedCount())
+	}))
+}
+
+func (proposals *Proposals) storePending(pending *sync.Map, key interface{}, value interface{}) {
+	if _, loaded := pending.LoadOrStore(key, value); loaded {
+		return
+	}
+	if int(atomic.AddInt32(&proposals.backlog, 1)) > proposals.maxBacklog() {
+		proposals.shedPending()
+	}
+}
+
+func (proposals *Proposals) deletePending(pending *sync.Map, key interface{}) {
+	if _, loaded := pending.LoadAndDelete(key); loaded {
+		atomic.AddInt32(&proposals.backlog, -1)
+	}
+	if pending == proposals.pendingProofs {
+		proposals.proofTimes.Delete(key)
+	}
+}
+
+func (proposals *Proposals) maxBacklog() int {
+	if proposals.maxPending > 0 {
+		return proposals.maxPending
+	}
+	return MaxPendingProposals
+}
+
+// shedPending drops the oldest deferred proposals until the backlog fits the limit,
+// proposals for the next round are critical for consensus and are never dropped
+func (proposals *Proposals) shedPending() {
+	proposals.shedMutex.Lock()
+	defer proposals.shedMutex.Unlock()
+
+	nextRound := proposals.chain.Round() + 1
+	shed := 0
+	for proposals.Backlog() > proposals.maxBacklog() {
+		var oldestMap *sync.Map
+		var oldestKey interface{}
+		var oldestTime time.Time
+		check := func(pending *sync.Map, round uint64, key interface{}, receivingTime time.Time) {
+			if round <= nextRound {
+				return
+			}
+			if oldestMap == nil || receivingTime.Before(oldestTime) {
+				oldestMap, oldestKey, oldestTime = pending, key, receivingTime
+			}
+		}
+		proposals.pendingBlocks.Range(func(key, value interface{}) bool {
+			blockPeer := value.(*blockPeer)
+			check(proposals.pendingBlocks, blockPeer.proposal.Block.Height(), key, blockPeer.receivingTime)
+			return true
+		})
+		proposals.pendingProofs.Range(func(key, value interface{}) bool {
+			var receivingTime time.Time
+			if t, ok := proposals.proofTimes.Load(key); ok {
+				receivingTime = t.(time.Time)
+			}
+			check(proposals.pendingProofs, value.(*types.ProofProposal).Round, key, receivingTime)
+			return true
+		})
+		if oldestMap == nil {
+			break
+		}
+		proposals.deletePending(oldestMap, oldestKey)
+		shed++
+	}
+	if shed > 0 {
+		atomic.AddUint64(&proposals.shed, uint64(shed))
+		proposals.log.Warn("Proposals backlog is full, oldest pending proposals dropped", "dropped", shed, "backlog", proposals.Backlog())
+	}
+}
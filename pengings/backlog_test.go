@@ -0,0 +1,54 @@
+package pengings
+
+import (
+	"github.com/idena-network/idena-go/blockchain"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/log"
+	"github.com/stretchr/testify/require"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestProposals_shedPending(t *testing.T) {
+	chain := &blockchain.Blockchain{}
+	chain.Head = &types.Header{ProposedHeader: &types.ProposedHeader{Height: 10}}
+	proposals := &Proposals{
+		log:           log.New(),
+		chain:         chain,
+		pendingBlocks: &sync.Map{},
+		pendingProofs: &sync.Map{},
+		proofTimes:    &sync.Map{},
+		maxPending:    5,
+	}
+	proposal := func(height uint64) *types.BlockProposal {
+		return &types.BlockProposal{Block: &types.Block{
+			Header: &types.Header{
+				ProposedHeader: &types.ProposedHeader{Height: height},
+			},
+		}}
+	}
+
+	start := time.Now()
+	critical := proposal(12)
+	_, pending := proposals.AddProposedBlock(critical, "", start)
+	require.True(t, pending)
+
+	var flood []*types.BlockProposal
+	for i := 0; i < 10; i++ {
+		p := proposal(13 + uint64(i))
+		flood = append(flood, p)
+		_, pending := proposals.AddProposedBlock(p, "", start.Add(time.Second*time.Duration(i+1)))
+		require.True(t, pending)
+	}
+
+	require.Equal(t, 5, proposals.Backlog())
+	require.Equal(t, uint64(6), proposals.ShedCount())
+
+	_, ok := proposals.pendingBlocks.Load(critical.Hash())
+	require.True(t, ok)
+	for i, p := range flood {
+		_, ok := proposals.pendingBlocks.Load(p.Hash())
+		require.Equal(t, i >= 6, ok)
+	}
+}
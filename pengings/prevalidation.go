@@ -0,0 +1,40 @@
+package pengings
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/idena-network/idena-go/common"
+	"sync/atomic"
+)
+
+type validatedProof struct {
+	proof  []byte
+	pubKey []byte
+}
+
+func validatedProofKey(round uint64, hash common.Hash) string {
+	return fmt.Sprintf("%d:%x", round, hash[:])
+}
+
+// validateProposerProof checks the proof of the round proposer once, a block proposal reuses the result
+// of the proof proposal received earlier, so it's validated and relayed without the VRF check
+func (proposals *Proposals) validateProposerProof(round uint64, hash common.Hash, proof []byte, pubKey []byte) error {
+	key := validatedProofKey(round, hash)
+	if value, ok := proposals.validatedProofs.Get(key); ok {
+		validated := value.(*validatedProof)
+		if bytes.Equal(validated.proof, proof) && bytes.Equal(validated.pubKey, pubKey) {
+			atomic.AddUint64(&proposals.prevalidated, 1)
+			return nil
+		}
+	}
+	if err := proposals.chain.ValidateProposerProof(proof, pubKey); err != nil {
+		return err
+	}
+	proposals.validatedProofs.SetDefault(key, &validatedProof{proof: proof, pubKey: pubKey})
+	return nil
+}
+
+// PrevalidatedCount returns the number of proposer proofs which were validated in advance and not checked again
+func (proposals *Proposals) PrevalidatedCount() uint64 {
+	return atomic.LoadUint64(&proposals.prevalidated)
+}
@@ -0,0 +1,22 @@
+package pengings
+
+import (
+	"github.com/idena-network/idena-go/common"
+	"github.com/patrickmn/go-cache"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestProposals_validateProposerProof_prevalidated(t *testing.T) {
+	proposals := &Proposals{
+		validatedProofs: cache.New(time.Minute, time.Minute),
+	}
+	hash := common.Hash{0x1}
+	proof, pubKey := []byte{0x2}, []byte{0x3}
+	proposals.validatedProofs.SetDefault(validatedProofKey(10, hash), &validatedProof{proof: proof, pubKey: pubKey})
+
+	require.NoError(t, proposals.validateProposerProof(10, hash, proof, pubKey))
+	require.NoError(t, proposals.validateProposerProof(10, hash, []byte{0x2}, []byte{0x3}))
+	require.Equal(t, uint64(2), proposals.PrevalidatedCount())
+}
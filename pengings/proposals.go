@@ -36,11 +36,20 @@ type Proposals struct {
 	blocksByRound *sync.Map
 
 	pendingProofs *sync.Map
+	// receiving times of pending proofs, kept apart so pendingProofs still holds *types.ProofProposal
+	proofTimes    *sync.Map
 	pendingBlocks *sync.Map
+	backlog       int32
+	maxPending    int
+	shed          uint64
+	shedMutex     sync.Mutex
 
 	potentialForkedPeers mapset.Set
 
 	proposeCache *cache.Cache
+	// proposer proofs validated in the current rounds
+	validatedProofs *cache.Cache
+	prevalidated    uint64
 	// used for requesting blocks by hash from peers
 	blockCache *cache.Cache
 	appState   *appstate.AppState
@@ -77,15 +86,18 @@ func NewProposals(chain *blockchain.Blockchain, appState *appstate.AppState, det
 		offlineDetector:      detector,
 		upgrader:             upgrader,
 		statsCollector:       statsCollector,
-		log:                  log.New(),
+		log:                  log.New(log.ModuleKey, "consensus"),
 		blocksByRound:        &sync.Map{},
 		pendingBlocks:        &sync.Map{},
 		pendingProofs:        &sync.Map{},
+		proofTimes:           &sync.Map{},
 		potentialForkedPeers: mapset.NewSet(),
 		proposeCache:         cache.New(30*time.Second, 1*time.Minute),
+		validatedProofs:      cache.New(30*time.Second, 1*time.Minute),
 		blockCache:           cache.New(time.Minute, time.Minute),
 		bestProofs:           map[uint64]bestHash{},
 	}
+	p.registerMetrics()
 	return p, p.pendingProofs
 }
 
@@ -135,7 +147,7 @@ func (proposals *Proposals) AddProposeProof(proposal *types.ProofProposal) (adde
 			return false, false
 		}
 
-		if err := proposals.chain.ValidateProposerProof(proposal.Proof, pubKeyBytes); err != nil {
+		if err := proposals.validateProposerProof(currentRound, hash, proposal.Proof, pubKeyBytes); err != nil {
 			log.Warn("Failed proposed proof validation", "err", err)
 			return false, false
 		}
@@ -145,7 +157,8 @@ func (proposals *Proposals) AddProposeProof(proposal *types.ProofProposal) (adde
 
 		return true, false
 	} else if currentRound < proposal.Round && proposal.Round-currentRound < DeferFutureProposalsPeriod {
-		proposals.pendingProofs.LoadOrStore(hash, proposal)
+		proposals.proofTimes.LoadOrStore(hash, time.Now())
+		proposals.storePending(proposals.pendingProofs, hash, proposal)
 		return false, true
 	}
 	return false, false
@@ -188,7 +201,7 @@ func (proposals *Proposals) ProcessPendingProofs() []*types.ProofProposal {
 		if added, pending := proposals.AddProposeProof(proof); added {
 			result = append(result, proof)
 		} else if !pending {
-			proposals.pendingProofs.Delete(key)
+			proposals.deletePending(proposals.pendingProofs, key)
 		}
 
 		return true
@@ -206,7 +219,7 @@ func (proposals *Proposals) ProcessPendingBlocks() []*types.BlockProposal {
 		if added, pending := proposals.AddProposedBlock(blockPeer.proposal, blockPeer.peerId, blockPeer.receivingTime); added {
 			result = append(result, blockPeer.proposal)
 		} else if !pending {
-			proposals.pendingBlocks.Delete(key)
+			proposals.deletePending(proposals.pendingBlocks, key)
 		}
 		return true
 	})
@@ -244,7 +257,7 @@ func (proposals *Proposals) AddProposedBlock(proposal *types.BlockProposal, peer
 			return false, false
 		}
 
-		if err := proposals.chain.ValidateProposerProof(proposal.Proof, block.Header.ProposedHeader.ProposerPubKey); err != nil {
+		if err := proposals.validateProposerProof(currentRound, vrfHash, proposal.Proof, block.Header.ProposedHeader.ProposerPubKey); err != nil {
 			log.Warn("Failed proposed block proof validation", "err", err)
 			return false, false
 		}
@@ -279,7 +292,7 @@ func (proposals *Proposals) AddProposedBlock(proposal *types.BlockProposal, peer
 		proposals.statsCollector.SubmitBlockProposal(proposal, receivingTime)
 		return true, false
 	} else if currentRound < block.Height() && block.Height()-currentRound < DeferFutureProposalsPeriod {
-		proposals.pendingBlocks.LoadOrStore(block.Hash(), &blockPeer{
+		proposals.storePending(proposals.pendingBlocks, block.Hash(), &blockPeer{
 			proposal: proposal, peerId: peerId, receivingTime: receivingTime,
 		})
 		return false, true
@@ -287,7 +300,8 @@ func (proposals *Proposals) AddProposedBlock(proposal *types.BlockProposal, peer
 	return false, false
 }
 
-func (proposals *Proposals) GetProposedBlock(round uint64, proposerPubKey []byte, timeout time.Duration) (*types.Block, error) {
+// GetProposedBlock waits for the block of the proposer until the timeout elapses or cancel is closed
+func (proposals *Proposals) GetProposedBlock(round uint64, proposerPubKey []byte, timeout time.Duration, cancel <-chan struct{}) (*types.Block, error) {
 	for start := time.Now(); time.Since(start) < timeout; {
 		m, ok := proposals.blocksByRound.Load(round)
 		if ok {
@@ -310,7 +324,11 @@ func (proposals *Proposals) GetProposedBlock(round uint64, proposerPubKey []byte
 			}
 		}
 
-		time.Sleep(time.Millisecond * 100)
+		select {
+		case <-time.After(time.Millisecond * 100):
+		case <-cancel:
+			return nil, errors.New("Waiting for proposed block is cancelled")
+		}
 	}
 	return nil, errors.New("Proposed block was not found")
 }
@@ -358,6 +376,11 @@ func (proposals *Proposals) ApproveBlock(hash common.Hash) {
 	proposals.blockCache.Add(hash.Hex(), nil, cache.DefaultExpiration)
 }
 
+func (proposals *Proposals) IsBlockApproved(hash common.Hash) bool {
+	_, ok := proposals.blockCache.Get(hash.Hex())
+	return ok
+}
+
 func (proposals *Proposals) AddBlock(block *types.Block) {
 	if block == nil {
 		return
@@ -1,10 +1,10 @@
 package pengings
 
 import (
-	"github.com/deckarep/golang-set"
 	"github.com/idena-network/idena-go/blockchain"
 	"github.com/idena-network/idena-go/blockchain/types"
 	"github.com/idena-network/idena-go/common/eventbus"
+	"github.com/idena-network/idena-go/common/knowncache"
 	"github.com/idena-network/idena-go/core/appstate"
 	"github.com/idena-network/idena-go/core/upgrade"
 	"github.com/idena-network/idena-go/events"
@@ -19,7 +19,7 @@ const (
 
 type Votes struct {
 	votesByRound    *sync.Map
-	knownVotes      mapset.Set
+	knownVotes      *knowncache.Cache
 	state           *appstate.AppState
 	head            *types.Header
 	headMutex       sync.RWMutex
@@ -31,7 +31,7 @@ type Votes struct {
 func NewVotes(state *appstate.AppState, bus eventbus.Bus, offlineDetector *blockchain.OfflineDetector, upgrade *upgrade.Upgrader) *Votes {
 	v := &Votes{
 		votesByRound:    &sync.Map{},
-		knownVotes:      mapset.NewSet(),
+		knownVotes:      knowncache.New(MaxKnownVotes, 0),
 		state:           state,
 		bus:             bus,
 		offlineDetector: offlineDetector,
@@ -66,7 +66,7 @@ func (votes *Votes) AddVote(vote *types.Vote) bool {
 		return false
 	}
 
-	if votes.knownVotes.Contains(vote.Hash()) {
+	if votes.knownVotes.Has(vote.Hash()) {
 		return false
 	}
 
@@ -78,12 +78,10 @@ func (votes *Votes) AddVote(vote *types.Vote) bool {
 	byRound := m.(*sync.Map)
 
 	byRound.Store(vote.Hash(), vote)
-	if votes.knownVotes.Cardinality() > MaxKnownVotes {
-		votes.knownVotes.Pop()
-	}
 	votes.knownVotes.Add(vote.Hash())
 	votes.offlineDetector.ProcessVote(vote)
 	votes.upgrade.ProcessVote(vote)
+	votes.bus.Publish(&events.NewVoteEvent{Vote: vote})
 	return true
 }
 
@@ -643,14 +643,19 @@ type ProtoHandshake struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NetworkId  uint32 `protobuf:"varint,1,opt,name=networkId,proto3" json:"networkId,omitempty"`
-	Height     uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
-	Genesis    []byte `protobuf:"bytes,3,opt,name=genesis,proto3" json:"genesis,omitempty"`
-	Timestamp  int64  `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	AppVersion string `protobuf:"bytes,5,opt,name=appVersion,proto3" json:"appVersion,omitempty"`
-	Peers      uint32 `protobuf:"varint,6,opt,name=peers,proto3" json:"peers,omitempty"`
-	OldGenesis []byte `protobuf:"bytes,7,opt,name=oldGenesis,proto3" json:"oldGenesis,omitempty"`
-	ShardId    uint32 `protobuf:"varint,8,opt,name=shardId,proto3" json:"shardId,omitempty"`
+	NetworkId       uint32   `protobuf:"varint,1,opt,name=networkId,proto3" json:"networkId,omitempty"`
+	Height          uint64   `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Genesis         []byte   `protobuf:"bytes,3,opt,name=genesis,proto3" json:"genesis,omitempty"`
+	Timestamp       int64    `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	AppVersion      string   `protobuf:"bytes,5,opt,name=appVersion,proto3" json:"appVersion,omitempty"`
+	Peers           uint32   `protobuf:"varint,6,opt,name=peers,proto3" json:"peers,omitempty"`
+	OldGenesis      []byte   `protobuf:"bytes,7,opt,name=oldGenesis,proto3" json:"oldGenesis,omitempty"`
+	ShardId         uint32   `protobuf:"varint,8,opt,name=shardId,proto3" json:"shardId,omitempty"`
+	ProtocolVersion uint32   `protobuf:"varint,9,opt,name=protocolVersion,proto3" json:"protocolVersion,omitempty"`
+	Light           bool     `protobuf:"varint,10,opt,name=light,proto3" json:"light,omitempty"`
+	ServesLight     bool     `protobuf:"varint,11,opt,name=servesLight,proto3" json:"servesLight,omitempty"`
+	HeadHash        []byte   `protobuf:"bytes,12,opt,name=headHash,proto3" json:"headHash,omitempty"`
+	ListenAddrs     [][]byte `protobuf:"bytes,13,rep,name=listenAddrs,proto3" json:"listenAddrs,omitempty"`
 }
 
 func (x *ProtoHandshake) Reset() {
@@ -741,6 +746,41 @@ func (x *ProtoHandshake) GetShardId() uint32 {
 	return 0
 }
 
+func (x *ProtoHandshake) GetProtocolVersion() uint32 {
+	if x != nil {
+		return x.ProtocolVersion
+	}
+	return 0
+}
+
+func (x *ProtoHandshake) GetLight() bool {
+	if x != nil {
+		return x.Light
+	}
+	return false
+}
+
+func (x *ProtoHandshake) GetServesLight() bool {
+	if x != nil {
+		return x.ServesLight
+	}
+	return false
+}
+
+func (x *ProtoHandshake) GetHeadHash() []byte {
+	if x != nil {
+		return x.HeadHash
+	}
+	return nil
+}
+
+func (x *ProtoHandshake) GetListenAddrs() [][]byte {
+	if x != nil {
+		return x.ListenAddrs
+	}
+	return nil
+}
+
 type ProtoMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -945,16 +985,17 @@ func (x *ProtoDisconnect) GetReason() string {
 	return ""
 }
 
-type ProtoIdentityStateDiff struct {
+type ProtoGetTransactionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Values []*ProtoIdentityStateDiff_IdentityStateDiffValue `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	Id     uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Hashes [][]byte `protobuf:"bytes,2,rep,name=hashes,proto3" json:"hashes,omitempty"`
 }
 
-func (x *ProtoIdentityStateDiff) Reset() {
-	*x = ProtoIdentityStateDiff{}
+func (x *ProtoGetTransactionsRequest) Reset() {
+	*x = ProtoGetTransactionsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protobuf_models_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -962,13 +1003,13 @@ func (x *ProtoIdentityStateDiff) Reset() {
 	}
 }
 
-func (x *ProtoIdentityStateDiff) String() string {
+func (x *ProtoGetTransactionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtoIdentityStateDiff) ProtoMessage() {}
+func (*ProtoGetTransactionsRequest) ProtoMessage() {}
 
-func (x *ProtoIdentityStateDiff) ProtoReflect() protoreflect.Message {
+func (x *ProtoGetTransactionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_protobuf_models_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -980,28 +1021,36 @@ func (x *ProtoIdentityStateDiff) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProtoIdentityStateDiff.ProtoReflect.Descriptor instead.
-func (*ProtoIdentityStateDiff) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProtoGetTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ProtoGetTransactionsRequest) Descriptor() ([]byte, []int) {
 	return file_protobuf_models_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *ProtoIdentityStateDiff) GetValues() []*ProtoIdentityStateDiff_IdentityStateDiffValue {
+func (x *ProtoGetTransactionsRequest) GetId() uint64 {
 	if x != nil {
-		return x.Values
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ProtoGetTransactionsRequest) GetHashes() [][]byte {
+	if x != nil {
+		return x.Hashes
 	}
 	return nil
 }
 
-type ProtoSnapshotBlock struct {
+type ProtoTransactions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data []*ProtoSnapshotBlock_KeyValue `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Id           uint64              `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Transactions []*ProtoTransaction `protobuf:"bytes,2,rep,name=transactions,proto3" json:"transactions,omitempty"`
 }
 
-func (x *ProtoSnapshotBlock) Reset() {
-	*x = ProtoSnapshotBlock{}
+func (x *ProtoTransactions) Reset() {
+	*x = ProtoTransactions{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protobuf_models_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1009,13 +1058,13 @@ func (x *ProtoSnapshotBlock) Reset() {
 	}
 }
 
-func (x *ProtoSnapshotBlock) String() string {
+func (x *ProtoTransactions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtoSnapshotBlock) ProtoMessage() {}
+func (*ProtoTransactions) ProtoMessage() {}
 
-func (x *ProtoSnapshotBlock) ProtoReflect() protoreflect.Message {
+func (x *ProtoTransactions) ProtoReflect() protoreflect.Message {
 	mi := &file_protobuf_models_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1027,28 +1076,35 @@ func (x *ProtoSnapshotBlock) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProtoSnapshotBlock.ProtoReflect.Descriptor instead.
-func (*ProtoSnapshotBlock) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProtoTransactions.ProtoReflect.Descriptor instead.
+func (*ProtoTransactions) Descriptor() ([]byte, []int) {
 	return file_protobuf_models_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *ProtoSnapshotBlock) GetData() []*ProtoSnapshotBlock_KeyValue {
+func (x *ProtoTransactions) GetId() uint64 {
 	if x != nil {
-		return x.Data
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ProtoTransactions) GetTransactions() []*ProtoTransaction {
+	if x != nil {
+		return x.Transactions
 	}
 	return nil
 }
 
-type ProtoSnapshotNodes struct {
+type ProtoGetPooledTransactionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Nodes []*ProtoSnapshotNodes_Node `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *ProtoSnapshotNodes) Reset() {
-	*x = ProtoSnapshotNodes{}
+func (x *ProtoGetPooledTransactionsRequest) Reset() {
+	*x = ProtoGetPooledTransactionsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protobuf_models_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1056,13 +1112,13 @@ func (x *ProtoSnapshotNodes) Reset() {
 	}
 }
 
-func (x *ProtoSnapshotNodes) String() string {
+func (x *ProtoGetPooledTransactionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtoSnapshotNodes) ProtoMessage() {}
+func (*ProtoGetPooledTransactionsRequest) ProtoMessage() {}
 
-func (x *ProtoSnapshotNodes) ProtoReflect() protoreflect.Message {
+func (x *ProtoGetPooledTransactionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_protobuf_models_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1074,29 +1130,29 @@ func (x *ProtoSnapshotNodes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProtoSnapshotNodes.ProtoReflect.Descriptor instead.
-func (*ProtoSnapshotNodes) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProtoGetPooledTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ProtoGetPooledTransactionsRequest) Descriptor() ([]byte, []int) {
 	return file_protobuf_models_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ProtoSnapshotNodes) GetNodes() []*ProtoSnapshotNodes_Node {
+func (x *ProtoGetPooledTransactionsRequest) GetId() uint64 {
 	if x != nil {
-		return x.Nodes
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type ProtoGossipBlockRange struct {
+type ProtoPooledTransactions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BatchId uint32                         `protobuf:"varint,1,opt,name=batchId,proto3" json:"batchId,omitempty"`
-	Blocks  []*ProtoGossipBlockRange_Block `protobuf:"bytes,2,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	Id     uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Hashes [][]byte `protobuf:"bytes,2,rep,name=hashes,proto3" json:"hashes,omitempty"`
 }
 
-func (x *ProtoGossipBlockRange) Reset() {
-	*x = ProtoGossipBlockRange{}
+func (x *ProtoPooledTransactions) Reset() {
+	*x = ProtoPooledTransactions{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protobuf_models_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1104,13 +1160,13 @@ func (x *ProtoGossipBlockRange) Reset() {
 	}
 }
 
-func (x *ProtoGossipBlockRange) String() string {
+func (x *ProtoPooledTransactions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtoGossipBlockRange) ProtoMessage() {}
+func (*ProtoPooledTransactions) ProtoMessage() {}
 
-func (x *ProtoGossipBlockRange) ProtoReflect() protoreflect.Message {
+func (x *ProtoPooledTransactions) ProtoReflect() protoreflect.Message {
 	mi := &file_protobuf_models_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1122,36 +1178,36 @@ func (x *ProtoGossipBlockRange) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProtoGossipBlockRange.ProtoReflect.Descriptor instead.
-func (*ProtoGossipBlockRange) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProtoPooledTransactions.ProtoReflect.Descriptor instead.
+func (*ProtoPooledTransactions) Descriptor() ([]byte, []int) {
 	return file_protobuf_models_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ProtoGossipBlockRange) GetBatchId() uint32 {
+func (x *ProtoPooledTransactions) GetId() uint64 {
 	if x != nil {
-		return x.BatchId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *ProtoGossipBlockRange) GetBlocks() []*ProtoGossipBlockRange_Block {
+func (x *ProtoPooledTransactions) GetHashes() [][]byte {
 	if x != nil {
-		return x.Blocks
+		return x.Hashes
 	}
 	return nil
 }
 
-type ProtoProposeProof struct {
+type ProtoGetStateProofRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data      *ProtoProposeProof_Data `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	Signature []byte                  `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Id      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address []byte `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *ProtoProposeProof) Reset() {
-	*x = ProtoProposeProof{}
+func (x *ProtoGetStateProofRequest) Reset() {
+	*x = ProtoGetStateProofRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protobuf_models_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1159,13 +1215,13 @@ func (x *ProtoProposeProof) Reset() {
 	}
 }
 
-func (x *ProtoProposeProof) String() string {
+func (x *ProtoGetStateProofRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtoProposeProof) ProtoMessage() {}
+func (*ProtoGetStateProofRequest) ProtoMessage() {}
 
-func (x *ProtoProposeProof) ProtoReflect() protoreflect.Message {
+func (x *ProtoGetStateProofRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_protobuf_models_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1177,36 +1233,39 @@ func (x *ProtoProposeProof) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProtoProposeProof.ProtoReflect.Descriptor instead.
-func (*ProtoProposeProof) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProtoGetStateProofRequest.ProtoReflect.Descriptor instead.
+func (*ProtoGetStateProofRequest) Descriptor() ([]byte, []int) {
 	return file_protobuf_models_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ProtoProposeProof) GetData() *ProtoProposeProof_Data {
+func (x *ProtoGetStateProofRequest) GetId() uint64 {
 	if x != nil {
-		return x.Data
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *ProtoProposeProof) GetSignature() []byte {
+func (x *ProtoGetStateProofRequest) GetAddress() []byte {
 	if x != nil {
-		return x.Signature
+		return x.Address
 	}
 	return nil
 }
 
-type ProtoVote struct {
+type ProtoStateProof struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data      *ProtoVote_Data `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	Signature []byte          `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Id       uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Height   uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Root     []byte `protobuf:"bytes,3,opt,name=root,proto3" json:"root,omitempty"`
+	Account  []byte `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
+	Identity []byte `protobuf:"bytes,5,opt,name=identity,proto3" json:"identity,omitempty"`
 }
 
-func (x *ProtoVote) Reset() {
-	*x = ProtoVote{}
+func (x *ProtoStateProof) Reset() {
+	*x = ProtoStateProof{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protobuf_models_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1214,6 +1273,742 @@ func (x *ProtoVote) Reset() {
 	}
 }
 
+func (x *ProtoStateProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoStateProof) ProtoMessage() {}
+
+func (x *ProtoStateProof) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoStateProof.ProtoReflect.Descriptor instead.
+func (*ProtoStateProof) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ProtoStateProof) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ProtoStateProof) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ProtoStateProof) GetRoot() []byte {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+func (x *ProtoStateProof) GetAccount() []byte {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *ProtoStateProof) GetIdentity() []byte {
+	if x != nil {
+		return x.Identity
+	}
+	return nil
+}
+
+type ProtoGetBlockHeadersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	From  uint64 `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	Count uint32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Skip  uint32 `protobuf:"varint,4,opt,name=skip,proto3" json:"skip,omitempty"`
+}
+
+func (x *ProtoGetBlockHeadersRequest) Reset() {
+	*x = ProtoGetBlockHeadersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoGetBlockHeadersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoGetBlockHeadersRequest) ProtoMessage() {}
+
+func (x *ProtoGetBlockHeadersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoGetBlockHeadersRequest.ProtoReflect.Descriptor instead.
+func (*ProtoGetBlockHeadersRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ProtoGetBlockHeadersRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ProtoGetBlockHeadersRequest) GetFrom() uint64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *ProtoGetBlockHeadersRequest) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ProtoGetBlockHeadersRequest) GetSkip() uint32 {
+	if x != nil {
+		return x.Skip
+	}
+	return 0
+}
+
+type ProtoBlockHeaders struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      uint64              `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Headers []*ProtoBlockHeader `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty"`
+}
+
+func (x *ProtoBlockHeaders) Reset() {
+	*x = ProtoBlockHeaders{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoBlockHeaders) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoBlockHeaders) ProtoMessage() {}
+
+func (x *ProtoBlockHeaders) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoBlockHeaders.ProtoReflect.Descriptor instead.
+func (*ProtoBlockHeaders) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ProtoBlockHeaders) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ProtoBlockHeaders) GetHeaders() []*ProtoBlockHeader {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+type ProtoPing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (x *ProtoPing) Reset() {
+	*x = ProtoPing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoPing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoPing) ProtoMessage() {}
+
+func (x *ProtoPing) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoPing.ProtoReflect.Descriptor instead.
+func (*ProtoPing) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ProtoPing) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+type ProtoStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height   uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	HeadHash []byte `protobuf:"bytes,2,opt,name=headHash,proto3" json:"headHash,omitempty"`
+}
+
+func (x *ProtoStatus) Reset() {
+	*x = ProtoStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoStatus) ProtoMessage() {}
+
+func (x *ProtoStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoStatus.ProtoReflect.Descriptor instead.
+func (*ProtoStatus) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ProtoStatus) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ProtoStatus) GetHeadHash() []byte {
+	if x != nil {
+		return x.HeadHash
+	}
+	return nil
+}
+
+type ProtoGetFlipRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id  uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Cid []byte `protobuf:"bytes,2,opt,name=cid,proto3" json:"cid,omitempty"`
+}
+
+func (x *ProtoGetFlipRequest) Reset() {
+	*x = ProtoGetFlipRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoGetFlipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoGetFlipRequest) ProtoMessage() {}
+
+func (x *ProtoGetFlipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoGetFlipRequest.ProtoReflect.Descriptor instead.
+func (*ProtoGetFlipRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ProtoGetFlipRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ProtoGetFlipRequest) GetCid() []byte {
+	if x != nil {
+		return x.Cid
+	}
+	return nil
+}
+
+type ProtoFlipResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Cid  []byte `protobuf:"bytes,2,opt,name=cid,proto3" json:"cid,omitempty"`
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ProtoFlipResponse) Reset() {
+	*x = ProtoFlipResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoFlipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoFlipResponse) ProtoMessage() {}
+
+func (x *ProtoFlipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoFlipResponse.ProtoReflect.Descriptor instead.
+func (*ProtoFlipResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ProtoFlipResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ProtoFlipResponse) GetCid() []byte {
+	if x != nil {
+		return x.Cid
+	}
+	return nil
+}
+
+func (x *ProtoFlipResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ProtoNewBlockHash struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash   []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *ProtoNewBlockHash) Reset() {
+	*x = ProtoNewBlockHash{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoNewBlockHash) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoNewBlockHash) ProtoMessage() {}
+
+func (x *ProtoNewBlockHash) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoNewBlockHash.ProtoReflect.Descriptor instead.
+func (*ProtoNewBlockHash) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ProtoNewBlockHash) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *ProtoNewBlockHash) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type ProtoIdentityStateDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []*ProtoIdentityStateDiff_IdentityStateDiffValue `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *ProtoIdentityStateDiff) Reset() {
+	*x = ProtoIdentityStateDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoIdentityStateDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoIdentityStateDiff) ProtoMessage() {}
+
+func (x *ProtoIdentityStateDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoIdentityStateDiff.ProtoReflect.Descriptor instead.
+func (*ProtoIdentityStateDiff) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ProtoIdentityStateDiff) GetValues() []*ProtoIdentityStateDiff_IdentityStateDiffValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type ProtoSnapshotBlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*ProtoSnapshotBlock_KeyValue `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ProtoSnapshotBlock) Reset() {
+	*x = ProtoSnapshotBlock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoSnapshotBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoSnapshotBlock) ProtoMessage() {}
+
+func (x *ProtoSnapshotBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoSnapshotBlock.ProtoReflect.Descriptor instead.
+func (*ProtoSnapshotBlock) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ProtoSnapshotBlock) GetData() []*ProtoSnapshotBlock_KeyValue {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ProtoSnapshotNodes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nodes []*ProtoSnapshotNodes_Node `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+}
+
+func (x *ProtoSnapshotNodes) Reset() {
+	*x = ProtoSnapshotNodes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoSnapshotNodes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoSnapshotNodes) ProtoMessage() {}
+
+func (x *ProtoSnapshotNodes) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoSnapshotNodes.ProtoReflect.Descriptor instead.
+func (*ProtoSnapshotNodes) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ProtoSnapshotNodes) GetNodes() []*ProtoSnapshotNodes_Node {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+type ProtoGossipBlockRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BatchId uint32                         `protobuf:"varint,1,opt,name=batchId,proto3" json:"batchId,omitempty"`
+	Blocks  []*ProtoGossipBlockRange_Block `protobuf:"bytes,2,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	More    bool                           `protobuf:"varint,3,opt,name=more,proto3" json:"more,omitempty"`
+}
+
+func (x *ProtoGossipBlockRange) Reset() {
+	*x = ProtoGossipBlockRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoGossipBlockRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoGossipBlockRange) ProtoMessage() {}
+
+func (x *ProtoGossipBlockRange) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoGossipBlockRange.ProtoReflect.Descriptor instead.
+func (*ProtoGossipBlockRange) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ProtoGossipBlockRange) GetBatchId() uint32 {
+	if x != nil {
+		return x.BatchId
+	}
+	return 0
+}
+
+func (x *ProtoGossipBlockRange) GetBlocks() []*ProtoGossipBlockRange_Block {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+func (x *ProtoGossipBlockRange) GetMore() bool {
+	if x != nil {
+		return x.More
+	}
+	return false
+}
+
+type ProtoProposeProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data      *ProtoProposeProof_Data `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Signature []byte                  `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *ProtoProposeProof) Reset() {
+	*x = ProtoProposeProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoProposeProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoProposeProof) ProtoMessage() {}
+
+func (x *ProtoProposeProof) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoProposeProof.ProtoReflect.Descriptor instead.
+func (*ProtoProposeProof) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ProtoProposeProof) GetData() *ProtoProposeProof_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ProtoProposeProof) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type ProtoVote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data      *ProtoVote_Data `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Signature []byte          `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *ProtoVote) Reset() {
+	*x = ProtoVote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
 func (x *ProtoVote) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
@@ -1221,7 +2016,7 @@ func (x *ProtoVote) String() string {
 func (*ProtoVote) ProtoMessage() {}
 
 func (x *ProtoVote) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[21]
+	mi := &file_protobuf_models_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1234,7 +2029,7 @@ func (x *ProtoVote) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoVote.ProtoReflect.Descriptor instead.
 func (*ProtoVote) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{21}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *ProtoVote) GetData() *ProtoVote_Data {
@@ -1262,7 +2057,7 @@ type ProtoGetBlockByHashRequest struct {
 func (x *ProtoGetBlockByHashRequest) Reset() {
 	*x = ProtoGetBlockByHashRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[22]
+		mi := &file_protobuf_models_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1275,7 +2070,7 @@ func (x *ProtoGetBlockByHashRequest) String() string {
 func (*ProtoGetBlockByHashRequest) ProtoMessage() {}
 
 func (x *ProtoGetBlockByHashRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[22]
+	mi := &file_protobuf_models_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1288,7 +2083,7 @@ func (x *ProtoGetBlockByHashRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoGetBlockByHashRequest.ProtoReflect.Descriptor instead.
 func (*ProtoGetBlockByHashRequest) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{22}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *ProtoGetBlockByHashRequest) GetHash() []byte {
@@ -1311,7 +2106,7 @@ type ProtoGetBlocksRangeRequest struct {
 func (x *ProtoGetBlocksRangeRequest) Reset() {
 	*x = ProtoGetBlocksRangeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[23]
+		mi := &file_protobuf_models_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1324,7 +2119,7 @@ func (x *ProtoGetBlocksRangeRequest) String() string {
 func (*ProtoGetBlocksRangeRequest) ProtoMessage() {}
 
 func (x *ProtoGetBlocksRangeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[23]
+	mi := &file_protobuf_models_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1337,7 +2132,7 @@ func (x *ProtoGetBlocksRangeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoGetBlocksRangeRequest.ProtoReflect.Descriptor instead.
 func (*ProtoGetBlocksRangeRequest) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{23}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *ProtoGetBlocksRangeRequest) GetBatchId() uint32 {
@@ -1373,7 +2168,7 @@ type ProtoGetForkBlockRangeRequest struct {
 func (x *ProtoGetForkBlockRangeRequest) Reset() {
 	*x = ProtoGetForkBlockRangeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[24]
+		mi := &file_protobuf_models_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1386,7 +2181,7 @@ func (x *ProtoGetForkBlockRangeRequest) String() string {
 func (*ProtoGetForkBlockRangeRequest) ProtoMessage() {}
 
 func (x *ProtoGetForkBlockRangeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[24]
+	mi := &file_protobuf_models_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1399,7 +2194,7 @@ func (x *ProtoGetForkBlockRangeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoGetForkBlockRangeRequest.ProtoReflect.Descriptor instead.
 func (*ProtoGetForkBlockRangeRequest) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{24}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *ProtoGetForkBlockRangeRequest) GetBatchId() uint32 {
@@ -1429,7 +2224,7 @@ type ProtoFlip struct {
 func (x *ProtoFlip) Reset() {
 	*x = ProtoFlip{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[25]
+		mi := &file_protobuf_models_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1442,7 +2237,7 @@ func (x *ProtoFlip) String() string {
 func (*ProtoFlip) ProtoMessage() {}
 
 func (x *ProtoFlip) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[25]
+	mi := &file_protobuf_models_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1455,7 +2250,7 @@ func (x *ProtoFlip) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoFlip.ProtoReflect.Descriptor instead.
 func (*ProtoFlip) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{25}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *ProtoFlip) GetTransaction() *ProtoTransaction {
@@ -1491,7 +2286,7 @@ type ProtoFlipKey struct {
 func (x *ProtoFlipKey) Reset() {
 	*x = ProtoFlipKey{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[26]
+		mi := &file_protobuf_models_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1504,7 +2299,7 @@ func (x *ProtoFlipKey) String() string {
 func (*ProtoFlipKey) ProtoMessage() {}
 
 func (x *ProtoFlipKey) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[26]
+	mi := &file_protobuf_models_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1517,7 +2312,7 @@ func (x *ProtoFlipKey) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoFlipKey.ProtoReflect.Descriptor instead.
 func (*ProtoFlipKey) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{26}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *ProtoFlipKey) GetData() *ProtoFlipKey_Data {
@@ -1547,7 +2342,7 @@ type ProtoManifest struct {
 func (x *ProtoManifest) Reset() {
 	*x = ProtoManifest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[27]
+		mi := &file_protobuf_models_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1560,7 +2355,7 @@ func (x *ProtoManifest) String() string {
 func (*ProtoManifest) ProtoMessage() {}
 
 func (x *ProtoManifest) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[27]
+	mi := &file_protobuf_models_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1573,7 +2368,7 @@ func (x *ProtoManifest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoManifest.ProtoReflect.Descriptor instead.
 func (*ProtoManifest) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{27}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *ProtoManifest) GetHeight() uint64 {
@@ -1609,7 +2404,7 @@ type ProtoPrivateFlipKeysPackage struct {
 func (x *ProtoPrivateFlipKeysPackage) Reset() {
 	*x = ProtoPrivateFlipKeysPackage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[28]
+		mi := &file_protobuf_models_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1622,7 +2417,7 @@ func (x *ProtoPrivateFlipKeysPackage) String() string {
 func (*ProtoPrivateFlipKeysPackage) ProtoMessage() {}
 
 func (x *ProtoPrivateFlipKeysPackage) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[28]
+	mi := &file_protobuf_models_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1635,7 +2430,7 @@ func (x *ProtoPrivateFlipKeysPackage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoPrivateFlipKeysPackage.ProtoReflect.Descriptor instead.
 func (*ProtoPrivateFlipKeysPackage) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{28}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *ProtoPrivateFlipKeysPackage) GetData() *ProtoPrivateFlipKeysPackage_Data {
@@ -1664,7 +2459,7 @@ type ProtoPullPushHash struct {
 func (x *ProtoPullPushHash) Reset() {
 	*x = ProtoPullPushHash{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[29]
+		mi := &file_protobuf_models_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1677,7 +2472,7 @@ func (x *ProtoPullPushHash) String() string {
 func (*ProtoPullPushHash) ProtoMessage() {}
 
 func (x *ProtoPullPushHash) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[29]
+	mi := &file_protobuf_models_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1690,7 +2485,7 @@ func (x *ProtoPullPushHash) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoPullPushHash.ProtoReflect.Descriptor instead.
 func (*ProtoPullPushHash) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{29}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *ProtoPullPushHash) GetType() uint32 {
@@ -1721,7 +2516,7 @@ type ProtoSnapshotManifestDb struct {
 func (x *ProtoSnapshotManifestDb) Reset() {
 	*x = ProtoSnapshotManifestDb{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[30]
+		mi := &file_protobuf_models_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1734,7 +2529,7 @@ func (x *ProtoSnapshotManifestDb) String() string {
 func (*ProtoSnapshotManifestDb) ProtoMessage() {}
 
 func (x *ProtoSnapshotManifestDb) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[30]
+	mi := &file_protobuf_models_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1747,7 +2542,7 @@ func (x *ProtoSnapshotManifestDb) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoSnapshotManifestDb.ProtoReflect.Descriptor instead.
 func (*ProtoSnapshotManifestDb) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{30}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *ProtoSnapshotManifestDb) GetHeight() uint64 {
@@ -1790,7 +2585,7 @@ type ProtoShortAnswerDb struct {
 func (x *ProtoShortAnswerDb) Reset() {
 	*x = ProtoShortAnswerDb{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[31]
+		mi := &file_protobuf_models_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1803,7 +2598,7 @@ func (x *ProtoShortAnswerDb) String() string {
 func (*ProtoShortAnswerDb) ProtoMessage() {}
 
 func (x *ProtoShortAnswerDb) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[31]
+	mi := &file_protobuf_models_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1816,7 +2611,7 @@ func (x *ProtoShortAnswerDb) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoShortAnswerDb.ProtoReflect.Descriptor instead.
 func (*ProtoShortAnswerDb) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{31}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *ProtoShortAnswerDb) GetHash() []byte {
@@ -1844,7 +2639,7 @@ type ProtoAnswersDb struct {
 func (x *ProtoAnswersDb) Reset() {
 	*x = ProtoAnswersDb{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[32]
+		mi := &file_protobuf_models_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1857,7 +2652,7 @@ func (x *ProtoAnswersDb) String() string {
 func (*ProtoAnswersDb) ProtoMessage() {}
 
 func (x *ProtoAnswersDb) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[32]
+	mi := &file_protobuf_models_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1870,7 +2665,7 @@ func (x *ProtoAnswersDb) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoAnswersDb.ProtoReflect.Descriptor instead.
 func (*ProtoAnswersDb) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{32}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *ProtoAnswersDb) GetAnswers() []*ProtoAnswersDb_Answer {
@@ -1893,7 +2688,7 @@ type ProtoBurntCoins struct {
 func (x *ProtoBurntCoins) Reset() {
 	*x = ProtoBurntCoins{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[33]
+		mi := &file_protobuf_models_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1906,7 +2701,7 @@ func (x *ProtoBurntCoins) String() string {
 func (*ProtoBurntCoins) ProtoMessage() {}
 
 func (x *ProtoBurntCoins) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[33]
+	mi := &file_protobuf_models_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1919,7 +2714,7 @@ func (x *ProtoBurntCoins) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoBurntCoins.ProtoReflect.Descriptor instead.
 func (*ProtoBurntCoins) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{33}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *ProtoBurntCoins) GetAddress() []byte {
@@ -1957,7 +2752,7 @@ type ProtoSavedTransaction struct {
 func (x *ProtoSavedTransaction) Reset() {
 	*x = ProtoSavedTransaction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[34]
+		mi := &file_protobuf_models_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1970,7 +2765,7 @@ func (x *ProtoSavedTransaction) String() string {
 func (*ProtoSavedTransaction) ProtoMessage() {}
 
 func (x *ProtoSavedTransaction) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[34]
+	mi := &file_protobuf_models_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1983,7 +2778,7 @@ func (x *ProtoSavedTransaction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoSavedTransaction.ProtoReflect.Descriptor instead.
 func (*ProtoSavedTransaction) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{34}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *ProtoSavedTransaction) GetTx() *ProtoTransaction {
@@ -2026,7 +2821,7 @@ type ProtoActivityMonitor struct {
 func (x *ProtoActivityMonitor) Reset() {
 	*x = ProtoActivityMonitor{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[35]
+		mi := &file_protobuf_models_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2039,7 +2834,7 @@ func (x *ProtoActivityMonitor) String() string {
 func (*ProtoActivityMonitor) ProtoMessage() {}
 
 func (x *ProtoActivityMonitor) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[35]
+	mi := &file_protobuf_models_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2052,7 +2847,7 @@ func (x *ProtoActivityMonitor) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoActivityMonitor.ProtoReflect.Descriptor instead.
 func (*ProtoActivityMonitor) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{35}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *ProtoActivityMonitor) GetTimestamp() int64 {
@@ -2082,7 +2877,7 @@ type ProtoShortAnswerAttachment struct {
 func (x *ProtoShortAnswerAttachment) Reset() {
 	*x = ProtoShortAnswerAttachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[36]
+		mi := &file_protobuf_models_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2095,7 +2890,7 @@ func (x *ProtoShortAnswerAttachment) String() string {
 func (*ProtoShortAnswerAttachment) ProtoMessage() {}
 
 func (x *ProtoShortAnswerAttachment) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[36]
+	mi := &file_protobuf_models_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2108,7 +2903,7 @@ func (x *ProtoShortAnswerAttachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoShortAnswerAttachment.ProtoReflect.Descriptor instead.
 func (*ProtoShortAnswerAttachment) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{36}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *ProtoShortAnswerAttachment) GetAnswers() []byte {
@@ -2146,7 +2941,7 @@ type ProtoLongAnswerAttachment struct {
 func (x *ProtoLongAnswerAttachment) Reset() {
 	*x = ProtoLongAnswerAttachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[37]
+		mi := &file_protobuf_models_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2159,7 +2954,7 @@ func (x *ProtoLongAnswerAttachment) String() string {
 func (*ProtoLongAnswerAttachment) ProtoMessage() {}
 
 func (x *ProtoLongAnswerAttachment) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[37]
+	mi := &file_protobuf_models_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2172,7 +2967,7 @@ func (x *ProtoLongAnswerAttachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoLongAnswerAttachment.ProtoReflect.Descriptor instead.
 func (*ProtoLongAnswerAttachment) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{37}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *ProtoLongAnswerAttachment) GetAnswers() []byte {
@@ -2215,7 +3010,7 @@ type ProtoFlipSubmitAttachment struct {
 func (x *ProtoFlipSubmitAttachment) Reset() {
 	*x = ProtoFlipSubmitAttachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[38]
+		mi := &file_protobuf_models_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2228,7 +3023,7 @@ func (x *ProtoFlipSubmitAttachment) String() string {
 func (*ProtoFlipSubmitAttachment) ProtoMessage() {}
 
 func (x *ProtoFlipSubmitAttachment) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[38]
+	mi := &file_protobuf_models_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2241,7 +3036,7 @@ func (x *ProtoFlipSubmitAttachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoFlipSubmitAttachment.ProtoReflect.Descriptor instead.
 func (*ProtoFlipSubmitAttachment) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{38}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *ProtoFlipSubmitAttachment) GetCid() []byte {
@@ -2269,7 +3064,7 @@ type ProtoOnlineStatusAttachment struct {
 func (x *ProtoOnlineStatusAttachment) Reset() {
 	*x = ProtoOnlineStatusAttachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[39]
+		mi := &file_protobuf_models_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2282,7 +3077,7 @@ func (x *ProtoOnlineStatusAttachment) String() string {
 func (*ProtoOnlineStatusAttachment) ProtoMessage() {}
 
 func (x *ProtoOnlineStatusAttachment) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[39]
+	mi := &file_protobuf_models_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2295,7 +3090,7 @@ func (x *ProtoOnlineStatusAttachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoOnlineStatusAttachment.ProtoReflect.Descriptor instead.
 func (*ProtoOnlineStatusAttachment) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{39}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *ProtoOnlineStatusAttachment) GetOnline() bool {
@@ -2316,7 +3111,7 @@ type ProtoBurnAttachment struct {
 func (x *ProtoBurnAttachment) Reset() {
 	*x = ProtoBurnAttachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[40]
+		mi := &file_protobuf_models_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2329,7 +3124,7 @@ func (x *ProtoBurnAttachment) String() string {
 func (*ProtoBurnAttachment) ProtoMessage() {}
 
 func (x *ProtoBurnAttachment) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[40]
+	mi := &file_protobuf_models_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2342,7 +3137,7 @@ func (x *ProtoBurnAttachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoBurnAttachment.ProtoReflect.Descriptor instead.
 func (*ProtoBurnAttachment) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{40}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *ProtoBurnAttachment) GetKey() string {
@@ -2363,7 +3158,7 @@ type ProtoChangeProfileAttachment struct {
 func (x *ProtoChangeProfileAttachment) Reset() {
 	*x = ProtoChangeProfileAttachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[41]
+		mi := &file_protobuf_models_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2376,7 +3171,7 @@ func (x *ProtoChangeProfileAttachment) String() string {
 func (*ProtoChangeProfileAttachment) ProtoMessage() {}
 
 func (x *ProtoChangeProfileAttachment) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[41]
+	mi := &file_protobuf_models_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2389,7 +3184,7 @@ func (x *ProtoChangeProfileAttachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoChangeProfileAttachment.ProtoReflect.Descriptor instead.
 func (*ProtoChangeProfileAttachment) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{41}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *ProtoChangeProfileAttachment) GetHash() []byte {
@@ -2410,7 +3205,7 @@ type ProtoDeleteFlipAttachment struct {
 func (x *ProtoDeleteFlipAttachment) Reset() {
 	*x = ProtoDeleteFlipAttachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[42]
+		mi := &file_protobuf_models_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2423,7 +3218,7 @@ func (x *ProtoDeleteFlipAttachment) String() string {
 func (*ProtoDeleteFlipAttachment) ProtoMessage() {}
 
 func (x *ProtoDeleteFlipAttachment) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[42]
+	mi := &file_protobuf_models_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2436,7 +3231,7 @@ func (x *ProtoDeleteFlipAttachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoDeleteFlipAttachment.ProtoReflect.Descriptor instead.
 func (*ProtoDeleteFlipAttachment) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{42}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *ProtoDeleteFlipAttachment) GetCid() []byte {
@@ -2460,7 +3255,7 @@ type ProtoStateAccount struct {
 func (x *ProtoStateAccount) Reset() {
 	*x = ProtoStateAccount{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[43]
+		mi := &file_protobuf_models_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2473,7 +3268,7 @@ func (x *ProtoStateAccount) String() string {
 func (*ProtoStateAccount) ProtoMessage() {}
 
 func (x *ProtoStateAccount) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[43]
+	mi := &file_protobuf_models_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2486,7 +3281,7 @@ func (x *ProtoStateAccount) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoStateAccount.ProtoReflect.Descriptor instead.
 func (*ProtoStateAccount) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{43}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *ProtoStateAccount) GetNonce() uint32 {
@@ -2555,7 +3350,7 @@ type ProtoStateIdentity struct {
 func (x *ProtoStateIdentity) Reset() {
 	*x = ProtoStateIdentity{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[44]
+		mi := &file_protobuf_models_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2568,7 +3363,7 @@ func (x *ProtoStateIdentity) String() string {
 func (*ProtoStateIdentity) ProtoMessage() {}
 
 func (x *ProtoStateIdentity) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[44]
+	mi := &file_protobuf_models_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2581,7 +3376,7 @@ func (x *ProtoStateIdentity) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoStateIdentity.ProtoReflect.Descriptor instead.
 func (*ProtoStateIdentity) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{44}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *ProtoStateIdentity) GetStake() []byte {
@@ -2807,7 +3602,7 @@ type ProtoStateGlobal struct {
 func (x *ProtoStateGlobal) Reset() {
 	*x = ProtoStateGlobal{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[45]
+		mi := &file_protobuf_models_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2820,7 +3615,7 @@ func (x *ProtoStateGlobal) String() string {
 func (*ProtoStateGlobal) ProtoMessage() {}
 
 func (x *ProtoStateGlobal) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[45]
+	mi := &file_protobuf_models_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2833,7 +3628,7 @@ func (x *ProtoStateGlobal) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoStateGlobal.ProtoReflect.Descriptor instead.
 func (*ProtoStateGlobal) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{45}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *ProtoStateGlobal) GetEpoch() uint32 {
@@ -2967,7 +3762,7 @@ type ProtoStateApprovedIdentity struct {
 func (x *ProtoStateApprovedIdentity) Reset() {
 	*x = ProtoStateApprovedIdentity{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[46]
+		mi := &file_protobuf_models_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2980,7 +3775,7 @@ func (x *ProtoStateApprovedIdentity) String() string {
 func (*ProtoStateApprovedIdentity) ProtoMessage() {}
 
 func (x *ProtoStateApprovedIdentity) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[46]
+	mi := &file_protobuf_models_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2993,7 +3788,7 @@ func (x *ProtoStateApprovedIdentity) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoStateApprovedIdentity.ProtoReflect.Descriptor instead.
 func (*ProtoStateApprovedIdentity) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{46}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *ProtoStateApprovedIdentity) GetDelegatee() []byte {
@@ -3021,7 +3816,7 @@ type ProtoStateIdentityStatusSwitch struct {
 func (x *ProtoStateIdentityStatusSwitch) Reset() {
 	*x = ProtoStateIdentityStatusSwitch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[47]
+		mi := &file_protobuf_models_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3034,7 +3829,7 @@ func (x *ProtoStateIdentityStatusSwitch) String() string {
 func (*ProtoStateIdentityStatusSwitch) ProtoMessage() {}
 
 func (x *ProtoStateIdentityStatusSwitch) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[47]
+	mi := &file_protobuf_models_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3047,7 +3842,7 @@ func (x *ProtoStateIdentityStatusSwitch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoStateIdentityStatusSwitch.ProtoReflect.Descriptor instead.
 func (*ProtoStateIdentityStatusSwitch) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{47}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *ProtoStateIdentityStatusSwitch) GetAddresses() [][]byte {
@@ -3068,7 +3863,7 @@ type ProtoStateDelegationSwitch struct {
 func (x *ProtoStateDelegationSwitch) Reset() {
 	*x = ProtoStateDelegationSwitch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[48]
+		mi := &file_protobuf_models_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3081,7 +3876,7 @@ func (x *ProtoStateDelegationSwitch) String() string {
 func (*ProtoStateDelegationSwitch) ProtoMessage() {}
 
 func (x *ProtoStateDelegationSwitch) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[48]
+	mi := &file_protobuf_models_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3094,7 +3889,7 @@ func (x *ProtoStateDelegationSwitch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoStateDelegationSwitch.ProtoReflect.Descriptor instead.
 func (*ProtoStateDelegationSwitch) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{48}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *ProtoStateDelegationSwitch) GetDelegations() []*ProtoStateDelegationSwitch_Delegation {
@@ -3115,7 +3910,7 @@ type ProtoStateDelayedPenalties struct {
 func (x *ProtoStateDelayedPenalties) Reset() {
 	*x = ProtoStateDelayedPenalties{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[49]
+		mi := &file_protobuf_models_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3128,7 +3923,7 @@ func (x *ProtoStateDelayedPenalties) String() string {
 func (*ProtoStateDelayedPenalties) ProtoMessage() {}
 
 func (x *ProtoStateDelayedPenalties) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[49]
+	mi := &file_protobuf_models_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3141,7 +3936,7 @@ func (x *ProtoStateDelayedPenalties) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoStateDelayedPenalties.ProtoReflect.Descriptor instead.
 func (*ProtoStateDelayedPenalties) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{49}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *ProtoStateDelayedPenalties) GetIdentities() [][]byte {
@@ -3162,7 +3957,7 @@ type ProtoStateBurntCoins struct {
 func (x *ProtoStateBurntCoins) Reset() {
 	*x = ProtoStateBurntCoins{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[50]
+		mi := &file_protobuf_models_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3175,7 +3970,7 @@ func (x *ProtoStateBurntCoins) String() string {
 func (*ProtoStateBurntCoins) ProtoMessage() {}
 
 func (x *ProtoStateBurntCoins) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[50]
+	mi := &file_protobuf_models_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3188,7 +3983,7 @@ func (x *ProtoStateBurntCoins) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoStateBurntCoins.ProtoReflect.Descriptor instead.
 func (*ProtoStateBurntCoins) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{50}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *ProtoStateBurntCoins) GetItems() []*ProtoStateBurntCoins_Item {
@@ -3216,7 +4011,7 @@ type ProtoPredefinedState struct {
 func (x *ProtoPredefinedState) Reset() {
 	*x = ProtoPredefinedState{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[51]
+		mi := &file_protobuf_models_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3229,7 +4024,7 @@ func (x *ProtoPredefinedState) String() string {
 func (*ProtoPredefinedState) ProtoMessage() {}
 
 func (x *ProtoPredefinedState) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[51]
+	mi := &file_protobuf_models_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3242,7 +4037,7 @@ func (x *ProtoPredefinedState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoPredefinedState.ProtoReflect.Descriptor instead.
 func (*ProtoPredefinedState) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{51}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *ProtoPredefinedState) GetBlock() uint64 {
@@ -3313,7 +4108,7 @@ type ProtoCallContractAttachment struct {
 func (x *ProtoCallContractAttachment) Reset() {
 	*x = ProtoCallContractAttachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[52]
+		mi := &file_protobuf_models_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3326,7 +4121,7 @@ func (x *ProtoCallContractAttachment) String() string {
 func (*ProtoCallContractAttachment) ProtoMessage() {}
 
 func (x *ProtoCallContractAttachment) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[52]
+	mi := &file_protobuf_models_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3339,7 +4134,7 @@ func (x *ProtoCallContractAttachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoCallContractAttachment.ProtoReflect.Descriptor instead.
 func (*ProtoCallContractAttachment) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{52}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *ProtoCallContractAttachment) GetMethod() string {
@@ -3370,7 +4165,7 @@ type ProtoDeployContractAttachment struct {
 func (x *ProtoDeployContractAttachment) Reset() {
 	*x = ProtoDeployContractAttachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[53]
+		mi := &file_protobuf_models_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3383,7 +4178,7 @@ func (x *ProtoDeployContractAttachment) String() string {
 func (*ProtoDeployContractAttachment) ProtoMessage() {}
 
 func (x *ProtoDeployContractAttachment) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[53]
+	mi := &file_protobuf_models_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3396,7 +4191,7 @@ func (x *ProtoDeployContractAttachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoDeployContractAttachment.ProtoReflect.Descriptor instead.
 func (*ProtoDeployContractAttachment) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{53}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *ProtoDeployContractAttachment) GetCodeHash() []byte {
@@ -3438,7 +4233,7 @@ type ProtoTerminateContractAttachment struct {
 func (x *ProtoTerminateContractAttachment) Reset() {
 	*x = ProtoTerminateContractAttachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[54]
+		mi := &file_protobuf_models_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3451,7 +4246,7 @@ func (x *ProtoTerminateContractAttachment) String() string {
 func (*ProtoTerminateContractAttachment) ProtoMessage() {}
 
 func (x *ProtoTerminateContractAttachment) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[54]
+	mi := &file_protobuf_models_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3464,7 +4259,7 @@ func (x *ProtoTerminateContractAttachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoTerminateContractAttachment.ProtoReflect.Descriptor instead.
 func (*ProtoTerminateContractAttachment) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{54}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *ProtoTerminateContractAttachment) GetArgs() [][]byte {
@@ -3486,7 +4281,7 @@ type ProtoStoreToIpfsAttachment struct {
 func (x *ProtoStoreToIpfsAttachment) Reset() {
 	*x = ProtoStoreToIpfsAttachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[55]
+		mi := &file_protobuf_models_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3499,7 +4294,7 @@ func (x *ProtoStoreToIpfsAttachment) String() string {
 func (*ProtoStoreToIpfsAttachment) ProtoMessage() {}
 
 func (x *ProtoStoreToIpfsAttachment) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[55]
+	mi := &file_protobuf_models_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3512,7 +4307,7 @@ func (x *ProtoStoreToIpfsAttachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoStoreToIpfsAttachment.ProtoReflect.Descriptor instead.
 func (*ProtoStoreToIpfsAttachment) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{55}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *ProtoStoreToIpfsAttachment) GetCid() []byte {
@@ -3540,7 +4335,7 @@ type ProtoTxReceipts struct {
 func (x *ProtoTxReceipts) Reset() {
 	*x = ProtoTxReceipts{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[56]
+		mi := &file_protobuf_models_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3553,7 +4348,7 @@ func (x *ProtoTxReceipts) String() string {
 func (*ProtoTxReceipts) ProtoMessage() {}
 
 func (x *ProtoTxReceipts) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[56]
+	mi := &file_protobuf_models_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3566,7 +4361,7 @@ func (x *ProtoTxReceipts) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoTxReceipts.ProtoReflect.Descriptor instead.
 func (*ProtoTxReceipts) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{56}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *ProtoTxReceipts) GetReceipts() []*ProtoTxReceipts_ProtoTxReceipt {
@@ -3588,7 +4383,7 @@ type ProtoTxReceiptIndex struct {
 func (x *ProtoTxReceiptIndex) Reset() {
 	*x = ProtoTxReceiptIndex{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[57]
+		mi := &file_protobuf_models_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3601,7 +4396,7 @@ func (x *ProtoTxReceiptIndex) String() string {
 func (*ProtoTxReceiptIndex) ProtoMessage() {}
 
 func (x *ProtoTxReceiptIndex) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[57]
+	mi := &file_protobuf_models_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3614,7 +4409,7 @@ func (x *ProtoTxReceiptIndex) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoTxReceiptIndex.ProtoReflect.Descriptor instead.
 func (*ProtoTxReceiptIndex) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{57}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *ProtoTxReceiptIndex) GetCid() []byte {
@@ -3642,7 +4437,7 @@ type ProtoDeferredTxs struct {
 func (x *ProtoDeferredTxs) Reset() {
 	*x = ProtoDeferredTxs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[58]
+		mi := &file_protobuf_models_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3655,7 +4450,7 @@ func (x *ProtoDeferredTxs) String() string {
 func (*ProtoDeferredTxs) ProtoMessage() {}
 
 func (x *ProtoDeferredTxs) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[58]
+	mi := &file_protobuf_models_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3668,7 +4463,7 @@ func (x *ProtoDeferredTxs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoDeferredTxs.ProtoReflect.Descriptor instead.
 func (*ProtoDeferredTxs) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{58}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *ProtoDeferredTxs) GetTxs() []*ProtoDeferredTxs_ProtoDeferredTx {
@@ -3691,7 +4486,7 @@ type ProtoSavedEvent struct {
 func (x *ProtoSavedEvent) Reset() {
 	*x = ProtoSavedEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[59]
+		mi := &file_protobuf_models_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3704,7 +4499,7 @@ func (x *ProtoSavedEvent) String() string {
 func (*ProtoSavedEvent) ProtoMessage() {}
 
 func (x *ProtoSavedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[59]
+	mi := &file_protobuf_models_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3717,7 +4512,7 @@ func (x *ProtoSavedEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoSavedEvent.ProtoReflect.Descriptor instead.
 func (*ProtoSavedEvent) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{59}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *ProtoSavedEvent) GetContract() []byte {
@@ -3752,7 +4547,7 @@ type ProtoUpgradeVotes struct {
 func (x *ProtoUpgradeVotes) Reset() {
 	*x = ProtoUpgradeVotes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[60]
+		mi := &file_protobuf_models_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3765,7 +4560,7 @@ func (x *ProtoUpgradeVotes) String() string {
 func (*ProtoUpgradeVotes) ProtoMessage() {}
 
 func (x *ProtoUpgradeVotes) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[60]
+	mi := &file_protobuf_models_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3778,7 +4573,7 @@ func (x *ProtoUpgradeVotes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoUpgradeVotes.ProtoReflect.Descriptor instead.
 func (*ProtoUpgradeVotes) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{60}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *ProtoUpgradeVotes) GetVotes() []*ProtoUpgradeVotes_ProtoUpgradeVote {
@@ -3799,7 +4594,7 @@ type ProtoLotteryIdentitiesDb struct {
 func (x *ProtoLotteryIdentitiesDb) Reset() {
 	*x = ProtoLotteryIdentitiesDb{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[61]
+		mi := &file_protobuf_models_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3812,7 +4607,7 @@ func (x *ProtoLotteryIdentitiesDb) String() string {
 func (*ProtoLotteryIdentitiesDb) ProtoMessage() {}
 
 func (x *ProtoLotteryIdentitiesDb) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[61]
+	mi := &file_protobuf_models_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3825,7 +4620,7 @@ func (x *ProtoLotteryIdentitiesDb) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoLotteryIdentitiesDb.ProtoReflect.Descriptor instead.
 func (*ProtoLotteryIdentitiesDb) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{61}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *ProtoLotteryIdentitiesDb) GetIdentities() []*ProtoLotteryIdentitiesDb_Identity {
@@ -3848,12 +4643,13 @@ type ProtoTransaction_Data struct {
 	MaxFee  []byte `protobuf:"bytes,6,opt,name=maxFee,proto3" json:"maxFee,omitempty"`
 	Tips    []byte `protobuf:"bytes,7,opt,name=tips,proto3" json:"tips,omitempty"`
 	Payload []byte `protobuf:"bytes,8,opt,name=payload,proto3" json:"payload,omitempty"`
+	Network uint32 `protobuf:"varint,9,opt,name=network,proto3" json:"network,omitempty"`
 }
 
 func (x *ProtoTransaction_Data) Reset() {
 	*x = ProtoTransaction_Data{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[62]
+		mi := &file_protobuf_models_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3866,7 +4662,7 @@ func (x *ProtoTransaction_Data) String() string {
 func (*ProtoTransaction_Data) ProtoMessage() {}
 
 func (x *ProtoTransaction_Data) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[62]
+	mi := &file_protobuf_models_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3938,6 +4734,13 @@ func (x *ProtoTransaction_Data) GetPayload() []byte {
 	return nil
 }
 
+func (x *ProtoTransaction_Data) GetNetwork() uint32 {
+	if x != nil {
+		return x.Network
+	}
+	return 0
+}
+
 type ProtoBlockHeader_Proposed struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3964,7 +4767,7 @@ type ProtoBlockHeader_Proposed struct {
 func (x *ProtoBlockHeader_Proposed) Reset() {
 	*x = ProtoBlockHeader_Proposed{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[63]
+		mi := &file_protobuf_models_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3977,7 +4780,7 @@ func (x *ProtoBlockHeader_Proposed) String() string {
 func (*ProtoBlockHeader_Proposed) ProtoMessage() {}
 
 func (x *ProtoBlockHeader_Proposed) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[63]
+	mi := &file_protobuf_models_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4122,7 +4925,7 @@ type ProtoBlockHeader_Empty struct {
 func (x *ProtoBlockHeader_Empty) Reset() {
 	*x = ProtoBlockHeader_Empty{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[64]
+		mi := &file_protobuf_models_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4135,7 +4938,7 @@ func (x *ProtoBlockHeader_Empty) String() string {
 func (*ProtoBlockHeader_Empty) ProtoMessage() {}
 
 func (x *ProtoBlockHeader_Empty) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[64]
+	mi := &file_protobuf_models_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4213,7 +5016,7 @@ type ProtoBlockProposal_Data struct {
 func (x *ProtoBlockProposal_Data) Reset() {
 	*x = ProtoBlockProposal_Data{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[65]
+		mi := &file_protobuf_models_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4226,7 +5029,7 @@ func (x *ProtoBlockProposal_Data) String() string {
 func (*ProtoBlockProposal_Data) ProtoMessage() {}
 
 func (x *ProtoBlockProposal_Data) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[65]
+	mi := &file_protobuf_models_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4276,7 +5079,7 @@ type ProtoBlockCert_Signature struct {
 func (x *ProtoBlockCert_Signature) Reset() {
 	*x = ProtoBlockCert_Signature{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[66]
+		mi := &file_protobuf_models_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4289,7 +5092,7 @@ func (x *ProtoBlockCert_Signature) String() string {
 func (*ProtoBlockCert_Signature) ProtoMessage() {}
 
 func (x *ProtoBlockCert_Signature) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[66]
+	mi := &file_protobuf_models_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4338,7 +5141,7 @@ type ProtoMsgBatch_BatchItem struct {
 func (x *ProtoMsgBatch_BatchItem) Reset() {
 	*x = ProtoMsgBatch_BatchItem{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[67]
+		mi := &file_protobuf_models_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4351,7 +5154,7 @@ func (x *ProtoMsgBatch_BatchItem) String() string {
 func (*ProtoMsgBatch_BatchItem) ProtoMessage() {}
 
 func (x *ProtoMsgBatch_BatchItem) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[67]
+	mi := &file_protobuf_models_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4394,7 +5197,7 @@ type ProtoIdentityStateDiff_IdentityStateDiffValue struct {
 func (x *ProtoIdentityStateDiff_IdentityStateDiffValue) Reset() {
 	*x = ProtoIdentityStateDiff_IdentityStateDiffValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[68]
+		mi := &file_protobuf_models_proto_msgTypes[81]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4407,7 +5210,7 @@ func (x *ProtoIdentityStateDiff_IdentityStateDiffValue) String() string {
 func (*ProtoIdentityStateDiff_IdentityStateDiffValue) ProtoMessage() {}
 
 func (x *ProtoIdentityStateDiff_IdentityStateDiffValue) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[68]
+	mi := &file_protobuf_models_proto_msgTypes[81]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4420,7 +5223,7 @@ func (x *ProtoIdentityStateDiff_IdentityStateDiffValue) ProtoReflect() protorefl
 
 // Deprecated: Use ProtoIdentityStateDiff_IdentityStateDiffValue.ProtoReflect.Descriptor instead.
 func (*ProtoIdentityStateDiff_IdentityStateDiffValue) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{16, 0}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{29, 0}
 }
 
 func (x *ProtoIdentityStateDiff_IdentityStateDiffValue) GetAddress() []byte {
@@ -4456,7 +5259,7 @@ type ProtoSnapshotBlock_KeyValue struct {
 func (x *ProtoSnapshotBlock_KeyValue) Reset() {
 	*x = ProtoSnapshotBlock_KeyValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[69]
+		mi := &file_protobuf_models_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4469,7 +5272,7 @@ func (x *ProtoSnapshotBlock_KeyValue) String() string {
 func (*ProtoSnapshotBlock_KeyValue) ProtoMessage() {}
 
 func (x *ProtoSnapshotBlock_KeyValue) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[69]
+	mi := &file_protobuf_models_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4482,7 +5285,7 @@ func (x *ProtoSnapshotBlock_KeyValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoSnapshotBlock_KeyValue.ProtoReflect.Descriptor instead.
 func (*ProtoSnapshotBlock_KeyValue) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{17, 0}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{30, 0}
 }
 
 func (x *ProtoSnapshotBlock_KeyValue) GetKey() []byte {
@@ -4514,7 +5317,7 @@ type ProtoSnapshotNodes_Node struct {
 func (x *ProtoSnapshotNodes_Node) Reset() {
 	*x = ProtoSnapshotNodes_Node{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[70]
+		mi := &file_protobuf_models_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4527,7 +5330,7 @@ func (x *ProtoSnapshotNodes_Node) String() string {
 func (*ProtoSnapshotNodes_Node) ProtoMessage() {}
 
 func (x *ProtoSnapshotNodes_Node) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[70]
+	mi := &file_protobuf_models_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4540,7 +5343,7 @@ func (x *ProtoSnapshotNodes_Node) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoSnapshotNodes_Node.ProtoReflect.Descriptor instead.
 func (*ProtoSnapshotNodes_Node) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{18, 0}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{31, 0}
 }
 
 func (x *ProtoSnapshotNodes_Node) GetKey() []byte {
@@ -4591,7 +5394,7 @@ type ProtoGossipBlockRange_Block struct {
 func (x *ProtoGossipBlockRange_Block) Reset() {
 	*x = ProtoGossipBlockRange_Block{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[71]
+		mi := &file_protobuf_models_proto_msgTypes[84]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4604,7 +5407,7 @@ func (x *ProtoGossipBlockRange_Block) String() string {
 func (*ProtoGossipBlockRange_Block) ProtoMessage() {}
 
 func (x *ProtoGossipBlockRange_Block) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[71]
+	mi := &file_protobuf_models_proto_msgTypes[84]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4617,7 +5420,7 @@ func (x *ProtoGossipBlockRange_Block) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoGossipBlockRange_Block.ProtoReflect.Descriptor instead.
 func (*ProtoGossipBlockRange_Block) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{19, 0}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{32, 0}
 }
 
 func (x *ProtoGossipBlockRange_Block) GetHeader() *ProtoBlockHeader {
@@ -4653,7 +5456,7 @@ type ProtoProposeProof_Data struct {
 func (x *ProtoProposeProof_Data) Reset() {
 	*x = ProtoProposeProof_Data{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[72]
+		mi := &file_protobuf_models_proto_msgTypes[85]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4666,7 +5469,7 @@ func (x *ProtoProposeProof_Data) String() string {
 func (*ProtoProposeProof_Data) ProtoMessage() {}
 
 func (x *ProtoProposeProof_Data) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[72]
+	mi := &file_protobuf_models_proto_msgTypes[85]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4679,7 +5482,7 @@ func (x *ProtoProposeProof_Data) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoProposeProof_Data.ProtoReflect.Descriptor instead.
 func (*ProtoProposeProof_Data) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{20, 0}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{33, 0}
 }
 
 func (x *ProtoProposeProof_Data) GetProof() []byte {
@@ -4712,7 +5515,7 @@ type ProtoVote_Data struct {
 func (x *ProtoVote_Data) Reset() {
 	*x = ProtoVote_Data{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[73]
+		mi := &file_protobuf_models_proto_msgTypes[86]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4725,7 +5528,7 @@ func (x *ProtoVote_Data) String() string {
 func (*ProtoVote_Data) ProtoMessage() {}
 
 func (x *ProtoVote_Data) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[73]
+	mi := &file_protobuf_models_proto_msgTypes[86]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4738,7 +5541,7 @@ func (x *ProtoVote_Data) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoVote_Data.ProtoReflect.Descriptor instead.
 func (*ProtoVote_Data) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{21, 0}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{34, 0}
 }
 
 func (x *ProtoVote_Data) GetRound() uint64 {
@@ -4795,7 +5598,7 @@ type ProtoFlipKey_Data struct {
 func (x *ProtoFlipKey_Data) Reset() {
 	*x = ProtoFlipKey_Data{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[74]
+		mi := &file_protobuf_models_proto_msgTypes[87]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4808,7 +5611,7 @@ func (x *ProtoFlipKey_Data) String() string {
 func (*ProtoFlipKey_Data) ProtoMessage() {}
 
 func (x *ProtoFlipKey_Data) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[74]
+	mi := &file_protobuf_models_proto_msgTypes[87]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4821,7 +5624,7 @@ func (x *ProtoFlipKey_Data) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoFlipKey_Data.ProtoReflect.Descriptor instead.
 func (*ProtoFlipKey_Data) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{26, 0}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{39, 0}
 }
 
 func (x *ProtoFlipKey_Data) GetKey() []byte {
@@ -4850,7 +5653,7 @@ type ProtoPrivateFlipKeysPackage_Data struct {
 func (x *ProtoPrivateFlipKeysPackage_Data) Reset() {
 	*x = ProtoPrivateFlipKeysPackage_Data{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[75]
+		mi := &file_protobuf_models_proto_msgTypes[88]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4863,7 +5666,7 @@ func (x *ProtoPrivateFlipKeysPackage_Data) String() string {
 func (*ProtoPrivateFlipKeysPackage_Data) ProtoMessage() {}
 
 func (x *ProtoPrivateFlipKeysPackage_Data) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[75]
+	mi := &file_protobuf_models_proto_msgTypes[88]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4876,7 +5679,7 @@ func (x *ProtoPrivateFlipKeysPackage_Data) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoPrivateFlipKeysPackage_Data.ProtoReflect.Descriptor instead.
 func (*ProtoPrivateFlipKeysPackage_Data) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{28, 0}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{41, 0}
 }
 
 func (x *ProtoPrivateFlipKeysPackage_Data) GetPackage() []byte {
@@ -4905,7 +5708,7 @@ type ProtoAnswersDb_Answer struct {
 func (x *ProtoAnswersDb_Answer) Reset() {
 	*x = ProtoAnswersDb_Answer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[76]
+		mi := &file_protobuf_models_proto_msgTypes[89]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4918,7 +5721,7 @@ func (x *ProtoAnswersDb_Answer) String() string {
 func (*ProtoAnswersDb_Answer) ProtoMessage() {}
 
 func (x *ProtoAnswersDb_Answer) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[76]
+	mi := &file_protobuf_models_proto_msgTypes[89]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4931,7 +5734,7 @@ func (x *ProtoAnswersDb_Answer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoAnswersDb_Answer.ProtoReflect.Descriptor instead.
 func (*ProtoAnswersDb_Answer) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{32, 0}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{45, 0}
 }
 
 func (x *ProtoAnswersDb_Answer) GetAddress() []byte {
@@ -4960,7 +5763,7 @@ type ProtoActivityMonitor_Activity struct {
 func (x *ProtoActivityMonitor_Activity) Reset() {
 	*x = ProtoActivityMonitor_Activity{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[77]
+		mi := &file_protobuf_models_proto_msgTypes[90]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4973,7 +5776,7 @@ func (x *ProtoActivityMonitor_Activity) String() string {
 func (*ProtoActivityMonitor_Activity) ProtoMessage() {}
 
 func (x *ProtoActivityMonitor_Activity) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[77]
+	mi := &file_protobuf_models_proto_msgTypes[90]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4986,7 +5789,7 @@ func (x *ProtoActivityMonitor_Activity) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoActivityMonitor_Activity.ProtoReflect.Descriptor instead.
 func (*ProtoActivityMonitor_Activity) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{35, 0}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{48, 0}
 }
 
 func (x *ProtoActivityMonitor_Activity) GetAddress() []byte {
@@ -5015,7 +5818,7 @@ type ProtoStateAccount_ProtoContractData struct {
 func (x *ProtoStateAccount_ProtoContractData) Reset() {
 	*x = ProtoStateAccount_ProtoContractData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[78]
+		mi := &file_protobuf_models_proto_msgTypes[91]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5028,7 +5831,7 @@ func (x *ProtoStateAccount_ProtoContractData) String() string {
 func (*ProtoStateAccount_ProtoContractData) ProtoMessage() {}
 
 func (x *ProtoStateAccount_ProtoContractData) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[78]
+	mi := &file_protobuf_models_proto_msgTypes[91]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5041,7 +5844,7 @@ func (x *ProtoStateAccount_ProtoContractData) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use ProtoStateAccount_ProtoContractData.ProtoReflect.Descriptor instead.
 func (*ProtoStateAccount_ProtoContractData) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{43, 0}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{56, 0}
 }
 
 func (x *ProtoStateAccount_ProtoContractData) GetCodeHash() []byte {
@@ -5070,7 +5873,7 @@ type ProtoStateIdentity_Flip struct {
 func (x *ProtoStateIdentity_Flip) Reset() {
 	*x = ProtoStateIdentity_Flip{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[79]
+		mi := &file_protobuf_models_proto_msgTypes[92]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5083,7 +5886,7 @@ func (x *ProtoStateIdentity_Flip) String() string {
 func (*ProtoStateIdentity_Flip) ProtoMessage() {}
 
 func (x *ProtoStateIdentity_Flip) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[79]
+	mi := &file_protobuf_models_proto_msgTypes[92]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5096,7 +5899,7 @@ func (x *ProtoStateIdentity_Flip) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoStateIdentity_Flip.ProtoReflect.Descriptor instead.
 func (*ProtoStateIdentity_Flip) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{44, 0}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{57, 0}
 }
 
 func (x *ProtoStateIdentity_Flip) GetCid() []byte {
@@ -5125,7 +5928,7 @@ type ProtoStateIdentity_TxAddr struct {
 func (x *ProtoStateIdentity_TxAddr) Reset() {
 	*x = ProtoStateIdentity_TxAddr{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[80]
+		mi := &file_protobuf_models_proto_msgTypes[93]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5138,7 +5941,7 @@ func (x *ProtoStateIdentity_TxAddr) String() string {
 func (*ProtoStateIdentity_TxAddr) ProtoMessage() {}
 
 func (x *ProtoStateIdentity_TxAddr) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[80]
+	mi := &file_protobuf_models_proto_msgTypes[93]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5151,7 +5954,7 @@ func (x *ProtoStateIdentity_TxAddr) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoStateIdentity_TxAddr.ProtoReflect.Descriptor instead.
 func (*ProtoStateIdentity_TxAddr) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{44, 1}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{57, 1}
 }
 
 func (x *ProtoStateIdentity_TxAddr) GetHash() []byte {
@@ -5181,7 +5984,7 @@ type ProtoStateIdentity_Inviter struct {
 func (x *ProtoStateIdentity_Inviter) Reset() {
 	*x = ProtoStateIdentity_Inviter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[81]
+		mi := &file_protobuf_models_proto_msgTypes[94]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5194,7 +5997,7 @@ func (x *ProtoStateIdentity_Inviter) String() string {
 func (*ProtoStateIdentity_Inviter) ProtoMessage() {}
 
 func (x *ProtoStateIdentity_Inviter) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[81]
+	mi := &file_protobuf_models_proto_msgTypes[94]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5207,7 +6010,7 @@ func (x *ProtoStateIdentity_Inviter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoStateIdentity_Inviter.ProtoReflect.Descriptor instead.
 func (*ProtoStateIdentity_Inviter) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{44, 2}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{57, 2}
 }
 
 func (x *ProtoStateIdentity_Inviter) GetHash() []byte {
@@ -5243,7 +6046,7 @@ type ProtoStateGlobal_EmptyBlocksByShards struct {
 func (x *ProtoStateGlobal_EmptyBlocksByShards) Reset() {
 	*x = ProtoStateGlobal_EmptyBlocksByShards{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[82]
+		mi := &file_protobuf_models_proto_msgTypes[95]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5256,7 +6059,7 @@ func (x *ProtoStateGlobal_EmptyBlocksByShards) String() string {
 func (*ProtoStateGlobal_EmptyBlocksByShards) ProtoMessage() {}
 
 func (x *ProtoStateGlobal_EmptyBlocksByShards) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[82]
+	mi := &file_protobuf_models_proto_msgTypes[95]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5269,7 +6072,7 @@ func (x *ProtoStateGlobal_EmptyBlocksByShards) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use ProtoStateGlobal_EmptyBlocksByShards.ProtoReflect.Descriptor instead.
 func (*ProtoStateGlobal_EmptyBlocksByShards) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{45, 0}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{58, 0}
 }
 
 func (x *ProtoStateGlobal_EmptyBlocksByShards) GetShardId() uint32 {
@@ -5298,7 +6101,7 @@ type ProtoStateGlobal_ShardSize struct {
 func (x *ProtoStateGlobal_ShardSize) Reset() {
 	*x = ProtoStateGlobal_ShardSize{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[83]
+		mi := &file_protobuf_models_proto_msgTypes[96]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5311,7 +6114,7 @@ func (x *ProtoStateGlobal_ShardSize) String() string {
 func (*ProtoStateGlobal_ShardSize) ProtoMessage() {}
 
 func (x *ProtoStateGlobal_ShardSize) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[83]
+	mi := &file_protobuf_models_proto_msgTypes[96]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5324,7 +6127,7 @@ func (x *ProtoStateGlobal_ShardSize) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoStateGlobal_ShardSize.ProtoReflect.Descriptor instead.
 func (*ProtoStateGlobal_ShardSize) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{45, 1}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{58, 1}
 }
 
 func (x *ProtoStateGlobal_ShardSize) GetShardId() uint32 {
@@ -5353,7 +6156,7 @@ type ProtoStateDelegationSwitch_Delegation struct {
 func (x *ProtoStateDelegationSwitch_Delegation) Reset() {
 	*x = ProtoStateDelegationSwitch_Delegation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[84]
+		mi := &file_protobuf_models_proto_msgTypes[97]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5366,7 +6169,7 @@ func (x *ProtoStateDelegationSwitch_Delegation) String() string {
 func (*ProtoStateDelegationSwitch_Delegation) ProtoMessage() {}
 
 func (x *ProtoStateDelegationSwitch_Delegation) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[84]
+	mi := &file_protobuf_models_proto_msgTypes[97]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5379,7 +6182,7 @@ func (x *ProtoStateDelegationSwitch_Delegation) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use ProtoStateDelegationSwitch_Delegation.ProtoReflect.Descriptor instead.
 func (*ProtoStateDelegationSwitch_Delegation) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{48, 0}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{61, 0}
 }
 
 func (x *ProtoStateDelegationSwitch_Delegation) GetDelegator() []byte {
@@ -5409,7 +6212,7 @@ type ProtoStateBurntCoins_Item struct {
 func (x *ProtoStateBurntCoins_Item) Reset() {
 	*x = ProtoStateBurntCoins_Item{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[85]
+		mi := &file_protobuf_models_proto_msgTypes[98]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5422,7 +6225,7 @@ func (x *ProtoStateBurntCoins_Item) String() string {
 func (*ProtoStateBurntCoins_Item) ProtoMessage() {}
 
 func (x *ProtoStateBurntCoins_Item) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[85]
+	mi := &file_protobuf_models_proto_msgTypes[98]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5435,7 +6238,7 @@ func (x *ProtoStateBurntCoins_Item) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoStateBurntCoins_Item.ProtoReflect.Descriptor instead.
 func (*ProtoStateBurntCoins_Item) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{50, 0}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{63, 0}
 }
 
 func (x *ProtoStateBurntCoins_Item) GetAddress() []byte {
@@ -5483,7 +6286,7 @@ type ProtoPredefinedState_Global struct {
 func (x *ProtoPredefinedState_Global) Reset() {
 	*x = ProtoPredefinedState_Global{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[86]
+		mi := &file_protobuf_models_proto_msgTypes[99]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5496,7 +6299,7 @@ func (x *ProtoPredefinedState_Global) String() string {
 func (*ProtoPredefinedState_Global) ProtoMessage() {}
 
 func (x *ProtoPredefinedState_Global) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[86]
+	mi := &file_protobuf_models_proto_msgTypes[99]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5509,7 +6312,7 @@ func (x *ProtoPredefinedState_Global) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoPredefinedState_Global.ProtoReflect.Descriptor instead.
 func (*ProtoPredefinedState_Global) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{51, 0}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{64, 0}
 }
 
 func (x *ProtoPredefinedState_Global) GetEpoch() uint32 {
@@ -5621,7 +6424,7 @@ type ProtoPredefinedState_StatusSwitch struct {
 func (x *ProtoPredefinedState_StatusSwitch) Reset() {
 	*x = ProtoPredefinedState_StatusSwitch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[87]
+		mi := &file_protobuf_models_proto_msgTypes[100]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5634,7 +6437,7 @@ func (x *ProtoPredefinedState_StatusSwitch) String() string {
 func (*ProtoPredefinedState_StatusSwitch) ProtoMessage() {}
 
 func (x *ProtoPredefinedState_StatusSwitch) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[87]
+	mi := &file_protobuf_models_proto_msgTypes[100]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5647,7 +6450,7 @@ func (x *ProtoPredefinedState_StatusSwitch) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ProtoPredefinedState_StatusSwitch.ProtoReflect.Descriptor instead.
 func (*ProtoPredefinedState_StatusSwitch) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{51, 1}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{64, 1}
 }
 
 func (x *ProtoPredefinedState_StatusSwitch) GetAddresses() [][]byte {
@@ -5672,7 +6475,7 @@ type ProtoPredefinedState_Account struct {
 func (x *ProtoPredefinedState_Account) Reset() {
 	*x = ProtoPredefinedState_Account{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[88]
+		mi := &file_protobuf_models_proto_msgTypes[101]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5685,7 +6488,7 @@ func (x *ProtoPredefinedState_Account) String() string {
 func (*ProtoPredefinedState_Account) ProtoMessage() {}
 
 func (x *ProtoPredefinedState_Account) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[88]
+	mi := &file_protobuf_models_proto_msgTypes[101]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5698,7 +6501,7 @@ func (x *ProtoPredefinedState_Account) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoPredefinedState_Account.ProtoReflect.Descriptor instead.
 func (*ProtoPredefinedState_Account) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{51, 2}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{64, 2}
 }
 
 func (x *ProtoPredefinedState_Account) GetAddress() []byte {
@@ -5774,7 +6577,7 @@ type ProtoPredefinedState_Identity struct {
 func (x *ProtoPredefinedState_Identity) Reset() {
 	*x = ProtoPredefinedState_Identity{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[89]
+		mi := &file_protobuf_models_proto_msgTypes[102]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5787,7 +6590,7 @@ func (x *ProtoPredefinedState_Identity) String() string {
 func (*ProtoPredefinedState_Identity) ProtoMessage() {}
 
 func (x *ProtoPredefinedState_Identity) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[89]
+	mi := &file_protobuf_models_proto_msgTypes[102]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5800,7 +6603,7 @@ func (x *ProtoPredefinedState_Identity) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoPredefinedState_Identity.ProtoReflect.Descriptor instead.
 func (*ProtoPredefinedState_Identity) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{51, 3}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{64, 3}
 }
 
 func (x *ProtoPredefinedState_Identity) GetAddress() []byte {
@@ -6014,7 +6817,7 @@ type ProtoPredefinedState_ApprovedIdentity struct {
 func (x *ProtoPredefinedState_ApprovedIdentity) Reset() {
 	*x = ProtoPredefinedState_ApprovedIdentity{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[90]
+		mi := &file_protobuf_models_proto_msgTypes[103]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6027,7 +6830,7 @@ func (x *ProtoPredefinedState_ApprovedIdentity) String() string {
 func (*ProtoPredefinedState_ApprovedIdentity) ProtoMessage() {}
 
 func (x *ProtoPredefinedState_ApprovedIdentity) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[90]
+	mi := &file_protobuf_models_proto_msgTypes[103]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6040,7 +6843,7 @@ func (x *ProtoPredefinedState_ApprovedIdentity) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use ProtoPredefinedState_ApprovedIdentity.ProtoReflect.Descriptor instead.
 func (*ProtoPredefinedState_ApprovedIdentity) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{51, 4}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{64, 4}
 }
 
 func (x *ProtoPredefinedState_ApprovedIdentity) GetAddress() []byte {
@@ -6090,7 +6893,7 @@ type ProtoPredefinedState_ContractKeyValue struct {
 func (x *ProtoPredefinedState_ContractKeyValue) Reset() {
 	*x = ProtoPredefinedState_ContractKeyValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[91]
+		mi := &file_protobuf_models_proto_msgTypes[104]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6103,7 +6906,7 @@ func (x *ProtoPredefinedState_ContractKeyValue) String() string {
 func (*ProtoPredefinedState_ContractKeyValue) ProtoMessage() {}
 
 func (x *ProtoPredefinedState_ContractKeyValue) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[91]
+	mi := &file_protobuf_models_proto_msgTypes[104]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6116,7 +6919,7 @@ func (x *ProtoPredefinedState_ContractKeyValue) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use ProtoPredefinedState_ContractKeyValue.ProtoReflect.Descriptor instead.
 func (*ProtoPredefinedState_ContractKeyValue) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{51, 5}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{64, 5}
 }
 
 func (x *ProtoPredefinedState_ContractKeyValue) GetKey() []byte {
@@ -6145,7 +6948,7 @@ type ProtoPredefinedState_Account_ContractData struct {
 func (x *ProtoPredefinedState_Account_ContractData) Reset() {
 	*x = ProtoPredefinedState_Account_ContractData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[92]
+		mi := &file_protobuf_models_proto_msgTypes[105]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6158,7 +6961,7 @@ func (x *ProtoPredefinedState_Account_ContractData) String() string {
 func (*ProtoPredefinedState_Account_ContractData) ProtoMessage() {}
 
 func (x *ProtoPredefinedState_Account_ContractData) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[92]
+	mi := &file_protobuf_models_proto_msgTypes[105]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6171,7 +6974,7 @@ func (x *ProtoPredefinedState_Account_ContractData) ProtoReflect() protoreflect.
 
 // Deprecated: Use ProtoPredefinedState_Account_ContractData.ProtoReflect.Descriptor instead.
 func (*ProtoPredefinedState_Account_ContractData) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{51, 2, 0}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{64, 2, 0}
 }
 
 func (x *ProtoPredefinedState_Account_ContractData) GetCodeHash() []byte {
@@ -6200,7 +7003,7 @@ type ProtoPredefinedState_Identity_Flip struct {
 func (x *ProtoPredefinedState_Identity_Flip) Reset() {
 	*x = ProtoPredefinedState_Identity_Flip{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[93]
+		mi := &file_protobuf_models_proto_msgTypes[106]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6213,7 +7016,7 @@ func (x *ProtoPredefinedState_Identity_Flip) String() string {
 func (*ProtoPredefinedState_Identity_Flip) ProtoMessage() {}
 
 func (x *ProtoPredefinedState_Identity_Flip) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[93]
+	mi := &file_protobuf_models_proto_msgTypes[106]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6226,7 +7029,7 @@ func (x *ProtoPredefinedState_Identity_Flip) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ProtoPredefinedState_Identity_Flip.ProtoReflect.Descriptor instead.
 func (*ProtoPredefinedState_Identity_Flip) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{51, 3, 0}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{64, 3, 0}
 }
 
 func (x *ProtoPredefinedState_Identity_Flip) GetCid() []byte {
@@ -6255,7 +7058,7 @@ type ProtoPredefinedState_Identity_TxAddr struct {
 func (x *ProtoPredefinedState_Identity_TxAddr) Reset() {
 	*x = ProtoPredefinedState_Identity_TxAddr{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[94]
+		mi := &file_protobuf_models_proto_msgTypes[107]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6268,7 +7071,7 @@ func (x *ProtoPredefinedState_Identity_TxAddr) String() string {
 func (*ProtoPredefinedState_Identity_TxAddr) ProtoMessage() {}
 
 func (x *ProtoPredefinedState_Identity_TxAddr) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[94]
+	mi := &file_protobuf_models_proto_msgTypes[107]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6281,7 +7084,7 @@ func (x *ProtoPredefinedState_Identity_TxAddr) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use ProtoPredefinedState_Identity_TxAddr.ProtoReflect.Descriptor instead.
 func (*ProtoPredefinedState_Identity_TxAddr) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{51, 3, 1}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{64, 3, 1}
 }
 
 func (x *ProtoPredefinedState_Identity_TxAddr) GetHash() []byte {
@@ -6311,7 +7114,7 @@ type ProtoPredefinedState_Identity_Inviter struct {
 func (x *ProtoPredefinedState_Identity_Inviter) Reset() {
 	*x = ProtoPredefinedState_Identity_Inviter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[95]
+		mi := &file_protobuf_models_proto_msgTypes[108]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6324,7 +7127,7 @@ func (x *ProtoPredefinedState_Identity_Inviter) String() string {
 func (*ProtoPredefinedState_Identity_Inviter) ProtoMessage() {}
 
 func (x *ProtoPredefinedState_Identity_Inviter) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[95]
+	mi := &file_protobuf_models_proto_msgTypes[108]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6337,7 +7140,7 @@ func (x *ProtoPredefinedState_Identity_Inviter) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use ProtoPredefinedState_Identity_Inviter.ProtoReflect.Descriptor instead.
 func (*ProtoPredefinedState_Identity_Inviter) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{51, 3, 2}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{64, 3, 2}
 }
 
 func (x *ProtoPredefinedState_Identity_Inviter) GetHash() []byte {
@@ -6381,7 +7184,7 @@ type ProtoTxReceipts_ProtoTxReceipt struct {
 func (x *ProtoTxReceipts_ProtoTxReceipt) Reset() {
 	*x = ProtoTxReceipts_ProtoTxReceipt{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[96]
+		mi := &file_protobuf_models_proto_msgTypes[109]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6394,7 +7197,7 @@ func (x *ProtoTxReceipts_ProtoTxReceipt) String() string {
 func (*ProtoTxReceipts_ProtoTxReceipt) ProtoMessage() {}
 
 func (x *ProtoTxReceipts_ProtoTxReceipt) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[96]
+	mi := &file_protobuf_models_proto_msgTypes[109]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6407,7 +7210,7 @@ func (x *ProtoTxReceipts_ProtoTxReceipt) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoTxReceipts_ProtoTxReceipt.ProtoReflect.Descriptor instead.
 func (*ProtoTxReceipts_ProtoTxReceipt) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{56, 0}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{69, 0}
 }
 
 func (x *ProtoTxReceipts_ProtoTxReceipt) GetContract() []byte {
@@ -6493,7 +7296,7 @@ type ProtoTxReceipts_ProtoEvent struct {
 func (x *ProtoTxReceipts_ProtoEvent) Reset() {
 	*x = ProtoTxReceipts_ProtoEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[97]
+		mi := &file_protobuf_models_proto_msgTypes[110]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6506,7 +7309,7 @@ func (x *ProtoTxReceipts_ProtoEvent) String() string {
 func (*ProtoTxReceipts_ProtoEvent) ProtoMessage() {}
 
 func (x *ProtoTxReceipts_ProtoEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[97]
+	mi := &file_protobuf_models_proto_msgTypes[110]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6519,7 +7322,7 @@ func (x *ProtoTxReceipts_ProtoEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoTxReceipts_ProtoEvent.ProtoReflect.Descriptor instead.
 func (*ProtoTxReceipts_ProtoEvent) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{56, 1}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{69, 1}
 }
 
 func (x *ProtoTxReceipts_ProtoEvent) GetEvent() string {
@@ -6559,7 +7362,7 @@ type ProtoDeferredTxs_ProtoDeferredTx struct {
 func (x *ProtoDeferredTxs_ProtoDeferredTx) Reset() {
 	*x = ProtoDeferredTxs_ProtoDeferredTx{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[98]
+		mi := &file_protobuf_models_proto_msgTypes[111]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6572,7 +7375,7 @@ func (x *ProtoDeferredTxs_ProtoDeferredTx) String() string {
 func (*ProtoDeferredTxs_ProtoDeferredTx) ProtoMessage() {}
 
 func (x *ProtoDeferredTxs_ProtoDeferredTx) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[98]
+	mi := &file_protobuf_models_proto_msgTypes[111]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6585,7 +7388,7 @@ func (x *ProtoDeferredTxs_ProtoDeferredTx) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoDeferredTxs_ProtoDeferredTx.ProtoReflect.Descriptor instead.
 func (*ProtoDeferredTxs_ProtoDeferredTx) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{58, 0}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{71, 0}
 }
 
 func (x *ProtoDeferredTxs_ProtoDeferredTx) GetFrom() []byte {
@@ -6642,7 +7445,7 @@ type ProtoUpgradeVotes_ProtoUpgradeVote struct {
 func (x *ProtoUpgradeVotes_ProtoUpgradeVote) Reset() {
 	*x = ProtoUpgradeVotes_ProtoUpgradeVote{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[99]
+		mi := &file_protobuf_models_proto_msgTypes[112]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6655,7 +7458,7 @@ func (x *ProtoUpgradeVotes_ProtoUpgradeVote) String() string {
 func (*ProtoUpgradeVotes_ProtoUpgradeVote) ProtoMessage() {}
 
 func (x *ProtoUpgradeVotes_ProtoUpgradeVote) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[99]
+	mi := &file_protobuf_models_proto_msgTypes[112]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6668,7 +7471,7 @@ func (x *ProtoUpgradeVotes_ProtoUpgradeVote) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ProtoUpgradeVotes_ProtoUpgradeVote.ProtoReflect.Descriptor instead.
 func (*ProtoUpgradeVotes_ProtoUpgradeVote) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{60, 0}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{73, 0}
 }
 
 func (x *ProtoUpgradeVotes_ProtoUpgradeVote) GetVoter() []byte {
@@ -6701,7 +7504,7 @@ type ProtoLotteryIdentitiesDb_Identity struct {
 func (x *ProtoLotteryIdentitiesDb_Identity) Reset() {
 	*x = ProtoLotteryIdentitiesDb_Identity{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[100]
+		mi := &file_protobuf_models_proto_msgTypes[113]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6714,7 +7517,7 @@ func (x *ProtoLotteryIdentitiesDb_Identity) String() string {
 func (*ProtoLotteryIdentitiesDb_Identity) ProtoMessage() {}
 
 func (x *ProtoLotteryIdentitiesDb_Identity) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[100]
+	mi := &file_protobuf_models_proto_msgTypes[113]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6727,7 +7530,7 @@ func (x *ProtoLotteryIdentitiesDb_Identity) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ProtoLotteryIdentitiesDb_Identity.ProtoReflect.Descriptor instead.
 func (*ProtoLotteryIdentitiesDb_Identity) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{61, 0}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{74, 0}
 }
 
 func (x *ProtoLotteryIdentitiesDb_Identity) GetAddress() []byte {
@@ -6777,14 +7580,14 @@ var File_protobuf_models_proto protoreflect.FileDescriptor
 var file_protobuf_models_proto_rawDesc = []byte{
 	0x0a, 0x15, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
 	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x22,
-	0xb2, 0x02, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0xcc, 0x02, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
 	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74,
 	0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74,
 	0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
 	0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
 	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x52, 0x6c, 0x70, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x73, 0x65, 0x52, 0x6c, 0x70, 0x1a, 0xb4, 0x01,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x73, 0x65, 0x52, 0x6c, 0x70, 0x1a, 0xce, 0x01,
 	0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05,
 	0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x70, 0x6f,
@@ -6796,837 +7599,912 @@ var file_protobuf_models_proto_rawDesc = []byte{
 	0x6d, 0x61, 0x78, 0x46, 0x65, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x70, 0x73, 0x18, 0x07,
 	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x74, 0x69, 0x70, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61,
 	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79,
-	0x6c, 0x6f, 0x61, 0x64, 0x22, 0xca, 0x06, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x49, 0x0a, 0x0e, 0x70, 0x72, 0x6f,
-	0x70, 0x6f, 0x73, 0x65, 0x64, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x70,
-	0x6f, 0x73, 0x65, 0x64, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x48, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x0b, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x22, 0xca,
+	0x06, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x12, 0x49, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x52, 0x0e,
+	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x40,
+	0x0a, 0x0b, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x52, 0x0b, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x1a, 0xdc, 0x03, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x12, 0x1e, 0x0a,
+	0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x26, 0x0a, 0x0e, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62,
+	0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x52, 0x6f, 0x6f, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66,
+	0x6c, 0x61, 0x67, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x70, 0x66, 0x73, 0x48, 0x61, 0x73, 0x68, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x08, 0x69, 0x70, 0x66, 0x73, 0x48, 0x61, 0x73, 0x68, 0x12, 0x20, 0x0a,
+	0x0b, 0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x41, 0x64, 0x64, 0x72, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0b, 0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x41, 0x64, 0x64, 0x72, 0x12,
+	0x18, 0x0a, 0x07, 0x74, 0x78, 0x42, 0x6c, 0x6f, 0x6f, 0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x74, 0x78, 0x42, 0x6c, 0x6f, 0x6f, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x53, 0x65, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x53, 0x65, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x50, 0x65,
+	0x72, 0x47, 0x61, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x66, 0x65, 0x65, 0x50,
+	0x65, 0x72, 0x47, 0x61, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x65, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x73, 0x65, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x20, 0x0a,
+	0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x43, 0x69, 0x64, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x43, 0x69, 0x64, 0x1a,
+	0xc9, 0x01, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x52, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x53, 0x65, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x53, 0x65, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x4e, 0x0a, 0x0e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x3c, 0x0a,
+	0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x6a, 0x0a, 0x0a, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
 	0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x0b, 0x65, 0x6d, 0x70, 0x74, 0x79,
-	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x1a, 0xdc, 0x03, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x70, 0x6f,
-	0x73, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73,
-	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x48,
-	0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x78, 0x48,
-	0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73,
-	0x68, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62,
-	0x4b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x70, 0x6f,
-	0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f,
-	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x22, 0x0a,
-	0x0c, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x6f, 0x6f, 0x74, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x0c, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x6f, 0x6f,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x70, 0x66, 0x73, 0x48,
-	0x61, 0x73, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x69, 0x70, 0x66, 0x73, 0x48,
-	0x61, 0x73, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x41, 0x64,
-	0x64, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e,
-	0x65, 0x41, 0x64, 0x64, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x78, 0x42, 0x6c, 0x6f, 0x6f, 0x6d,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x78, 0x42, 0x6c, 0x6f, 0x6f, 0x6d, 0x12,
-	0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x65, 0x64, 0x12, 0x1c, 0x0a,
-	0x09, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x09, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x75,
-	0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x75, 0x70,
-	0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x65, 0x64, 0x50, 0x72, 0x6f,
-	0x6f, 0x66, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x65, 0x65, 0x64, 0x50, 0x72,
-	0x6f, 0x6f, 0x66, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x43,
-	0x69, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70,
-	0x74, 0x73, 0x43, 0x69, 0x64, 0x1a, 0xc9, 0x01, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
-	0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12,
-	0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x69,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0c, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x6f, 0x6f, 0x74, 0x12,
-	0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1c, 0x0a,
-	0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66,
-	0x6c, 0x61, 0x67, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67,
-	0x73, 0x22, 0x4e, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42,
-	0x6f, 0x64, 0x79, 0x12, 0x3c, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x22, 0x6a, 0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
-	0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x12, 0x2a, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0xe3, 0x01,
-	0x0a, 0x12, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x70,
-	0x6f, 0x73, 0x61, 0x6c, 0x12, 0x33, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x04, 0x62,
+	0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x6f, 0x64,
+	0x79, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0xe3, 0x01, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x33,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x1a, 0x7a, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x04, 0x62,
+	0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x6f, 0x64,
+	0x79, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0x69, 0x0a,
+	0x0d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x49, 0x70, 0x66, 0x73, 0x46, 0x6c, 0x69, 0x70, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06,
+	0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x50, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x50, 0x61, 0x72, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x50, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x70, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x22, 0x81, 0x02, 0x0a, 0x0e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x65, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x04, 0x73, 0x74, 0x65, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x6f, 0x74, 0x65, 0x64, 0x48, 0x61,
+	0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x76, 0x6f, 0x74, 0x65, 0x64, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x40, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x65, 0x72, 0x74, 0x2e,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x1a, 0x65, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x75, 0x72, 0x6e, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x74, 0x75, 0x72, 0x6e, 0x4f, 0x66, 0x66,
+	0x6c, 0x69, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x2f, 0x0a, 0x15,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x57, 0x65, 0x61, 0x6b, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0x47, 0x0a,
+	0x15, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x61, 0x73, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x03, 0x69, 0x64, 0x78, 0x22, 0x2a, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x46,
+	0x6c, 0x69, 0x70, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x6b, 0x65,
+	0x79, 0x73, 0x22, 0x3e, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x69, 0x6e,
+	0x66, 0x6f, 0x22, 0x8e, 0x03, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x48, 0x61, 0x6e, 0x64,
+	0x73, 0x68, 0x61, 0x6b, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x67,
+	0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x67, 0x65,
+	0x6e, 0x65, 0x73, 0x69, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x6c, 0x64,
+	0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6f,
+	0x6c, 0x64, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x68, 0x61,
+	0x72, 0x64, 0x49, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x68, 0x61, 0x72,
+	0x64, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x73, 0x4c, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x73,
+	0x4c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x65, 0x61, 0x64, 0x48, 0x61, 0x73,
+	0x68, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x68, 0x65, 0x61, 0x64, 0x48, 0x61, 0x73,
+	0x68, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x73,
+	0x18, 0x0d, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x41, 0x64,
+	0x64, 0x72, 0x73, 0x22, 0x52, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x4d, 0x73, 0x67, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x68, 0x61, 0x72, 0x64, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x73, 0x68, 0x61, 0x72, 0x64, 0x49, 0x64, 0x22, 0x2e, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x68, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x68, 0x61, 0x72, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x73, 0x68, 0x61, 0x72, 0x64, 0x49, 0x64, 0x22, 0x85, 0x01, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x4d, 0x73, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x33, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x4d, 0x73, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x3f,
+	0x0a, 0x09, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x68, 0x61, 0x72, 0x64, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x68, 0x61, 0x72, 0x64, 0x49, 0x64, 0x22,
+	0x29, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x45, 0x0a, 0x1b, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x61, 0x73,
+	0x68, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65,
+	0x73, 0x22, 0x61, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3c, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x33, 0x0a, 0x21, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x47, 0x65, 0x74,
+	0x50, 0x6f, 0x6f, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x41, 0x0a, 0x17, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x6f, 0x6f, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0x45, 0x0a, 0x19,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x72,
+	0x6f, 0x6f, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x6b, 0x0a, 0x1b, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x22, 0x57, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x32, 0x0a, 0x07, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x22,
+	0x21, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05,
+	0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e,
+	0x63, 0x65, 0x22, 0x41, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x65, 0x61,
+	0x64, 0x48, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x68, 0x65, 0x61,
+	0x64, 0x48, 0x61, 0x73, 0x68, 0x22, 0x37, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x47, 0x65,
+	0x74, 0x46, 0x6c, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03,
+	0x63, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63, 0x69, 0x64, 0x22, 0x49,
+	0x0a, 0x11, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x46, 0x6c, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x3f, 0x0a, 0x11, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x4e, 0x65, 0x77, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x12,
+	0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61,
+	0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xcb, 0x01, 0x0a, 0x16, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x44, 0x69, 0x66, 0x66, 0x12, 0x4d, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x44, 0x69, 0x66, 0x66, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x44, 0x69, 0x66, 0x66, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x1a, 0x62, 0x0a, 0x16, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x69, 0x66, 0x66, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x81, 0x01, 0x0a, 0x12, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
+	0x37, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x32, 0x0a, 0x08, 0x4b, 0x65, 0x79, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xce, 0x01, 0x0a,
+	0x12, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4e, 0x6f,
+	0x64, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
 	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x2e, 0x44,
-	0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x1a, 0x7a, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12,
-	0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x12, 0x2a, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x70, 0x72,
-	0x6f, 0x6f, 0x66, 0x22, 0x69, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x49, 0x70, 0x66, 0x73,
-	0x46, 0x6c, 0x69, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x1e, 0x0a, 0x0a,
-	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x61, 0x72, 0x74, 0x12, 0x20, 0x0a, 0x0b,
-	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x22, 0x81,
-	0x02, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x65, 0x72,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x76,
-	0x6f, 0x74, 0x65, 0x64, 0x48, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
-	0x76, 0x6f, 0x74, 0x65, 0x64, 0x48, 0x61, 0x73, 0x68, 0x12, 0x40, 0x0a, 0x0a, 0x73, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x43, 0x65, 0x72, 0x74, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52,
-	0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x1a, 0x65, 0x0a, 0x09, 0x53,
-	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x75, 0x72, 0x6e,
-	0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x74,
-	0x75, 0x72, 0x6e, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70,
-	0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x75, 0x70, 0x67,
-	0x72, 0x61, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
-	0x72, 0x65, 0x22, 0x2f, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x57, 0x65, 0x61, 0x6b, 0x43,
-	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68,
-	0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x68, 0x61, 0x73,
-	0x68, 0x65, 0x73, 0x22, 0x47, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1c, 0x0a, 0x09,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64,
-	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x69, 0x64, 0x78, 0x22, 0x2a, 0x0a, 0x14,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x46, 0x6c, 0x69, 0x70, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
-	0x4b, 0x65, 0x79, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0c, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x3e, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0xee, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
-	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x07, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x70, 0x70,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61,
-	0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x65, 0x65,
-	0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x12,
-	0x1e, 0x0a, 0x0a, 0x6f, 0x6c, 0x64, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6f, 0x6c, 0x64, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x12,
-	0x18, 0x0a, 0x07, 0x73, 0x68, 0x61, 0x72, 0x64, 0x49, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x07, 0x73, 0x68, 0x61, 0x72, 0x64, 0x49, 0x64, 0x22, 0x52, 0x0a, 0x08, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x4d, 0x73, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79,
-	0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c,
-	0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x68, 0x61, 0x72, 0x64, 0x49, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x68, 0x61, 0x72, 0x64, 0x49, 0x64, 0x22, 0x2e, 0x0a,
-	0x12, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x68, 0x61, 0x72,
-	0x64, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x68, 0x61, 0x72, 0x64, 0x49, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x68, 0x61, 0x72, 0x64, 0x49, 0x64, 0x22, 0x85, 0x01,
-	0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x4d, 0x73, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12,
-	0x33, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x4d, 0x73, 0x67, 0x42,
-	0x61, 0x74, 0x63, 0x68, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x1a, 0x3f, 0x0a, 0x09, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x74, 0x65,
-	0x6d, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73,
-	0x68, 0x61, 0x72, 0x64, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x68,
-	0x61, 0x72, 0x64, 0x49, 0x64, 0x22, 0x29, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x44, 0x69,
-	0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x61, 0x73,
-	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
-	0x22, 0xcb, 0x01, 0x0a, 0x16, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x69, 0x66, 0x66, 0x12, 0x4d, 0x0a, 0x06, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x69, 0x66, 0x66, 0x2e, 0x49, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x69, 0x66, 0x66, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x62, 0x0a, 0x16, 0x49, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x69, 0x66, 0x66, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18,
-	0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x81,
-	0x01, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x37, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x2e,
-	0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x32,
-	0x0a, 0x08, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x22, 0xce, 0x01, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x6e, 0x61, 0x70,
-	0x73, 0x68, 0x6f, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x05, 0x6e, 0x6f, 0x64,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4e,
-	0x6f, 0x64, 0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73,
-	0x1a, 0x80, 0x01, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x68,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x22, 0x8a, 0x02, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x47, 0x6f, 0x73,
-	0x73, 0x69, 0x70, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
-	0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
-	0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x06, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x73, 0x1a, 0x99, 0x01, 0x0a, 0x05, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x30,
-	0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
-	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x12, 0x2a, 0x0a, 0x04, 0x63, 0x65, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
-	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x43, 0x65, 0x72, 0x74, 0x52, 0x04, 0x63, 0x65, 0x72, 0x74, 0x12, 0x32, 0x0a, 0x04,
-	0x64, 0x69, 0x66, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x6f, 0x64,
-	0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66,
-	0x22, 0x99, 0x01, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
-	0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x32, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x2e,
-	0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73,
-	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x1a, 0x32, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61,
-	0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x82, 0x02, 0x0a,
-	0x09, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x56, 0x6f, 0x74, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61,
-	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x1a, 0xaa, 0x01, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a,
-	0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x72, 0x6f,
-	0x75, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e,
-	0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x61, 0x72,
-	0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x6f, 0x74, 0x65, 0x64,
-	0x48, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x76, 0x6f, 0x74, 0x65,
-	0x64, 0x48, 0x61, 0x73, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x75, 0x72, 0x6e, 0x4f, 0x66, 0x66,
-	0x6c, 0x69, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x74, 0x75, 0x72, 0x6e,
-	0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x67, 0x72, 0x61,
-	0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64,
-	0x65, 0x22, 0x30, 0x0a, 0x1a, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x42, 0x79, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68,
-	0x61, 0x73, 0x68, 0x22, 0x5a, 0x0a, 0x1a, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x47, 0x65, 0x74, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66,
-	0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12,
-	0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x74, 0x6f, 0x22,
-	0x51, 0x0a, 0x1d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x6b, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x73, 0x22, 0x89, 0x01, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x46, 0x6c, 0x69, 0x70,
-	0x12, 0x3a, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a,
-	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x61, 0x72, 0x74, 0x12, 0x20, 0x0a, 0x0b,
-	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x22, 0x8b,
-	0x01, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x46, 0x6c, 0x69, 0x70, 0x4b, 0x65, 0x79, 0x12,
-	0x2d, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x46, 0x6c, 0x69, 0x70,
-	0x4b, 0x65, 0x79, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c,
-	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x1a, 0x2e, 0x0a, 0x04,
-	0x44, 0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x22, 0x51, 0x0a, 0x0d,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
-	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x69, 0x64,
-	0x56, 0x32, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x69, 0x64, 0x56, 0x32, 0x22,
-	0xb1, 0x01, 0x0a, 0x1b, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
-	0x46, 0x6c, 0x69, 0x70, 0x4b, 0x65, 0x79, 0x73, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12,
-	0x3c, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x69, 0x76,
-	0x61, 0x74, 0x65, 0x46, 0x6c, 0x69, 0x70, 0x4b, 0x65, 0x79, 0x73, 0x50, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a,
-	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x1a, 0x36, 0x0a, 0x04, 0x44,
-	0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x70,
-	0x6f, 0x63, 0x68, 0x22, 0x3b, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x75, 0x6c, 0x6c,
-	0x50, 0x75, 0x73, 0x68, 0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68,
-	0x22, 0x7b, 0x0a, 0x17, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
-	0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x44, 0x62, 0x12, 0x16, 0x0a, 0x06, 0x68,
+	0x6f, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x2e, 0x4e,
+	0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x1a, 0x80, 0x01, 0x0a, 0x04, 0x4e,
+	0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x9e, 0x02,
+	0x0a, 0x15, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49,
+	0x64, 0x12, 0x3b, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x6d, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6d, 0x6f,
+	0x72, 0x65, 0x1a, 0x99, 0x01, 0x0a, 0x05, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x30, 0x0a, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2a,
+	0x0a, 0x04, 0x63, 0x65, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x43, 0x65, 0x72, 0x74, 0x52, 0x04, 0x63, 0x65, 0x72, 0x74, 0x12, 0x32, 0x0a, 0x04, 0x64, 0x69,
+	0x66, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x22, 0x99,
+	0x01, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x12, 0x32, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x2e, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x1a, 0x32, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x70,
+	0x72, 0x6f, 0x6f, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x82, 0x02, 0x0a, 0x09, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x56, 0x6f, 0x74, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x1a, 0xaa, 0x01, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x04, 0x73, 0x74, 0x65, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x48,
+	0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x6f, 0x74, 0x65, 0x64, 0x48, 0x61,
+	0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x76, 0x6f, 0x74, 0x65, 0x64, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x75, 0x72, 0x6e, 0x4f, 0x66, 0x66, 0x6c, 0x69,
+	0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x74, 0x75, 0x72, 0x6e, 0x4f, 0x66,
+	0x66, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x22,
+	0x30, 0x0a, 0x1a, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x42, 0x79, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x22, 0x5a, 0x0a, 0x1a, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a,
+	0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x51, 0x0a,
+	0x1d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x6b, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x22, 0x89, 0x01, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x46, 0x6c, 0x69, 0x70, 0x12, 0x3a,
+	0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x50, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x61, 0x72, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x22, 0x8b, 0x01, 0x0a,
+	0x0c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x46, 0x6c, 0x69, 0x70, 0x4b, 0x65, 0x79, 0x12, 0x2d, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x46, 0x6c, 0x69, 0x70, 0x4b, 0x65,
+	0x79, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x1a, 0x2e, 0x0a, 0x04, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x22, 0x51, 0x0a, 0x0d, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68,
 	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
 	0x0c, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x69, 0x64, 0x56, 0x32,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x69, 0x64, 0x56, 0x32, 0x12, 0x1e, 0x0a,
-	0x0a, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x56, 0x32, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x56, 0x32, 0x22, 0x46, 0x0a,
-	0x12, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65,
-	0x72, 0x44, 0x62, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x87, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x41,
-	0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x44, 0x62, 0x12, 0x37, 0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77,
-	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x44,
-	0x62, 0x2e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72,
-	0x73, 0x1a, 0x3c, 0x0a, 0x06, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x22,
-	0x55, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x75, 0x72, 0x6e, 0x74, 0x43, 0x6f, 0x69,
-	0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x16,
-	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x9b, 0x01, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x53, 0x61, 0x76, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x28, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d,
-	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x02, 0x74, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x65,
-	0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x66,
-	0x65, 0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x48, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x22, 0xbf, 0x01, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x41, 0x63,
-	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x1c, 0x0a,
-	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x45, 0x0a, 0x0a, 0x61,
-	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x25, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x41, 0x63,
-	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x41, 0x63,
-	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69,
-	0x65, 0x73, 0x1a, 0x42, 0x0a, 0x08, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x18,
-	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x68, 0x0a, 0x1a, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53,
-	0x68, 0x6f, 0x72, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
-	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x12, 0x10,
-	0x0a, 0x03, 0x72, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x72, 0x6e, 0x64,
-	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
-	0x22, 0x71, 0x0a, 0x19, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x4c, 0x6f, 0x6e, 0x67, 0x41, 0x6e, 0x73,
-	0x77, 0x65, 0x72, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a,
-	0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
-	0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x12, 0x0a, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x73,
-	0x61, 0x6c, 0x74, 0x22, 0x41, 0x0a, 0x19, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x46, 0x6c, 0x69, 0x70,
-	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
-	0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63,
-	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x22, 0x35, 0x0a, 0x1b, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x4f,
-	0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x41, 0x74, 0x74, 0x61, 0x63,
-	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x27, 0x0a,
-	0x13, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x75, 0x72, 0x6e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
-	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x32, 0x0a, 0x1c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x43,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x41, 0x74, 0x74, 0x61,
-	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x2d, 0x0a, 0x19, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6c, 0x69, 0x70, 0x41, 0x74, 0x74,
-	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63, 0x69, 0x64, 0x22, 0xf1, 0x01, 0x0a, 0x11, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
-	0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x62,
-	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x61,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x4f, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
-	0x74, 0x44, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x43, 0x6f, 0x6e, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
-	0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x45, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x43,
-	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x63,
-	0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63,
-	0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x22, 0xe5, 0x09,
-	0x0a, 0x12, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e,
-	0x76, 0x69, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x69, 0x6e, 0x76,
-	0x69, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66,
-	0x69, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e,
-	0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x12, 0x28,
-	0x0a, 0x0f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x46, 0x6c, 0x69, 0x70, 0x50, 0x6f, 0x69, 0x6e, 0x74,
-	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x46, 0x6c,
-	0x69, 0x70, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x4b,
-	0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79,
-	0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70,
-	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
-	0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x12, 0x35, 0x0a, 0x05, 0x66, 0x6c, 0x69, 0x70, 0x73, 0x18,
-	0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x2e, 0x46, 0x6c, 0x69, 0x70, 0x52, 0x05, 0x66, 0x6c, 0x69, 0x70, 0x73, 0x12, 0x1e, 0x0a,
-	0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
-	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x63, 0x6f, 0x64,
-	0x65, 0x12, 0x3d, 0x0a, 0x08, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x73, 0x18, 0x0c, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e,
-	0x54, 0x78, 0x41, 0x64, 0x64, 0x72, 0x52, 0x08, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x73,
-	0x12, 0x3c, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x49, 0x6e,
-	0x76, 0x69, 0x74, 0x65, 0x72, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x12, 0x18,
-	0x0a, 0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x74, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x74, 0x73,
-	0x12, 0x2a, 0x0a, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b,
-	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x11, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06,
-	0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
-	0x74, 0x65, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67,
-	0x61, 0x74, 0x65, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x64,
-	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x28,
-	0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x70, 0x6f, 0x63,
-	0x68, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x68, 0x61, 0x72,
-	0x64, 0x49, 0x64, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x68, 0x61, 0x72, 0x64,
-	0x49, 0x64, 0x12, 0x30, 0x0a, 0x13, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x64,
-	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x17, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x13, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x70, 0x6c, 0x65, 0x6e, 0x69, 0x73,
-	0x68, 0x65, 0x64, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10,
-	0x72, 0x65, 0x70, 0x6c, 0x65, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x53, 0x74, 0x61, 0x6b, 0x65,
-	0x12, 0x26, 0x0a, 0x0e, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e,
-	0x64, 0x73, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74,
-	0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x70, 0x65, 0x6e, 0x61,
-	0x6c, 0x74, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x1a, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x10, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x12, 0x2c, 0x0a, 0x11, 0x75, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x11, 0x75, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x70, 0x6f,
-	0x63, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x53, 0x74, 0x61, 0x6b,
-	0x65, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x53,
-	0x74, 0x61, 0x6b, 0x65, 0x1a, 0x2c, 0x0a, 0x04, 0x46, 0x6c, 0x69, 0x70, 0x12, 0x10, 0x0a, 0x03,
-	0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x61,
-	0x69, 0x72, 0x1a, 0x36, 0x0a, 0x06, 0x54, 0x78, 0x41, 0x64, 0x64, 0x72, 0x12, 0x12, 0x0a, 0x04,
-	0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68,
-	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x1a, 0x59, 0x0a, 0x07, 0x49, 0x6e,
-	0x76, 0x69, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x48, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x48,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xb0, 0x07, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70,
-	0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68,
-	0x12, 0x2e, 0x0a, 0x12, 0x6e, 0x65, 0x78, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6e, 0x65,
-	0x78, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65,
-	0x12, 0x2a, 0x0a, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65,
-	0x72, 0x69, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
-	0x67, 0x6f, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x0a, 0x67, 0x6f, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09,
-	0x77, 0x6f, 0x72, 0x64, 0x73, 0x53, 0x65, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x09, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x53, 0x65, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x61,
-	0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1e,
-	0x0a, 0x0a, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0a, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1c,
-	0x0a, 0x09, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x09, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73, 0x12, 0x32, 0x0a, 0x14,
-	0x76, 0x72, 0x66, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73,
-	0x68, 0x6f, 0x6c, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x76, 0x72, 0x66, 0x50,
-	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
-	0x12, 0x28, 0x0a, 0x0f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x42,
-	0x69, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x65, 0x6d, 0x70, 0x74, 0x79,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x42, 0x69, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x67, 0x6f,
-	0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x67, 0x6f, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x1d, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x73, 0x43, 0x6e, 0x74, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x43, 0x65, 0x72, 0x65,
-	0x6d, 0x6f, 0x6e, 0x69, 0x61, 0x6c, 0x54, 0x78, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x1d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x43, 0x6e, 0x74, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75,
-	0x74, 0x43, 0x65, 0x72, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x61, 0x6c, 0x54, 0x78, 0x73, 0x12, 0x28,
-	0x0a, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x45, 0x70, 0x6f,
-	0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x68, 0x61, 0x72,
-	0x64, 0x73, 0x4e, 0x75, 0x6d, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x68, 0x61,
-	0x72, 0x64, 0x73, 0x4e, 0x75, 0x6d, 0x12, 0x5e, 0x0a, 0x13, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x42, 0x79, 0x53, 0x68, 0x61, 0x72, 0x64, 0x73, 0x18, 0x0f, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x42, 0x79, 0x53, 0x68, 0x61, 0x72, 0x64,
-	0x73, 0x52, 0x13, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x42, 0x79,
-	0x53, 0x68, 0x61, 0x72, 0x64, 0x73, 0x12, 0x42, 0x0a, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x64, 0x53,
-	0x69, 0x7a, 0x65, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x6f, 0x64,
-	0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x47, 0x6c,
-	0x6f, 0x62, 0x61, 0x6c, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x0a,
-	0x73, 0x68, 0x61, 0x72, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x1c, 0x64, 0x69,
-	0x73, 0x63, 0x72, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x6b,
-	0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x1c, 0x64, 0x69, 0x73, 0x63, 0x72, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x53, 0x74, 0x61, 0x6b, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x1a, 0x4d,
-	0x0a, 0x13, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x42, 0x79, 0x53,
-	0x68, 0x61, 0x72, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x68, 0x61, 0x72, 0x64, 0x49, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x68, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12,
-	0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0c, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x73, 0x1a, 0x39, 0x0a,
-	0x09, 0x53, 0x68, 0x61, 0x72, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x68,
-	0x61, 0x72, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x68, 0x61,
-	0x72, 0x64, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x50, 0x0a, 0x1a, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x49, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
-	0x74, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67,
-	0x61, 0x74, 0x65, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x3e, 0x0a, 0x1e, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x1c, 0x0a, 0x09,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52,
-	0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0xb7, 0x01, 0x0a, 0x1a, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x4f, 0x0a, 0x0b, 0x64, 0x65, 0x6c,
-	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d,
-	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x77, 0x69, 0x74,
-	0x63, 0x68, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x64,
-	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x48, 0x0a, 0x0a, 0x44, 0x65,
-	0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65,
-	0x67, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x64, 0x65, 0x6c,
-	0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
-	0x74, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67,
-	0x61, 0x74, 0x65, 0x65, 0x22, 0x3c, 0x0a, 0x1a, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x69,
-	0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69,
-	0x65, 0x73, 0x22, 0x9b, 0x01, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x42, 0x75, 0x72, 0x6e, 0x74, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x12, 0x37, 0x0a, 0x05, 0x69,
-	0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x6f, 0x64,
-	0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x75,
-	0x72, 0x6e, 0x74, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69,
-	0x74, 0x65, 0x6d, 0x73, 0x1a, 0x4a, 0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x18, 0x0a, 0x07,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x69, 0x64, 0x56, 0x32, 0x22, 0xb1, 0x01,
+	0x0a, 0x1b, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x46, 0x6c,
+	0x69, 0x70, 0x4b, 0x65, 0x79, 0x73, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x3c, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x46, 0x6c, 0x69, 0x70, 0x4b, 0x65, 0x79, 0x73, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x1a, 0x36, 0x0a, 0x04, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x22, 0x3b, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x75, 0x6c, 0x6c, 0x50, 0x75,
+	0x73, 0x68, 0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x7b,
+	0x0a, 0x17, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4d,
+	0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x44, 0x62, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x69, 0x64, 0x56, 0x32, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x69, 0x64, 0x56, 0x32, 0x12, 0x1e, 0x0a, 0x0a, 0x66,
+	0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x56, 0x32, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x56, 0x32, 0x22, 0x46, 0x0a, 0x12, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x44,
+	0x62, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x22, 0x87, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x41, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x73, 0x44, 0x62, 0x12, 0x37, 0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x44, 0x62, 0x2e,
+	0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x1a,
+	0x3c, 0x0a, 0x06, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x22, 0x55, 0x0a,
+	0x0f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x75, 0x72, 0x6e, 0x74, 0x43, 0x6f, 0x69, 0x6e, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x9b, 0x01, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x61,
+	0x76, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28,
+	0x0a, 0x02, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x02, 0x74, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x50,
+	0x65, 0x72, 0x47, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x66, 0x65, 0x65,
+	0x50, 0x65, 0x72, 0x47, 0x61, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x22, 0xbf, 0x01, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x69, 0x74, 0x79, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x45, 0x0a, 0x0a, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x69, 0x74, 0x79, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x1a, 0x42, 0x0a, 0x08, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07,
 	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x22, 0xc1, 0x16, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66,
-	0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
-	0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x73,
-	0x65, 0x65, 0x64, 0x12, 0x3b, 0x0a, 0x06, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x2e, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x52, 0x06, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c,
-	0x12, 0x4d, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x77, 0x69, 0x74, 0x63,
-	0x68, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12,
-	0x40, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x22, 0x68, 0x0a, 0x1a, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x68, 0x6f,
+	0x72, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x12, 0x10, 0x0a, 0x03,
+	0x72, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x72, 0x6e, 0x64, 0x12, 0x1e,
+	0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x71,
+	0x0a, 0x19, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x4c, 0x6f, 0x6e, 0x67, 0x41, 0x6e, 0x73, 0x77, 0x65,
+	0x72, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x6e,
+	0x73, 0x77, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x61, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x73, 0x61, 0x6c,
+	0x74, 0x22, 0x41, 0x0a, 0x19, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x46, 0x6c, 0x69, 0x70, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
+	0x70, 0x61, 0x69, 0x72, 0x22, 0x35, 0x0a, 0x1b, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x4f, 0x6e, 0x6c,
+	0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x27, 0x0a, 0x13, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x42, 0x75, 0x72, 0x6e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x22, 0x32, 0x0a, 0x1c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x2d, 0x0a, 0x19, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6c, 0x69, 0x70, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x03, 0x63, 0x69, 0x64, 0x22, 0xf1, 0x01, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6e, 0x6f,
+	0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x4f, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x44, 0x61, 0x74, 0x61, 0x1a, 0x45, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x64,
+	0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x6f, 0x64,
+	0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x22, 0xe5, 0x09, 0x0a, 0x12,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x71, 0x75,
+	0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x12, 0x28, 0x0a, 0x0f,
+	0x73, 0x68, 0x6f, 0x72, 0x74, 0x46, 0x6c, 0x69, 0x70, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x46, 0x6c, 0x69, 0x70,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x24,
+	0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x46,
+	0x6c, 0x69, 0x70, 0x73, 0x12, 0x35, 0x0a, 0x05, 0x66, 0x6c, 0x69, 0x70, 0x73, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e,
+	0x46, 0x6c, 0x69, 0x70, 0x52, 0x05, 0x66, 0x6c, 0x69, 0x70, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x67,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
+	0x3d, 0x0a, 0x08, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x54, 0x78,
+	0x41, 0x64, 0x64, 0x72, 0x52, 0x08, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x73, 0x12, 0x3c,
+	0x0a, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x72, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70,
+	0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x74, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x74, 0x73, 0x12, 0x2a,
+	0x0a, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0b, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x64, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f,
+	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x18,
+	0x15, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x68, 0x61, 0x72, 0x64, 0x49,
+	0x64, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x68, 0x61, 0x72, 0x64, 0x49, 0x64,
+	0x12, 0x30, 0x0a, 0x13, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x64, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x17, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x70, 0x6c, 0x65, 0x6e, 0x69, 0x73, 0x68, 0x65,
+	0x64, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x72, 0x65,
+	0x70, 0x6c, 0x65, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x26,
+	0x0a, 0x0e, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x18, 0x19, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74,
+	0x79, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x10, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x2c, 0x0a, 0x11, 0x75, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x75,
+	0x6e, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x70, 0x6f, 0x63, 0x68,
+	0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x18,
+	0x1c, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x53, 0x74, 0x61,
+	0x6b, 0x65, 0x1a, 0x2c, 0x0a, 0x04, 0x46, 0x6c, 0x69, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72,
+	0x1a, 0x36, 0x0a, 0x06, 0x54, 0x78, 0x41, 0x64, 0x64, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x1a, 0x59, 0x0a, 0x07, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x22, 0xb0, 0x07, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x2e,
+	0x0a, 0x12, 0x6e, 0x65, 0x78, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6e, 0x65, 0x78, 0x74,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2a,
+	0x0a, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x6f,
+	0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a,
+	0x67, 0x6f, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x6f,
+	0x72, 0x64, 0x73, 0x53, 0x65, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x77,
+	0x6f, 0x72, 0x64, 0x73, 0x53, 0x65, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
+	0x6c, 0x61, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1c, 0x0a, 0x09,
+	0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x09, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73, 0x12, 0x32, 0x0a, 0x14, 0x76, 0x72,
+	0x66, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x76, 0x72, 0x66, 0x50, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x28,
+	0x0a, 0x0f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x42, 0x69, 0x74,
+	0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x42, 0x69, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x67, 0x6f, 0x64, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x11, 0x67, 0x6f, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x1d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x43, 0x6e, 0x74, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x43, 0x65, 0x72, 0x65, 0x6d, 0x6f,
+	0x6e, 0x69, 0x61, 0x6c, 0x54, 0x78, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1d, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x43, 0x6e, 0x74, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x43,
+	0x65, 0x72, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x61, 0x6c, 0x54, 0x78, 0x73, 0x12, 0x28, 0x0a, 0x0f,
+	0x70, 0x72, 0x65, 0x76, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18,
+	0x0d, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x45, 0x70, 0x6f, 0x63, 0x68,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x68, 0x61, 0x72, 0x64, 0x73,
+	0x4e, 0x75, 0x6d, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x68, 0x61, 0x72, 0x64,
+	0x73, 0x4e, 0x75, 0x6d, 0x12, 0x5e, 0x0a, 0x13, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x42, 0x79, 0x53, 0x68, 0x61, 0x72, 0x64, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x42, 0x79, 0x53, 0x68, 0x61, 0x72, 0x64, 0x73, 0x52,
+	0x13, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x42, 0x79, 0x53, 0x68,
+	0x61, 0x72, 0x64, 0x73, 0x12, 0x42, 0x0a, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x64, 0x53, 0x69, 0x7a,
+	0x65, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x47, 0x6c, 0x6f, 0x62,
+	0x61, 0x6c, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x0a, 0x73, 0x68,
+	0x61, 0x72, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x1c, 0x64, 0x69, 0x73, 0x63,
+	0x72, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x54,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x1c,
+	0x64, 0x69, 0x73, 0x63, 0x72, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x6b, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x1a, 0x4d, 0x0a, 0x13,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x42, 0x79, 0x53, 0x68, 0x61,
+	0x72, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x68, 0x61, 0x72, 0x64, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x68, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c,
+	0x52, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x73, 0x1a, 0x39, 0x0a, 0x09, 0x53,
+	0x68, 0x61, 0x72, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x68, 0x61, 0x72,
+	0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x68, 0x61, 0x72, 0x64,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x50, 0x0a, 0x1a, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x3e, 0x0a, 0x1e, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0xb7, 0x01, 0x0a, 0x1a, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x4f, 0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x64, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x48, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65,
+	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x65, 0x22, 0x3c, 0x0a, 0x1a, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x44, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x69, 0x65, 0x73,
+	0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0c, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x22, 0x9b, 0x01, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42,
+	0x75, 0x72, 0x6e, 0x74, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x12, 0x37, 0x0a, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x75, 0x72, 0x6e,
+	0x74, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x1a, 0x4a, 0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xc1,
+	0x16, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e,
+	0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x73, 0x65, 0x65,
+	0x64, 0x12, 0x3b, 0x0a, 0x06, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
 	0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x73, 0x12, 0x45, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18,
-	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x69, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x5d, 0x0a, 0x12, 0x61, 0x70, 0x70, 0x72,
-	0x6f, 0x76, 0x65, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x07,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72,
+	0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x52, 0x06, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12, 0x4d,
+	0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72,
 	0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x52, 0x12, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x49, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x55, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x72,
-	0x61, 0x63, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x2d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72,
-	0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x43, 0x6f,
-	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e,
-	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0xb4,
-	0x04, 0x0a, 0x06, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f,
-	0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12,
-	0x2e, 0x0a, 0x12, 0x6e, 0x65, 0x78, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6e, 0x65, 0x78,
-	0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12,
-	0x2a, 0x0a, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72,
-	0x69, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x67,
-	0x6f, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x0a, 0x67, 0x6f, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x77,
-	0x6f, 0x72, 0x64, 0x73, 0x53, 0x65, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
-	0x77, 0x6f, 0x72, 0x64, 0x73, 0x53, 0x65, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x61, 0x73,
-	0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0c, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1e, 0x0a,
-	0x0a, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0a, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1c, 0x0a,
-	0x09, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x09, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73, 0x12, 0x32, 0x0a, 0x14, 0x76,
-	0x72, 0x66, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
-	0x6f, 0x6c, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x76, 0x72, 0x66, 0x50, 0x72,
-	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12,
-	0x28, 0x0a, 0x0f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x42, 0x69,
-	0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x42, 0x69, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x67, 0x6f, 0x64,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x67, 0x6f, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x1d, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x73, 0x43, 0x6e, 0x74, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x43, 0x65, 0x72, 0x65, 0x6d,
-	0x6f, 0x6e, 0x69, 0x61, 0x6c, 0x54, 0x78, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1d,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x43, 0x6e, 0x74, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74,
-	0x43, 0x65, 0x72, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x61, 0x6c, 0x54, 0x78, 0x73, 0x12, 0x28, 0x0a,
-	0x0f, 0x70, 0x72, 0x65, 0x76, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
-	0x18, 0x0d, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x45, 0x70, 0x6f, 0x63,
-	0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x68, 0x61, 0x72, 0x64,
-	0x73, 0x4e, 0x75, 0x6d, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x68, 0x61, 0x72,
-	0x64, 0x73, 0x4e, 0x75, 0x6d, 0x1a, 0x2c, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53,
-	0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x65, 0x73, 0x1a, 0x82, 0x02, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e,
-	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
-	0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12,
-	0x55, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
-	0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x40, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
-	0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x64, 0x65, 0x48, 0x61,
-	0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x6f, 0x64, 0x65, 0x48, 0x61,
-	0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x1a, 0xf4, 0x09, 0x0a, 0x08, 0x49, 0x64, 0x65,
+	0x74, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52,
+	0x0c, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x40, 0x0a,
+	0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72,
+	0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12,
+	0x45, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x5d, 0x0a, 0x12, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x52, 0x12, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x55, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65, 0x64,
+	0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0xb4, 0x04, 0x0a,
+	0x06, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x2e, 0x0a,
+	0x12, 0x6e, 0x65, 0x78, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6e, 0x65, 0x78, 0x74, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2a, 0x0a,
+	0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x6f, 0x64,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x67,
+	0x6f, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x6f, 0x72,
+	0x64, 0x73, 0x53, 0x65, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x77, 0x6f,
+	0x72, 0x64, 0x73, 0x53, 0x65, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6c,
+	0x61, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x66,
+	0x65, 0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73, 0x12, 0x32, 0x0a, 0x14, 0x76, 0x72, 0x66,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x76, 0x72, 0x66, 0x50, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x28, 0x0a,
+	0x0f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x42, 0x69, 0x74, 0x73,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x42, 0x69, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x67, 0x6f, 0x64, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x11, 0x67, 0x6f, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x1d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x43,
+	0x6e, 0x74, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x43, 0x65, 0x72, 0x65, 0x6d, 0x6f, 0x6e,
+	0x69, 0x61, 0x6c, 0x54, 0x78, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1d, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x43, 0x6e, 0x74, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x43, 0x65,
+	0x72, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x61, 0x6c, 0x54, 0x78, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x70,
+	0x72, 0x65, 0x76, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x0d,
+	0x20, 0x03, 0x28, 0x04, 0x52, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x68, 0x61, 0x72, 0x64, 0x73, 0x4e,
+	0x75, 0x6d, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x68, 0x61, 0x72, 0x64, 0x73,
+	0x4e, 0x75, 0x6d, 0x1a, 0x2c, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x77, 0x69,
+	0x74, 0x63, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x1a, 0x82, 0x02, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x55, 0x0a,
+	0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x44, 0x61, 0x74, 0x61, 0x1a, 0x40, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x1a, 0xf4, 0x09, 0x0a, 0x08, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x6b, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x26, 0x0a, 0x0e, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x68, 0x6f, 0x72, 0x74,
+	0x46, 0x6c, 0x69, 0x70, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x46, 0x6c, 0x69, 0x70, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x12,
+	0x40, 0x0a, 0x05, 0x66, 0x6c, 0x69, 0x70, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65,
+	0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x46, 0x6c, 0x69, 0x70, 0x52, 0x05, 0x66, 0x6c, 0x69, 0x70,
+	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x08, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65,
+	0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x54,
+	0x78, 0x41, 0x64, 0x64, 0x72, 0x52, 0x08, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x73, 0x12,
+	0x47, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x52,
+	0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x61,
+	0x6c, 0x74, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c,
+	0x74, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x69, 0x74, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x65, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x65, 0x12, 0x28,
+	0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x6e, 0x63,
+	0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x65,
+	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x16, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x70, 0x6f,
+	0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x68, 0x61, 0x72, 0x64, 0x49, 0x64, 0x18, 0x17, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x68, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x13,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x18, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x70, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a,
+	0x0a, 0x10, 0x72, 0x65, 0x70, 0x6c, 0x65, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x53, 0x74, 0x61,
+	0x6b, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x72, 0x65, 0x70, 0x6c, 0x65, 0x6e,
+	0x69, 0x73, 0x68, 0x65, 0x64, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x65,
+	0x6e, 0x61, 0x6c, 0x74, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x1a, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0e, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x70, 0x65,
+	0x6e, 0x61, 0x6c, 0x74, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2c,
+	0x0a, 0x11, 0x75, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x75, 0x6e, 0x64, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x1a, 0x2c, 0x0a, 0x04,
+	0x46, 0x6c, 0x69, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x1a, 0x36, 0x0a, 0x06, 0x54, 0x78,
+	0x41, 0x64, 0x64, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x1a, 0x59, 0x0a, 0x07, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x1a, 0x96, 0x01,
+	0x0a, 0x10, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6e,
+	0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x6e, 0x6c, 0x69,
+	0x6e, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x1a, 0x3a, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0x49, 0x0a, 0x1b, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x43, 0x61, 0x6c, 0x6c, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x22, 0x79, 0x0a,
+	0x1d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x08, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72,
+	0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x05, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0x36, 0x0a, 0x20, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x61, 0x72, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73,
+	0x22, 0x42, 0x0a, 0x1a, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x54, 0x6f,
+	0x49, 0x70, 0x66, 0x73, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x22, 0xe0, 0x03, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x78,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x12, 0x42, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x1a, 0xb4, 0x02, 0x0a,
+	0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x61, 0x73,
+	0x43, 0x6f, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x67, 0x61, 0x73, 0x43,
+	0x6f, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x3a, 0x0a, 0x06, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x78, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
+	0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12,
+	0x22, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x1a, 0x52, 0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x22, 0x3d, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x10,
+	0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63, 0x69, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xe2, 0x01, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x44, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x54, 0x78, 0x73, 0x12, 0x3a, 0x0a, 0x03, 0x54,
+	0x78, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x44, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x54,
+	0x78, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x44, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64,
+	0x54, 0x78, 0x52, 0x03, 0x54, 0x78, 0x73, 0x1a, 0x91, 0x01, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x44, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x54, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12,
+	0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x74, 0x6f, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x70, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x74, 0x69, 0x70, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x57, 0x0a, 0x0f, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x53, 0x61, 0x76, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04,
+	0x61, 0x72, 0x67, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x55, 0x70,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x05, 0x76, 0x6f,
+	0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x56,
+	0x6f, 0x74, 0x65, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x1a, 0x42, 0x0a, 0x10,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x56, 0x6f, 0x74, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x22, 0xb8, 0x02, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x4c, 0x6f, 0x74, 0x74, 0x65, 0x72,
+	0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x44, 0x62, 0x12, 0x49, 0x0a,
+	0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x29, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x4c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x44, 0x62, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x1a, 0xd0, 0x01, 0x0a, 0x08, 0x49, 0x64, 0x65,
 	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
-	0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x12,
-	0x1a, 0x0a, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x26, 0x0a, 0x0e, 0x73, 0x68, 0x69, 0x66, 0x74, 0x65, 0x64, 0x53, 0x68, 0x61, 0x72, 0x64, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x73, 0x68, 0x69, 0x66, 0x74, 0x65, 0x64,
+	0x53, 0x68, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x6c, 0x69, 0x70, 0x43,
+	0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x08, 0x66, 0x6c, 0x69, 0x70, 0x43,
+	0x69, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73,
 	0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x12, 0x26, 0x0a, 0x0e, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x46, 0x6c,
-	0x69, 0x70, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x71, 0x75, 0x61, 0x6c, 0x69,
-	0x66, 0x69, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x68, 0x6f,
-	0x72, 0x74, 0x46, 0x6c, 0x69, 0x70, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x0f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x46, 0x6c, 0x69, 0x70, 0x50, 0x6f, 0x69,
-	0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x72,
-	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70,
-	0x73, 0x12, 0x40, 0x0a, 0x05, 0x66, 0x6c, 0x69, 0x70, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x2a, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-	0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x49,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x46, 0x6c, 0x69, 0x70, 0x52, 0x05, 0x66, 0x6c,
-	0x69, 0x70, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x08, 0x69, 0x6e, 0x76, 0x69, 0x74,
-	0x65, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e,
-	0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x2e, 0x54, 0x78, 0x41, 0x64, 0x64, 0x72, 0x52, 0x08, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65,
-	0x73, 0x12, 0x47, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x18, 0x0e, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
-	0x72, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65,
-	0x6e, 0x61, 0x6c, 0x74, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x65, 0x6e,
-	0x61, 0x6c, 0x74, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x42, 0x69, 0x74, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x10,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x70,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63,
-	0x6f, 0x72, 0x65, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x72,
-	0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x65, 0x18,
-	0x14, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x65,
-	0x12, 0x28, 0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f,
-	0x6e, 0x63, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x67,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x65,
-	0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x16, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45,
-	0x70, 0x6f, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x68, 0x61, 0x72, 0x64, 0x49, 0x64, 0x18,
-	0x17, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x68, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x30,
-	0x0a, 0x13, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x67,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x18, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x70, 0x65, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x70, 0x6c, 0x65, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x53,
-	0x74, 0x61, 0x6b, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x72, 0x65, 0x70, 0x6c,
-	0x65, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x26, 0x0a, 0x0e,
-	0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x1a,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x53, 0x65, 0x63,
-	0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10,
-	0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x12, 0x2c, 0x0a, 0x11, 0x75, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x45, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x75, 0x6e, 0x64,
-	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x1a, 0x2c,
-	0x0a, 0x04, 0x46, 0x6c, 0x69, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x1a, 0x36, 0x0a, 0x06,
-	0x54, 0x78, 0x41, 0x64, 0x64, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x1a, 0x59, 0x0a, 0x07, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x12,
-	0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68,
-	0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a,
-	0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x1a,
-	0x96, 0x01, 0x0a, 0x10, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x49, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c,
-	0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06,
-	0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x6e,
-	0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
-	0x65, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x1a, 0x3a, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x22, 0x49, 0x0a, 0x1b, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x43, 0x61, 0x6c,
-	0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61,
-	0x72, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x22,
-	0x79, 0x0a, 0x1d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x43, 0x6f,
-	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
-	0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x08, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04,
-	0x61, 0x72, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73,
-	0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
-	0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x05, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0x36, 0x0a, 0x20, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x61, 0x72,
-	0x67, 0x73, 0x22, 0x42, 0x0a, 0x1a, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x6f, 0x72, 0x65,
-	0x54, 0x6f, 0x49, 0x70, 0x66, 0x73, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
-	0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63,
-	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0xe0, 0x03, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x12, 0x42, 0x0a, 0x08, 0x72, 0x65,
-	0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6d,
-	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x78, 0x52, 0x65, 0x63,
-	0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x78, 0x52, 0x65, 0x63,
-	0x65, 0x69, 0x70, 0x74, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x1a, 0xb4,
-	0x02, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
-	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x18, 0x0a,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x61, 0x73, 0x55, 0x73,
-	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x67,
-	0x61, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x67, 0x61,
-	0x73, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x3a, 0x0a,
-	0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x78, 0x52, 0x65,
-	0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74,
-	0x68, 0x6f, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f,
-	0x64, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x1a, 0x52, 0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a,
-	0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x22, 0x3d, 0x0a, 0x13, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78,
-	0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63,
-	0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xe2, 0x01, 0x0a, 0x10, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x44, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x54, 0x78, 0x73, 0x12, 0x3a, 0x0a,
-	0x03, 0x54, 0x78, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6d, 0x6f, 0x64,
-	0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x44, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65,
-	0x64, 0x54, 0x78, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x44, 0x65, 0x66, 0x65, 0x72, 0x72,
-	0x65, 0x64, 0x54, 0x78, 0x52, 0x03, 0x54, 0x78, 0x73, 0x1a, 0x91, 0x01, 0x0a, 0x0f, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x44, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x54, 0x78, 0x12, 0x12, 0x0a,
-	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x66, 0x72, 0x6f,
-	0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x74,
-	0x6f, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79,
-	0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c,
-	0x6f, 0x61, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x70, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x04, 0x74, 0x69, 0x70, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x57, 0x0a,
-	0x0f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x61, 0x76, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x14, 0x0a, 0x05,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c,
-	0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x05,
-	0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64,
-	0x65, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x55, 0x70, 0x67, 0x72,
-	0x61, 0x64, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x1a, 0x42,
-	0x0a, 0x10, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x56, 0x6f,
-	0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x67, 0x72,
-	0x61, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x75, 0x70, 0x67, 0x72, 0x61,
-	0x64, 0x65, 0x22, 0xb8, 0x02, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x4c, 0x6f, 0x74, 0x74,
-	0x65, 0x72, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x44, 0x62, 0x12,
-	0x49, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x4c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x69, 0x65, 0x73, 0x44, 0x62, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x0a,
-	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x1a, 0xd0, 0x01, 0x0a, 0x08, 0x49,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x68, 0x69, 0x66, 0x74, 0x65, 0x64, 0x53, 0x68, 0x61, 0x72,
-	0x64, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x73, 0x68, 0x69, 0x66, 0x74,
-	0x65, 0x64, 0x53, 0x68, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x6c, 0x69,
-	0x70, 0x43, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x08, 0x66, 0x6c, 0x69,
-	0x70, 0x43, 0x69, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x17, 0x68, 0x61, 0x73, 0x44, 0x6f, 0x6e, 0x65, 0x41, 0x6c,
-	0x6c, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x68, 0x61, 0x73, 0x44, 0x6f, 0x6e, 0x65, 0x41, 0x6c, 0x6c,
-	0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x12, 0x38, 0x0a, 0x17, 0x68, 0x61, 0x73, 0x44, 0x6f, 0x6e, 0x65, 0x41, 0x6c, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x17, 0x68, 0x61, 0x73, 0x44, 0x6f, 0x6e, 0x65, 0x41, 0x6c, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -7641,7 +8519,7 @@ func file_protobuf_models_proto_rawDescGZIP() []byte {
 	return file_protobuf_models_proto_rawDescData
 }
 
-var file_protobuf_models_proto_msgTypes = make([]protoimpl.MessageInfo, 101)
+var file_protobuf_models_proto_msgTypes = make([]protoimpl.MessageInfo, 114)
 var file_protobuf_models_proto_goTypes = []interface{}{
 	(*ProtoTransaction)(nil),                              // 0: models.ProtoTransaction
 	(*ProtoBlockHeader)(nil),                              // 1: models.ProtoBlockHeader
@@ -7659,147 +8537,162 @@ var file_protobuf_models_proto_goTypes = []interface{}{
 	(*ProtoUpdateShardId)(nil),                            // 13: models.ProtoUpdateShardId
 	(*ProtoMsgBatch)(nil),                                 // 14: models.ProtoMsgBatch
 	(*ProtoDisconnect)(nil),                               // 15: models.ProtoDisconnect
-	(*ProtoIdentityStateDiff)(nil),                        // 16: models.ProtoIdentityStateDiff
-	(*ProtoSnapshotBlock)(nil),                            // 17: models.ProtoSnapshotBlock
-	(*ProtoSnapshotNodes)(nil),                            // 18: models.ProtoSnapshotNodes
-	(*ProtoGossipBlockRange)(nil),                         // 19: models.ProtoGossipBlockRange
-	(*ProtoProposeProof)(nil),                             // 20: models.ProtoProposeProof
-	(*ProtoVote)(nil),                                     // 21: models.ProtoVote
-	(*ProtoGetBlockByHashRequest)(nil),                    // 22: models.ProtoGetBlockByHashRequest
-	(*ProtoGetBlocksRangeRequest)(nil),                    // 23: models.ProtoGetBlocksRangeRequest
-	(*ProtoGetForkBlockRangeRequest)(nil),                 // 24: models.ProtoGetForkBlockRangeRequest
-	(*ProtoFlip)(nil),                                     // 25: models.ProtoFlip
-	(*ProtoFlipKey)(nil),                                  // 26: models.ProtoFlipKey
-	(*ProtoManifest)(nil),                                 // 27: models.ProtoManifest
-	(*ProtoPrivateFlipKeysPackage)(nil),                   // 28: models.ProtoPrivateFlipKeysPackage
-	(*ProtoPullPushHash)(nil),                             // 29: models.ProtoPullPushHash
-	(*ProtoSnapshotManifestDb)(nil),                       // 30: models.ProtoSnapshotManifestDb
-	(*ProtoShortAnswerDb)(nil),                            // 31: models.ProtoShortAnswerDb
-	(*ProtoAnswersDb)(nil),                                // 32: models.ProtoAnswersDb
-	(*ProtoBurntCoins)(nil),                               // 33: models.ProtoBurntCoins
-	(*ProtoSavedTransaction)(nil),                         // 34: models.ProtoSavedTransaction
-	(*ProtoActivityMonitor)(nil),                          // 35: models.ProtoActivityMonitor
-	(*ProtoShortAnswerAttachment)(nil),                    // 36: models.ProtoShortAnswerAttachment
-	(*ProtoLongAnswerAttachment)(nil),                     // 37: models.ProtoLongAnswerAttachment
-	(*ProtoFlipSubmitAttachment)(nil),                     // 38: models.ProtoFlipSubmitAttachment
-	(*ProtoOnlineStatusAttachment)(nil),                   // 39: models.ProtoOnlineStatusAttachment
-	(*ProtoBurnAttachment)(nil),                           // 40: models.ProtoBurnAttachment
-	(*ProtoChangeProfileAttachment)(nil),                  // 41: models.ProtoChangeProfileAttachment
-	(*ProtoDeleteFlipAttachment)(nil),                     // 42: models.ProtoDeleteFlipAttachment
-	(*ProtoStateAccount)(nil),                             // 43: models.ProtoStateAccount
-	(*ProtoStateIdentity)(nil),                            // 44: models.ProtoStateIdentity
-	(*ProtoStateGlobal)(nil),                              // 45: models.ProtoStateGlobal
-	(*ProtoStateApprovedIdentity)(nil),                    // 46: models.ProtoStateApprovedIdentity
-	(*ProtoStateIdentityStatusSwitch)(nil),                // 47: models.ProtoStateIdentityStatusSwitch
-	(*ProtoStateDelegationSwitch)(nil),                    // 48: models.ProtoStateDelegationSwitch
-	(*ProtoStateDelayedPenalties)(nil),                    // 49: models.ProtoStateDelayedPenalties
-	(*ProtoStateBurntCoins)(nil),                          // 50: models.ProtoStateBurntCoins
-	(*ProtoPredefinedState)(nil),                          // 51: models.ProtoPredefinedState
-	(*ProtoCallContractAttachment)(nil),                   // 52: models.ProtoCallContractAttachment
-	(*ProtoDeployContractAttachment)(nil),                 // 53: models.ProtoDeployContractAttachment
-	(*ProtoTerminateContractAttachment)(nil),              // 54: models.ProtoTerminateContractAttachment
-	(*ProtoStoreToIpfsAttachment)(nil),                    // 55: models.ProtoStoreToIpfsAttachment
-	(*ProtoTxReceipts)(nil),                               // 56: models.ProtoTxReceipts
-	(*ProtoTxReceiptIndex)(nil),                           // 57: models.ProtoTxReceiptIndex
-	(*ProtoDeferredTxs)(nil),                              // 58: models.ProtoDeferredTxs
-	(*ProtoSavedEvent)(nil),                               // 59: models.ProtoSavedEvent
-	(*ProtoUpgradeVotes)(nil),                             // 60: models.ProtoUpgradeVotes
-	(*ProtoLotteryIdentitiesDb)(nil),                      // 61: models.ProtoLotteryIdentitiesDb
-	(*ProtoTransaction_Data)(nil),                         // 62: models.ProtoTransaction.Data
-	(*ProtoBlockHeader_Proposed)(nil),                     // 63: models.ProtoBlockHeader.Proposed
-	(*ProtoBlockHeader_Empty)(nil),                        // 64: models.ProtoBlockHeader.Empty
-	(*ProtoBlockProposal_Data)(nil),                       // 65: models.ProtoBlockProposal.Data
-	(*ProtoBlockCert_Signature)(nil),                      // 66: models.ProtoBlockCert.Signature
-	(*ProtoMsgBatch_BatchItem)(nil),                       // 67: models.ProtoMsgBatch.BatchItem
-	(*ProtoIdentityStateDiff_IdentityStateDiffValue)(nil), // 68: models.ProtoIdentityStateDiff.IdentityStateDiffValue
-	(*ProtoSnapshotBlock_KeyValue)(nil),                   // 69: models.ProtoSnapshotBlock.KeyValue
-	(*ProtoSnapshotNodes_Node)(nil),                       // 70: models.ProtoSnapshotNodes.Node
-	(*ProtoGossipBlockRange_Block)(nil),                   // 71: models.ProtoGossipBlockRange.Block
-	(*ProtoProposeProof_Data)(nil),                        // 72: models.ProtoProposeProof.Data
-	(*ProtoVote_Data)(nil),                                // 73: models.ProtoVote.Data
-	(*ProtoFlipKey_Data)(nil),                             // 74: models.ProtoFlipKey.Data
-	(*ProtoPrivateFlipKeysPackage_Data)(nil),              // 75: models.ProtoPrivateFlipKeysPackage.Data
-	(*ProtoAnswersDb_Answer)(nil),                         // 76: models.ProtoAnswersDb.Answer
-	(*ProtoActivityMonitor_Activity)(nil),                 // 77: models.ProtoActivityMonitor.Activity
-	(*ProtoStateAccount_ProtoContractData)(nil),           // 78: models.ProtoStateAccount.ProtoContractData
-	(*ProtoStateIdentity_Flip)(nil),                       // 79: models.ProtoStateIdentity.Flip
-	(*ProtoStateIdentity_TxAddr)(nil),                     // 80: models.ProtoStateIdentity.TxAddr
-	(*ProtoStateIdentity_Inviter)(nil),                    // 81: models.ProtoStateIdentity.Inviter
-	(*ProtoStateGlobal_EmptyBlocksByShards)(nil),          // 82: models.ProtoStateGlobal.EmptyBlocksByShards
-	(*ProtoStateGlobal_ShardSize)(nil),                    // 83: models.ProtoStateGlobal.ShardSize
-	(*ProtoStateDelegationSwitch_Delegation)(nil),         // 84: models.ProtoStateDelegationSwitch.Delegation
-	(*ProtoStateBurntCoins_Item)(nil),                     // 85: models.ProtoStateBurntCoins.Item
-	(*ProtoPredefinedState_Global)(nil),                   // 86: models.ProtoPredefinedState.Global
-	(*ProtoPredefinedState_StatusSwitch)(nil),             // 87: models.ProtoPredefinedState.StatusSwitch
-	(*ProtoPredefinedState_Account)(nil),                  // 88: models.ProtoPredefinedState.Account
-	(*ProtoPredefinedState_Identity)(nil),                 // 89: models.ProtoPredefinedState.Identity
-	(*ProtoPredefinedState_ApprovedIdentity)(nil),         // 90: models.ProtoPredefinedState.ApprovedIdentity
-	(*ProtoPredefinedState_ContractKeyValue)(nil),         // 91: models.ProtoPredefinedState.ContractKeyValue
-	(*ProtoPredefinedState_Account_ContractData)(nil),     // 92: models.ProtoPredefinedState.Account.ContractData
-	(*ProtoPredefinedState_Identity_Flip)(nil),            // 93: models.ProtoPredefinedState.Identity.Flip
-	(*ProtoPredefinedState_Identity_TxAddr)(nil),          // 94: models.ProtoPredefinedState.Identity.TxAddr
-	(*ProtoPredefinedState_Identity_Inviter)(nil),         // 95: models.ProtoPredefinedState.Identity.Inviter
-	(*ProtoTxReceipts_ProtoTxReceipt)(nil),                // 96: models.ProtoTxReceipts.ProtoTxReceipt
-	(*ProtoTxReceipts_ProtoEvent)(nil),                    // 97: models.ProtoTxReceipts.ProtoEvent
-	(*ProtoDeferredTxs_ProtoDeferredTx)(nil),              // 98: models.ProtoDeferredTxs.ProtoDeferredTx
-	(*ProtoUpgradeVotes_ProtoUpgradeVote)(nil),            // 99: models.ProtoUpgradeVotes.ProtoUpgradeVote
-	(*ProtoLotteryIdentitiesDb_Identity)(nil),             // 100: models.ProtoLotteryIdentitiesDb.Identity
+	(*ProtoGetTransactionsRequest)(nil),                   // 16: models.ProtoGetTransactionsRequest
+	(*ProtoTransactions)(nil),                             // 17: models.ProtoTransactions
+	(*ProtoGetPooledTransactionsRequest)(nil),             // 18: models.ProtoGetPooledTransactionsRequest
+	(*ProtoPooledTransactions)(nil),                       // 19: models.ProtoPooledTransactions
+	(*ProtoGetStateProofRequest)(nil),                     // 20: models.ProtoGetStateProofRequest
+	(*ProtoStateProof)(nil),                               // 21: models.ProtoStateProof
+	(*ProtoGetBlockHeadersRequest)(nil),                   // 22: models.ProtoGetBlockHeadersRequest
+	(*ProtoBlockHeaders)(nil),                             // 23: models.ProtoBlockHeaders
+	(*ProtoPing)(nil),                                     // 24: models.ProtoPing
+	(*ProtoStatus)(nil),                                   // 25: models.ProtoStatus
+	(*ProtoGetFlipRequest)(nil),                           // 26: models.ProtoGetFlipRequest
+	(*ProtoFlipResponse)(nil),                             // 27: models.ProtoFlipResponse
+	(*ProtoNewBlockHash)(nil),                             // 28: models.ProtoNewBlockHash
+	(*ProtoIdentityStateDiff)(nil),                        // 29: models.ProtoIdentityStateDiff
+	(*ProtoSnapshotBlock)(nil),                            // 30: models.ProtoSnapshotBlock
+	(*ProtoSnapshotNodes)(nil),                            // 31: models.ProtoSnapshotNodes
+	(*ProtoGossipBlockRange)(nil),                         // 32: models.ProtoGossipBlockRange
+	(*ProtoProposeProof)(nil),                             // 33: models.ProtoProposeProof
+	(*ProtoVote)(nil),                                     // 34: models.ProtoVote
+	(*ProtoGetBlockByHashRequest)(nil),                    // 35: models.ProtoGetBlockByHashRequest
+	(*ProtoGetBlocksRangeRequest)(nil),                    // 36: models.ProtoGetBlocksRangeRequest
+	(*ProtoGetForkBlockRangeRequest)(nil),                 // 37: models.ProtoGetForkBlockRangeRequest
+	(*ProtoFlip)(nil),                                     // 38: models.ProtoFlip
+	(*ProtoFlipKey)(nil),                                  // 39: models.ProtoFlipKey
+	(*ProtoManifest)(nil),                                 // 40: models.ProtoManifest
+	(*ProtoPrivateFlipKeysPackage)(nil),                   // 41: models.ProtoPrivateFlipKeysPackage
+	(*ProtoPullPushHash)(nil),                             // 42: models.ProtoPullPushHash
+	(*ProtoSnapshotManifestDb)(nil),                       // 43: models.ProtoSnapshotManifestDb
+	(*ProtoShortAnswerDb)(nil),                            // 44: models.ProtoShortAnswerDb
+	(*ProtoAnswersDb)(nil),                                // 45: models.ProtoAnswersDb
+	(*ProtoBurntCoins)(nil),                               // 46: models.ProtoBurntCoins
+	(*ProtoSavedTransaction)(nil),                         // 47: models.ProtoSavedTransaction
+	(*ProtoActivityMonitor)(nil),                          // 48: models.ProtoActivityMonitor
+	(*ProtoShortAnswerAttachment)(nil),                    // 49: models.ProtoShortAnswerAttachment
+	(*ProtoLongAnswerAttachment)(nil),                     // 50: models.ProtoLongAnswerAttachment
+	(*ProtoFlipSubmitAttachment)(nil),                     // 51: models.ProtoFlipSubmitAttachment
+	(*ProtoOnlineStatusAttachment)(nil),                   // 52: models.ProtoOnlineStatusAttachment
+	(*ProtoBurnAttachment)(nil),                           // 53: models.ProtoBurnAttachment
+	(*ProtoChangeProfileAttachment)(nil),                  // 54: models.ProtoChangeProfileAttachment
+	(*ProtoDeleteFlipAttachment)(nil),                     // 55: models.ProtoDeleteFlipAttachment
+	(*ProtoStateAccount)(nil),                             // 56: models.ProtoStateAccount
+	(*ProtoStateIdentity)(nil),                            // 57: models.ProtoStateIdentity
+	(*ProtoStateGlobal)(nil),                              // 58: models.ProtoStateGlobal
+	(*ProtoStateApprovedIdentity)(nil),                    // 59: models.ProtoStateApprovedIdentity
+	(*ProtoStateIdentityStatusSwitch)(nil),                // 60: models.ProtoStateIdentityStatusSwitch
+	(*ProtoStateDelegationSwitch)(nil),                    // 61: models.ProtoStateDelegationSwitch
+	(*ProtoStateDelayedPenalties)(nil),                    // 62: models.ProtoStateDelayedPenalties
+	(*ProtoStateBurntCoins)(nil),                          // 63: models.ProtoStateBurntCoins
+	(*ProtoPredefinedState)(nil),                          // 64: models.ProtoPredefinedState
+	(*ProtoCallContractAttachment)(nil),                   // 65: models.ProtoCallContractAttachment
+	(*ProtoDeployContractAttachment)(nil),                 // 66: models.ProtoDeployContractAttachment
+	(*ProtoTerminateContractAttachment)(nil),              // 67: models.ProtoTerminateContractAttachment
+	(*ProtoStoreToIpfsAttachment)(nil),                    // 68: models.ProtoStoreToIpfsAttachment
+	(*ProtoTxReceipts)(nil),                               // 69: models.ProtoTxReceipts
+	(*ProtoTxReceiptIndex)(nil),                           // 70: models.ProtoTxReceiptIndex
+	(*ProtoDeferredTxs)(nil),                              // 71: models.ProtoDeferredTxs
+	(*ProtoSavedEvent)(nil),                               // 72: models.ProtoSavedEvent
+	(*ProtoUpgradeVotes)(nil),                             // 73: models.ProtoUpgradeVotes
+	(*ProtoLotteryIdentitiesDb)(nil),                      // 74: models.ProtoLotteryIdentitiesDb
+	(*ProtoTransaction_Data)(nil),                         // 75: models.ProtoTransaction.Data
+	(*ProtoBlockHeader_Proposed)(nil),                     // 76: models.ProtoBlockHeader.Proposed
+	(*ProtoBlockHeader_Empty)(nil),                        // 77: models.ProtoBlockHeader.Empty
+	(*ProtoBlockProposal_Data)(nil),                       // 78: models.ProtoBlockProposal.Data
+	(*ProtoBlockCert_Signature)(nil),                      // 79: models.ProtoBlockCert.Signature
+	(*ProtoMsgBatch_BatchItem)(nil),                       // 80: models.ProtoMsgBatch.BatchItem
+	(*ProtoIdentityStateDiff_IdentityStateDiffValue)(nil), // 81: models.ProtoIdentityStateDiff.IdentityStateDiffValue
+	(*ProtoSnapshotBlock_KeyValue)(nil),                   // 82: models.ProtoSnapshotBlock.KeyValue
+	(*ProtoSnapshotNodes_Node)(nil),                       // 83: models.ProtoSnapshotNodes.Node
+	(*ProtoGossipBlockRange_Block)(nil),                   // 84: models.ProtoGossipBlockRange.Block
+	(*ProtoProposeProof_Data)(nil),                        // 85: models.ProtoProposeProof.Data
+	(*ProtoVote_Data)(nil),                                // 86: models.ProtoVote.Data
+	(*ProtoFlipKey_Data)(nil),                             // 87: models.ProtoFlipKey.Data
+	(*ProtoPrivateFlipKeysPackage_Data)(nil),              // 88: models.ProtoPrivateFlipKeysPackage.Data
+	(*ProtoAnswersDb_Answer)(nil),                         // 89: models.ProtoAnswersDb.Answer
+	(*ProtoActivityMonitor_Activity)(nil),                 // 90: models.ProtoActivityMonitor.Activity
+	(*ProtoStateAccount_ProtoContractData)(nil),           // 91: models.ProtoStateAccount.ProtoContractData
+	(*ProtoStateIdentity_Flip)(nil),                       // 92: models.ProtoStateIdentity.Flip
+	(*ProtoStateIdentity_TxAddr)(nil),                     // 93: models.ProtoStateIdentity.TxAddr
+	(*ProtoStateIdentity_Inviter)(nil),                    // 94: models.ProtoStateIdentity.Inviter
+	(*ProtoStateGlobal_EmptyBlocksByShards)(nil),          // 95: models.ProtoStateGlobal.EmptyBlocksByShards
+	(*ProtoStateGlobal_ShardSize)(nil),                    // 96: models.ProtoStateGlobal.ShardSize
+	(*ProtoStateDelegationSwitch_Delegation)(nil),         // 97: models.ProtoStateDelegationSwitch.Delegation
+	(*ProtoStateBurntCoins_Item)(nil),                     // 98: models.ProtoStateBurntCoins.Item
+	(*ProtoPredefinedState_Global)(nil),                   // 99: models.ProtoPredefinedState.Global
+	(*ProtoPredefinedState_StatusSwitch)(nil),             // 100: models.ProtoPredefinedState.StatusSwitch
+	(*ProtoPredefinedState_Account)(nil),                  // 101: models.ProtoPredefinedState.Account
+	(*ProtoPredefinedState_Identity)(nil),                 // 102: models.ProtoPredefinedState.Identity
+	(*ProtoPredefinedState_ApprovedIdentity)(nil),         // 103: models.ProtoPredefinedState.ApprovedIdentity
+	(*ProtoPredefinedState_ContractKeyValue)(nil),         // 104: models.ProtoPredefinedState.ContractKeyValue
+	(*ProtoPredefinedState_Account_ContractData)(nil),     // 105: models.ProtoPredefinedState.Account.ContractData
+	(*ProtoPredefinedState_Identity_Flip)(nil),            // 106: models.ProtoPredefinedState.Identity.Flip
+	(*ProtoPredefinedState_Identity_TxAddr)(nil),          // 107: models.ProtoPredefinedState.Identity.TxAddr
+	(*ProtoPredefinedState_Identity_Inviter)(nil),         // 108: models.ProtoPredefinedState.Identity.Inviter
+	(*ProtoTxReceipts_ProtoTxReceipt)(nil),                // 109: models.ProtoTxReceipts.ProtoTxReceipt
+	(*ProtoTxReceipts_ProtoEvent)(nil),                    // 110: models.ProtoTxReceipts.ProtoEvent
+	(*ProtoDeferredTxs_ProtoDeferredTx)(nil),              // 111: models.ProtoDeferredTxs.ProtoDeferredTx
+	(*ProtoUpgradeVotes_ProtoUpgradeVote)(nil),            // 112: models.ProtoUpgradeVotes.ProtoUpgradeVote
+	(*ProtoLotteryIdentitiesDb_Identity)(nil),             // 113: models.ProtoLotteryIdentitiesDb.Identity
 }
 var file_protobuf_models_proto_depIdxs = []int32{
-	62,  // 0: models.ProtoTransaction.data:type_name -> models.ProtoTransaction.Data
-	63,  // 1: models.ProtoBlockHeader.proposedHeader:type_name -> models.ProtoBlockHeader.Proposed
-	64,  // 2: models.ProtoBlockHeader.emptyHeader:type_name -> models.ProtoBlockHeader.Empty
+	75,  // 0: models.ProtoTransaction.data:type_name -> models.ProtoTransaction.Data
+	76,  // 1: models.ProtoBlockHeader.proposedHeader:type_name -> models.ProtoBlockHeader.Proposed
+	77,  // 2: models.ProtoBlockHeader.emptyHeader:type_name -> models.ProtoBlockHeader.Empty
 	0,   // 3: models.ProtoBlockBody.transactions:type_name -> models.ProtoTransaction
 	1,   // 4: models.ProtoBlock.header:type_name -> models.ProtoBlockHeader
 	2,   // 5: models.ProtoBlock.body:type_name -> models.ProtoBlockBody
-	65,  // 6: models.ProtoBlockProposal.data:type_name -> models.ProtoBlockProposal.Data
-	66,  // 7: models.ProtoBlockCert.signatures:type_name -> models.ProtoBlockCert.Signature
-	67,  // 8: models.ProtoMsgBatch.data:type_name -> models.ProtoMsgBatch.BatchItem
-	68,  // 9: models.ProtoIdentityStateDiff.values:type_name -> models.ProtoIdentityStateDiff.IdentityStateDiffValue
-	69,  // 10: models.ProtoSnapshotBlock.data:type_name -> models.ProtoSnapshotBlock.KeyValue
-	70,  // 11: models.ProtoSnapshotNodes.nodes:type_name -> models.ProtoSnapshotNodes.Node
-	71,  // 12: models.ProtoGossipBlockRange.blocks:type_name -> models.ProtoGossipBlockRange.Block
-	72,  // 13: models.ProtoProposeProof.data:type_name -> models.ProtoProposeProof.Data
-	73,  // 14: models.ProtoVote.data:type_name -> models.ProtoVote.Data
-	0,   // 15: models.ProtoFlip.transaction:type_name -> models.ProtoTransaction
-	74,  // 16: models.ProtoFlipKey.data:type_name -> models.ProtoFlipKey.Data
-	75,  // 17: models.ProtoPrivateFlipKeysPackage.data:type_name -> models.ProtoPrivateFlipKeysPackage.Data
-	76,  // 18: models.ProtoAnswersDb.answers:type_name -> models.ProtoAnswersDb.Answer
-	0,   // 19: models.ProtoSavedTransaction.tx:type_name -> models.ProtoTransaction
-	77,  // 20: models.ProtoActivityMonitor.activities:type_name -> models.ProtoActivityMonitor.Activity
-	78,  // 21: models.ProtoStateAccount.contractData:type_name -> models.ProtoStateAccount.ProtoContractData
-	79,  // 22: models.ProtoStateIdentity.flips:type_name -> models.ProtoStateIdentity.Flip
-	80,  // 23: models.ProtoStateIdentity.invitees:type_name -> models.ProtoStateIdentity.TxAddr
-	81,  // 24: models.ProtoStateIdentity.inviter:type_name -> models.ProtoStateIdentity.Inviter
-	82,  // 25: models.ProtoStateGlobal.emptyBlocksByShards:type_name -> models.ProtoStateGlobal.EmptyBlocksByShards
-	83,  // 26: models.ProtoStateGlobal.shardSizes:type_name -> models.ProtoStateGlobal.ShardSize
-	84,  // 27: models.ProtoStateDelegationSwitch.delegations:type_name -> models.ProtoStateDelegationSwitch.Delegation
-	85,  // 28: models.ProtoStateBurntCoins.items:type_name -> models.ProtoStateBurntCoins.Item
-	86,  // 29: models.ProtoPredefinedState.global:type_name -> models.ProtoPredefinedState.Global
-	87,  // 30: models.ProtoPredefinedState.statusSwitch:type_name -> models.ProtoPredefinedState.StatusSwitch
-	88,  // 31: models.ProtoPredefinedState.accounts:type_name -> models.ProtoPredefinedState.Account
-	89,  // 32: models.ProtoPredefinedState.identities:type_name -> models.ProtoPredefinedState.Identity
-	90,  // 33: models.ProtoPredefinedState.approvedIdentities:type_name -> models.ProtoPredefinedState.ApprovedIdentity
-	91,  // 34: models.ProtoPredefinedState.contractValues:type_name -> models.ProtoPredefinedState.ContractKeyValue
-	96,  // 35: models.ProtoTxReceipts.receipts:type_name -> models.ProtoTxReceipts.ProtoTxReceipt
-	98,  // 36: models.ProtoDeferredTxs.Txs:type_name -> models.ProtoDeferredTxs.ProtoDeferredTx
-	99,  // 37: models.ProtoUpgradeVotes.votes:type_name -> models.ProtoUpgradeVotes.ProtoUpgradeVote
-	100, // 38: models.ProtoLotteryIdentitiesDb.identities:type_name -> models.ProtoLotteryIdentitiesDb.Identity
-	1,   // 39: models.ProtoBlockProposal.Data.header:type_name -> models.ProtoBlockHeader
-	2,   // 40: models.ProtoBlockProposal.Data.body:type_name -> models.ProtoBlockBody
-	1,   // 41: models.ProtoGossipBlockRange.Block.header:type_name -> models.ProtoBlockHeader
-	6,   // 42: models.ProtoGossipBlockRange.Block.cert:type_name -> models.ProtoBlockCert
-	16,  // 43: models.ProtoGossipBlockRange.Block.diff:type_name -> models.ProtoIdentityStateDiff
-	92,  // 44: models.ProtoPredefinedState.Account.contractData:type_name -> models.ProtoPredefinedState.Account.ContractData
-	93,  // 45: models.ProtoPredefinedState.Identity.flips:type_name -> models.ProtoPredefinedState.Identity.Flip
-	94,  // 46: models.ProtoPredefinedState.Identity.invitees:type_name -> models.ProtoPredefinedState.Identity.TxAddr
-	95,  // 47: models.ProtoPredefinedState.Identity.inviter:type_name -> models.ProtoPredefinedState.Identity.Inviter
-	97,  // 48: models.ProtoTxReceipts.ProtoTxReceipt.events:type_name -> models.ProtoTxReceipts.ProtoEvent
-	49,  // [49:49] is the sub-list for method output_type
-	49,  // [49:49] is the sub-list for method input_type
-	49,  // [49:49] is the sub-list for extension type_name
-	49,  // [49:49] is the sub-list for extension extendee
-	0,   // [0:49] is the sub-list for field type_name
+	78,  // 6: models.ProtoBlockProposal.data:type_name -> models.ProtoBlockProposal.Data
+	79,  // 7: models.ProtoBlockCert.signatures:type_name -> models.ProtoBlockCert.Signature
+	80,  // 8: models.ProtoMsgBatch.data:type_name -> models.ProtoMsgBatch.BatchItem
+	0,   // 9: models.ProtoTransactions.transactions:type_name -> models.ProtoTransaction
+	1,   // 10: models.ProtoBlockHeaders.headers:type_name -> models.ProtoBlockHeader
+	81,  // 11: models.ProtoIdentityStateDiff.values:type_name -> models.ProtoIdentityStateDiff.IdentityStateDiffValue
+	82,  // 12: models.ProtoSnapshotBlock.data:type_name -> models.ProtoSnapshotBlock.KeyValue
+	83,  // 13: models.ProtoSnapshotNodes.nodes:type_name -> models.ProtoSnapshotNodes.Node
+	84,  // 14: models.ProtoGossipBlockRange.blocks:type_name -> models.ProtoGossipBlockRange.Block
+	85,  // 15: models.ProtoProposeProof.data:type_name -> models.ProtoProposeProof.Data
+	86,  // 16: models.ProtoVote.data:type_name -> models.ProtoVote.Data
+	0,   // 17: models.ProtoFlip.transaction:type_name -> models.ProtoTransaction
+	87,  // 18: models.ProtoFlipKey.data:type_name -> models.ProtoFlipKey.Data
+	88,  // 19: models.ProtoPrivateFlipKeysPackage.data:type_name -> models.ProtoPrivateFlipKeysPackage.Data
+	89,  // 20: models.ProtoAnswersDb.answers:type_name -> models.ProtoAnswersDb.Answer
+	0,   // 21: models.ProtoSavedTransaction.tx:type_name -> models.ProtoTransaction
+	90,  // 22: models.ProtoActivityMonitor.activities:type_name -> models.ProtoActivityMonitor.Activity
+	91,  // 23: models.ProtoStateAccount.contractData:type_name -> models.ProtoStateAccount.ProtoContractData
+	92,  // 24: models.ProtoStateIdentity.flips:type_name -> models.ProtoStateIdentity.Flip
+	93,  // 25: models.ProtoStateIdentity.invitees:type_name -> models.ProtoStateIdentity.TxAddr
+	94,  // 26: models.ProtoStateIdentity.inviter:type_name -> models.ProtoStateIdentity.Inviter
+	95,  // 27: models.ProtoStateGlobal.emptyBlocksByShards:type_name -> models.ProtoStateGlobal.EmptyBlocksByShards
+	96,  // 28: models.ProtoStateGlobal.shardSizes:type_name -> models.ProtoStateGlobal.ShardSize
+	97,  // 29: models.ProtoStateDelegationSwitch.delegations:type_name -> models.ProtoStateDelegationSwitch.Delegation
+	98,  // 30: models.ProtoStateBurntCoins.items:type_name -> models.ProtoStateBurntCoins.Item
+	99,  // 31: models.ProtoPredefinedState.global:type_name -> models.ProtoPredefinedState.Global
+	100, // 32: models.ProtoPredefinedState.statusSwitch:type_name -> models.ProtoPredefinedState.StatusSwitch
+	101, // 33: models.ProtoPredefinedState.accounts:type_name -> models.ProtoPredefinedState.Account
+	102, // 34: models.ProtoPredefinedState.identities:type_name -> models.ProtoPredefinedState.Identity
+	103, // 35: models.ProtoPredefinedState.approvedIdentities:type_name -> models.ProtoPredefinedState.ApprovedIdentity
+	104, // 36: models.ProtoPredefinedState.contractValues:type_name -> models.ProtoPredefinedState.ContractKeyValue
+	109, // 37: models.ProtoTxReceipts.receipts:type_name -> models.ProtoTxReceipts.ProtoTxReceipt
+	111, // 38: models.ProtoDeferredTxs.Txs:type_name -> models.ProtoDeferredTxs.ProtoDeferredTx
+	112, // 39: models.ProtoUpgradeVotes.votes:type_name -> models.ProtoUpgradeVotes.ProtoUpgradeVote
+	113, // 40: models.ProtoLotteryIdentitiesDb.identities:type_name -> models.ProtoLotteryIdentitiesDb.Identity
+	1,   // 41: models.ProtoBlockProposal.Data.header:type_name -> models.ProtoBlockHeader
+	2,   // 42: models.ProtoBlockProposal.Data.body:type_name -> models.ProtoBlockBody
+	1,   // 43: models.ProtoGossipBlockRange.Block.header:type_name -> models.ProtoBlockHeader
+	6,   // 44: models.ProtoGossipBlockRange.Block.cert:type_name -> models.ProtoBlockCert
+	29,  // 45: models.ProtoGossipBlockRange.Block.diff:type_name -> models.ProtoIdentityStateDiff
+	105, // 46: models.ProtoPredefinedState.Account.contractData:type_name -> models.ProtoPredefinedState.Account.ContractData
+	106, // 47: models.ProtoPredefinedState.Identity.flips:type_name -> models.ProtoPredefinedState.Identity.Flip
+	107, // 48: models.ProtoPredefinedState.Identity.invitees:type_name -> models.ProtoPredefinedState.Identity.TxAddr
+	108, // 49: models.ProtoPredefinedState.Identity.inviter:type_name -> models.ProtoPredefinedState.Identity.Inviter
+	110, // 50: models.ProtoTxReceipts.ProtoTxReceipt.events:type_name -> models.ProtoTxReceipts.ProtoEvent
+	51,  // [51:51] is the sub-list for method output_type
+	51,  // [51:51] is the sub-list for method input_type
+	51,  // [51:51] is the sub-list for extension type_name
+	51,  // [51:51] is the sub-list for extension extendee
+	0,   // [0:51] is the sub-list for field type_name
 }
 
 func init() { file_protobuf_models_proto_init() }
@@ -7820,8 +8713,116 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoBlockHeader); i {
+		file_protobuf_models_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoBlockHeader); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoBlockBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoBlockProposal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoIpfsFlip); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoBlockCert); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoWeakCertificates); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoTransactionIndex); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoFlipPrivateKeys); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoProfile); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7832,8 +8833,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoBlockBody); i {
+		file_protobuf_models_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoHandshake); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7844,8 +8845,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoBlock); i {
+		file_protobuf_models_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7856,8 +8857,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoBlockProposal); i {
+		file_protobuf_models_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoUpdateShardId); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7868,8 +8869,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoIpfsFlip); i {
+		file_protobuf_models_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoMsgBatch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7880,8 +8881,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoBlockCert); i {
+		file_protobuf_models_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoDisconnect); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7892,8 +8893,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoWeakCertificates); i {
+		file_protobuf_models_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoGetTransactionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7904,8 +8905,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoTransactionIndex); i {
+		file_protobuf_models_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoTransactions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7916,8 +8917,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoFlipPrivateKeys); i {
+		file_protobuf_models_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoGetPooledTransactionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7928,8 +8929,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoProfile); i {
+		file_protobuf_models_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoPooledTransactions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7940,8 +8941,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoHandshake); i {
+		file_protobuf_models_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoGetStateProofRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7952,8 +8953,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoMsg); i {
+		file_protobuf_models_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoStateProof); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7964,8 +8965,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoUpdateShardId); i {
+		file_protobuf_models_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoGetBlockHeadersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7976,8 +8977,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoMsgBatch); i {
+		file_protobuf_models_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoBlockHeaders); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7988,8 +8989,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoDisconnect); i {
+		file_protobuf_models_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoPing); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8000,7 +9001,55 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoGetFlipRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoFlipResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoNewBlockHash); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoIdentityStateDiff); i {
 			case 0:
 				return &v.state
@@ -8012,7 +9061,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoSnapshotBlock); i {
 			case 0:
 				return &v.state
@@ -8024,7 +9073,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoSnapshotNodes); i {
 			case 0:
 				return &v.state
@@ -8036,7 +9085,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoGossipBlockRange); i {
 			case 0:
 				return &v.state
@@ -8048,7 +9097,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoProposeProof); i {
 			case 0:
 				return &v.state
@@ -8060,7 +9109,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoVote); i {
 			case 0:
 				return &v.state
@@ -8072,7 +9121,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoGetBlockByHashRequest); i {
 			case 0:
 				return &v.state
@@ -8084,7 +9133,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoGetBlocksRangeRequest); i {
 			case 0:
 				return &v.state
@@ -8096,7 +9145,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoGetForkBlockRangeRequest); i {
 			case 0:
 				return &v.state
@@ -8108,7 +9157,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoFlip); i {
 			case 0:
 				return &v.state
@@ -8120,7 +9169,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoFlipKey); i {
 			case 0:
 				return &v.state
@@ -8132,7 +9181,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoManifest); i {
 			case 0:
 				return &v.state
@@ -8144,7 +9193,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoPrivateFlipKeysPackage); i {
 			case 0:
 				return &v.state
@@ -8156,7 +9205,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoPullPushHash); i {
 			case 0:
 				return &v.state
@@ -8168,7 +9217,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoSnapshotManifestDb); i {
 			case 0:
 				return &v.state
@@ -8180,7 +9229,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoShortAnswerDb); i {
 			case 0:
 				return &v.state
@@ -8192,7 +9241,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoAnswersDb); i {
 			case 0:
 				return &v.state
@@ -8204,7 +9253,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoBurntCoins); i {
 			case 0:
 				return &v.state
@@ -8216,7 +9265,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoSavedTransaction); i {
 			case 0:
 				return &v.state
@@ -8228,7 +9277,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoActivityMonitor); i {
 			case 0:
 				return &v.state
@@ -8240,7 +9289,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoShortAnswerAttachment); i {
 			case 0:
 				return &v.state
@@ -8252,7 +9301,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoLongAnswerAttachment); i {
 			case 0:
 				return &v.state
@@ -8264,7 +9313,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoFlipSubmitAttachment); i {
 			case 0:
 				return &v.state
@@ -8276,7 +9325,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoOnlineStatusAttachment); i {
 			case 0:
 				return &v.state
@@ -8288,7 +9337,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoBurnAttachment); i {
 			case 0:
 				return &v.state
@@ -8300,7 +9349,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoChangeProfileAttachment); i {
 			case 0:
 				return &v.state
@@ -8312,7 +9361,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoDeleteFlipAttachment); i {
 			case 0:
 				return &v.state
@@ -8324,7 +9373,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoStateAccount); i {
 			case 0:
 				return &v.state
@@ -8336,7 +9385,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoStateIdentity); i {
 			case 0:
 				return &v.state
@@ -8348,7 +9397,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoStateGlobal); i {
 			case 0:
 				return &v.state
@@ -8360,7 +9409,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoStateApprovedIdentity); i {
 			case 0:
 				return &v.state
@@ -8372,7 +9421,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoStateIdentityStatusSwitch); i {
 			case 0:
 				return &v.state
@@ -8384,7 +9433,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoStateDelegationSwitch); i {
 			case 0:
 				return &v.state
@@ -8396,7 +9445,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoStateDelayedPenalties); i {
 			case 0:
 				return &v.state
@@ -8408,7 +9457,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoStateBurntCoins); i {
 			case 0:
 				return &v.state
@@ -8420,7 +9469,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoPredefinedState); i {
 			case 0:
 				return &v.state
@@ -8432,7 +9481,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoCallContractAttachment); i {
 			case 0:
 				return &v.state
@@ -8444,7 +9493,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoDeployContractAttachment); i {
 			case 0:
 				return &v.state
@@ -8456,7 +9505,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoTerminateContractAttachment); i {
 			case 0:
 				return &v.state
@@ -8468,7 +9517,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoStoreToIpfsAttachment); i {
 			case 0:
 				return &v.state
@@ -8480,7 +9529,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoTxReceipts); i {
 			case 0:
 				return &v.state
@@ -8492,7 +9541,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoTxReceiptIndex); i {
 			case 0:
 				return &v.state
@@ -8504,7 +9553,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoDeferredTxs); i {
 			case 0:
 				return &v.state
@@ -8516,7 +9565,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoSavedEvent); i {
 			case 0:
 				return &v.state
@@ -8528,7 +9577,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoUpgradeVotes); i {
 			case 0:
 				return &v.state
@@ -8540,7 +9589,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoLotteryIdentitiesDb); i {
 			case 0:
 				return &v.state
@@ -8552,7 +9601,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoTransaction_Data); i {
 			case 0:
 				return &v.state
@@ -8564,7 +9613,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoBlockHeader_Proposed); i {
 			case 0:
 				return &v.state
@@ -8576,7 +9625,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoBlockHeader_Empty); i {
 			case 0:
 				return &v.state
@@ -8588,7 +9637,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoBlockProposal_Data); i {
 			case 0:
 				return &v.state
@@ -8600,7 +9649,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoBlockCert_Signature); i {
 			case 0:
 				return &v.state
@@ -8612,7 +9661,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoMsgBatch_BatchItem); i {
 			case 0:
 				return &v.state
@@ -8624,7 +9673,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoIdentityStateDiff_IdentityStateDiffValue); i {
 			case 0:
 				return &v.state
@@ -8636,7 +9685,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoSnapshotBlock_KeyValue); i {
 			case 0:
 				return &v.state
@@ -8648,7 +9697,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoSnapshotNodes_Node); i {
 			case 0:
 				return &v.state
@@ -8660,7 +9709,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoGossipBlockRange_Block); i {
 			case 0:
 				return &v.state
@@ -8672,7 +9721,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoProposeProof_Data); i {
 			case 0:
 				return &v.state
@@ -8684,7 +9733,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoVote_Data); i {
 			case 0:
 				return &v.state
@@ -8696,7 +9745,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoFlipKey_Data); i {
 			case 0:
 				return &v.state
@@ -8708,7 +9757,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoPrivateFlipKeysPackage_Data); i {
 			case 0:
 				return &v.state
@@ -8720,7 +9769,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoAnswersDb_Answer); i {
 			case 0:
 				return &v.state
@@ -8732,7 +9781,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoActivityMonitor_Activity); i {
 			case 0:
 				return &v.state
@@ -8744,7 +9793,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoStateAccount_ProtoContractData); i {
 			case 0:
 				return &v.state
@@ -8756,7 +9805,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoStateIdentity_Flip); i {
 			case 0:
 				return &v.state
@@ -8768,7 +9817,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoStateIdentity_TxAddr); i {
 			case 0:
 				return &v.state
@@ -8780,7 +9829,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoStateIdentity_Inviter); i {
 			case 0:
 				return &v.state
@@ -8792,7 +9841,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoStateGlobal_EmptyBlocksByShards); i {
 			case 0:
 				return &v.state
@@ -8804,7 +9853,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoStateGlobal_ShardSize); i {
 			case 0:
 				return &v.state
@@ -8816,7 +9865,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoStateDelegationSwitch_Delegation); i {
 			case 0:
 				return &v.state
@@ -8828,7 +9877,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoStateBurntCoins_Item); i {
 			case 0:
 				return &v.state
@@ -8840,7 +9889,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoPredefinedState_Global); i {
 			case 0:
 				return &v.state
@@ -8852,7 +9901,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoPredefinedState_StatusSwitch); i {
 			case 0:
 				return &v.state
@@ -8864,7 +9913,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoPredefinedState_Account); i {
 			case 0:
 				return &v.state
@@ -8876,7 +9925,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoPredefinedState_Identity); i {
 			case 0:
 				return &v.state
@@ -8888,7 +9937,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoPredefinedState_ApprovedIdentity); i {
 			case 0:
 				return &v.state
@@ -8900,7 +9949,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoPredefinedState_ContractKeyValue); i {
 			case 0:
 				return &v.state
@@ -8912,7 +9961,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoPredefinedState_Account_ContractData); i {
 			case 0:
 				return &v.state
@@ -8924,7 +9973,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoPredefinedState_Identity_Flip); i {
 			case 0:
 				return &v.state
@@ -8936,7 +9985,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoPredefinedState_Identity_TxAddr); i {
 			case 0:
 				return &v.state
@@ -8948,7 +9997,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoPredefinedState_Identity_Inviter); i {
 			case 0:
 				return &v.state
@@ -8960,7 +10009,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoTxReceipts_ProtoTxReceipt); i {
 			case 0:
 				return &v.state
@@ -8972,7 +10021,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoTxReceipts_ProtoEvent); i {
 			case 0:
 				return &v.state
@@ -8984,7 +10033,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoDeferredTxs_ProtoDeferredTx); i {
 			case 0:
 				return &v.state
@@ -8996,7 +10045,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoUpgradeVotes_ProtoUpgradeVote); i {
 			case 0:
 				return &v.state
@@ -9008,7 +10057,7 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_models_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoLotteryIdentitiesDb_Identity); i {
 			case 0:
 				return &v.state
@@ -9027,7 +10076,7 @@ func file_protobuf_models_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_protobuf_models_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   101,
+			NumMessages:   114,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
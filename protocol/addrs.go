@@ -0,0 +1,79 @@
+package protocol
+
+import (
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
+	"sort"
+	"time"
+)
+
+// maxAdvertisedAddrs limits the number of listen addresses sent in the handshake and accepted from a peer
+const maxAdvertisedAddrs = 16
+
+// isAdvertisable reports whether the address may be dialed by a remote peer, loopback, unspecified and link-local
+// addresses are not
+func isAdvertisable(addr multiaddr.Multiaddr) bool {
+	ip, err := manet.ToIP(addr)
+	if err != nil {
+		return false
+	}
+	return !ip.IsLoopback() && !ip.IsUnspecified() && !ip.IsLinkLocalUnicast()
+}
+
+// advertisedAddrs returns IPv4 and IPv6 addresses the host listens on or is reachable at after the port mapping,
+// they are sent to peers in the handshake, so peers can dial the node at any of them
+func (h *IdenaGossipHandler) advertisedAddrs() []multiaddr.Multiaddr {
+	var result []multiaddr.Multiaddr
+	for _, addr := range h.host.Addrs() {
+		if len(result) == maxAdvertisedAddrs {
+			break
+		}
+		if isAdvertisable(addr) {
+			result = append(result, addr)
+		}
+	}
+	return result
+}
+
+// parseAdvertisedAddrs decodes listen addresses of the peer handshake, invalid and not advertisable ones are skipped
+func parseAdvertisedAddrs(data [][]byte) []multiaddr.Multiaddr {
+	var result []multiaddr.Multiaddr
+	for _, bytes := range data {
+		if len(result) == maxAdvertisedAddrs {
+			break
+		}
+		addr, err := multiaddr.NewMultiaddrBytes(bytes)
+		if err != nil || !isAdvertisable(addr) {
+			continue
+		}
+		result = append(result, addr)
+	}
+	return result
+}
+
+// Endpoints returns all advertised addresses of the node with its id
+func (h *IdenaGossipHandler) Endpoints() []string {
+	addrs, err := peer.AddrInfoToP2pAddrs(&peer.AddrInfo{ID: h.host.ID(), Addrs: h.advertisedAddrs()})
+	if err != nil {
+		return nil
+	}
+	result := make([]string, 0, len(addrs))
+	for _, addr := range addrs {
+		result = append(result, addr.String())
+	}
+	return result
+}
+
+// sortAddrsByLatency moves addresses with the known latency to the front, the fastest first, other addresses keep
+// their order
+func sortAddrsByLatency(addrs []multiaddr.Multiaddr, latencies map[string]time.Duration) {
+	sort.SliceStable(addrs, func(i, j int) bool {
+		li, iok := latencies[addrs[i].String()]
+		lj, jok := latencies[addrs[j].String()]
+		if iok != jok {
+			return iok
+		}
+		return iok && li < lj
+	})
+}
@@ -0,0 +1,70 @@
+package protocol
+
+import (
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestParseAdvertisedAddrs(t *testing.T) {
+	public4 := multiaddr.StringCast("/ip4/10.0.0.1/tcp/40404")
+	public6 := multiaddr.StringCast("/ip6/2001:db8::1/tcp/40404")
+	data := [][]byte{
+		public4.Bytes(),
+		multiaddr.StringCast("/ip4/127.0.0.1/tcp/40404").Bytes(),
+		multiaddr.StringCast("/ip6/::/tcp/40404").Bytes(),
+		multiaddr.StringCast("/ip6/fe80::1/tcp/40404").Bytes(),
+		{0xff, 0x01},
+		public6.Bytes(),
+	}
+	require.Equal(t, []multiaddr.Multiaddr{public4, public6}, parseAdvertisedAddrs(data))
+
+	data = nil
+	for i := 0; i < maxAdvertisedAddrs+5; i++ {
+		data = append(data, public4.Bytes())
+	}
+	require.Len(t, parseAdvertisedAddrs(data), maxAdvertisedAddrs)
+}
+
+func TestSortAddrsByLatency(t *testing.T) {
+	a := multiaddr.StringCast("/ip4/10.0.0.1/tcp/1")
+	b := multiaddr.StringCast("/ip6/2001:db8::1/tcp/1")
+	c := multiaddr.StringCast("/ip4/10.0.0.2/tcp/1")
+	d := multiaddr.StringCast("/ip4/10.0.0.3/tcp/1")
+
+	addrs := []multiaddr.Multiaddr{a, b, c, d}
+	sortAddrsByLatency(addrs, nil)
+	require.Equal(t, []multiaddr.Multiaddr{a, b, c, d}, addrs)
+
+	sortAddrsByLatency(addrs, map[string]time.Duration{
+		c.String(): time.Millisecond * 80,
+		b.String(): time.Millisecond * 20,
+	})
+	require.Equal(t, []multiaddr.Multiaddr{b, c, a, d}, addrs)
+}
+
+func TestProtoPeer_Handshake_listenAddrs(t *testing.T) {
+	genesis := &types.GenesisInfo{
+		Genesis: &types.Header{ProposedHeader: &types.ProposedHeader{Height: 1}},
+	}
+	addrs := []multiaddr.Multiaddr{
+		multiaddr.StringCast("/ip4/10.0.0.1/tcp/40404"),
+		multiaddr.StringCast("/ip6/2001:db8::1/tcp/40404"),
+	}
+	local, remote := newTestPeer("peer")
+	localErr, remoteErr := make(chan error, 1), make(chan error, 1)
+	go func() {
+		localErr <- local.Handshake(types.Network(1), 10, common.Hash{}, genesis, "0.1.0", 0, 1, handshakeRules{})
+	}()
+	go func() {
+		remoteErr <- remote.Handshake(types.Network(1), 10, common.Hash{}, genesis, "0.1.0", 0, 1, handshakeRules{listenAddrs: addrs})
+	}()
+	require.NoError(t, <-localErr)
+	require.NoError(t, <-remoteErr)
+	require.Equal(t, addrs, local.listenAddrs)
+	require.Empty(t, remote.listenAddrs)
+	require.Equal(t, []string{addrs[0].String(), addrs[1].String()}, local.Snapshot().ListenAddrs)
+}
@@ -0,0 +1,119 @@
+package protocol
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	defaultSyncRetryInitialDelay = time.Second
+	defaultSyncRetryMaxDelay     = time.Second * 30
+	defaultSyncRetryMultiplier   = 2
+)
+
+// SyncBackoff calculates growing delays between attempts to load the same batch of blocks
+type SyncBackoff struct {
+	InitialDelay time.Duration
+	MaxDelay     time.Duration
+	Multiplier   float64
+
+	delay   time.Duration
+	retries int
+}
+
+// Next returns the delay before the next attempt
+func (b *SyncBackoff) Next() time.Duration {
+	if b.delay == 0 {
+		b.delay = b.InitialDelay
+	} else {
+		b.delay = time.Duration(float64(b.delay) * b.Multiplier)
+	}
+	if b.delay > b.MaxDelay {
+		b.delay = b.MaxDelay
+	}
+	b.retries++
+	return b.delay
+}
+
+func (b *SyncBackoff) Reset() {
+	b.delay = 0
+	b.retries = 0
+}
+
+// SyncStatus describes the latest retried batch of blocks
+type SyncStatus struct {
+	From    uint64
+	To      uint64
+	Retries int
+	Delay   time.Duration
+}
+
+// syncRetries keeps back-off state of batches by their last height since retries of a batch may start from different heights
+type syncRetries struct {
+	backoffs map[uint64]*SyncBackoff
+	last     SyncStatus
+	mutex    sync.Mutex
+}
+
+func (h *IdenaGossipHandler) newSyncBackoff() *SyncBackoff {
+	b := &SyncBackoff{
+		InitialDelay: h.cfg.SyncRetryInitialDelay,
+		MaxDelay:     h.cfg.SyncRetryMaxDelay,
+		Multiplier:   h.cfg.SyncRetryMultiplier,
+	}
+	if b.InitialDelay <= 0 {
+		b.InitialDelay = defaultSyncRetryInitialDelay
+	}
+	if b.MaxDelay <= 0 {
+		b.MaxDelay = defaultSyncRetryMaxDelay
+	}
+	if b.Multiplier < 1 {
+		b.Multiplier = defaultSyncRetryMultiplier
+	}
+	return b
+}
+
+// syncRetryDelay returns the delay before the next attempt to load blocks from..to
+func (h *IdenaGossipHandler) syncRetryDelay(from, to uint64) time.Duration {
+	h.syncRetries.mutex.Lock()
+	defer h.syncRetries.mutex.Unlock()
+	if h.syncRetries.backoffs == nil {
+		h.syncRetries.backoffs = make(map[uint64]*SyncBackoff)
+	}
+	b, ok := h.syncRetries.backoffs[to]
+	if !ok {
+		// the first retry goes to another peer right away, the back-off applies to the next ones
+		h.syncRetries.backoffs[to] = h.newSyncBackoff()
+		h.syncRetries.last = SyncStatus{
+			From:    from,
+			To:      to,
+			Retries: 1,
+		}
+		return 0
+	}
+	delay := b.Next()
+	h.syncRetries.last = SyncStatus{
+		From:    from,
+		To:      to,
+		Retries: b.retries + 1,
+		Delay:   delay,
+	}
+	return delay
+}
+
+// resetSyncBackoff is called when the batch ending at the given height is loaded or abandoned
+func (h *IdenaGossipHandler) resetSyncBackoff(to uint64) {
+	h.syncRetries.mutex.Lock()
+	defer h.syncRetries.mutex.Unlock()
+	delete(h.syncRetries.backoffs, to)
+	if h.syncRetries.last.To == to {
+		h.syncRetries.last = SyncStatus{}
+	}
+}
+
+// SyncStatus returns back-off state of the latest retried batch, zero value means that there are no retries
+func (h *IdenaGossipHandler) SyncStatus() SyncStatus {
+	h.syncRetries.mutex.Lock()
+	defer h.syncRetries.mutex.Unlock()
+	return h.syncRetries.last
+}
@@ -0,0 +1,42 @@
+package protocol
+
+import (
+	"github.com/idena-network/idena-go/config"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestSyncBackoff_Next(t *testing.T) {
+	b := &SyncBackoff{InitialDelay: time.Second, MaxDelay: time.Second * 5, Multiplier: 2}
+	var delays []time.Duration
+	for i := 0; i < 5; i++ {
+		delays = append(delays, b.Next())
+	}
+	require.Equal(t, []time.Duration{time.Second, time.Second * 2, time.Second * 4, time.Second * 5, time.Second * 5}, delays)
+	require.Equal(t, 5, b.retries)
+
+	b.Reset()
+	require.Equal(t, time.Second, b.Next())
+}
+
+func TestIdenaGossipHandler_syncRetryDelay(t *testing.T) {
+	h := &IdenaGossipHandler{
+		cfg: config.P2P{SyncRetryInitialDelay: time.Millisecond * 100, SyncRetryMultiplier: 3},
+	}
+	require.Equal(t, SyncStatus{}, h.SyncStatus())
+
+	require.Zero(t, h.syncRetryDelay(1, 200))
+	require.Equal(t, time.Millisecond*100, h.syncRetryDelay(1, 200))
+	// retry of the same batch from the middle keeps growing the delay
+	require.Equal(t, time.Millisecond*300, h.syncRetryDelay(50, 200))
+	require.Equal(t, SyncStatus{From: 50, To: 200, Retries: 3, Delay: time.Millisecond * 300}, h.SyncStatus())
+
+	require.Zero(t, h.syncRetryDelay(201, 400))
+	h.resetSyncBackoff(200)
+	require.Equal(t, uint64(400), h.SyncStatus().To)
+	require.Zero(t, h.syncRetryDelay(1, 200))
+
+	h.resetSyncBackoff(200)
+	require.Equal(t, SyncStatus{}, h.SyncStatus())
+}
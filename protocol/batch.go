@@ -6,6 +6,8 @@ import (
 	"github.com/idena-network/idena-go/core/state"
 	models "github.com/idena-network/idena-go/protobuf"
 	"github.com/libp2p/go-libp2p-core/peer"
+	"sync"
+	"sync/atomic"
 )
 
 type batch struct {
@@ -13,6 +15,29 @@ type batch struct {
 	from    uint64
 	to      uint64
 	headers chan *block
+	// number of range requests the batch is split into which are not answered yet
+	parts int32
+}
+
+// completePart reports whether the last part of the batch is received
+func (b *batch) completePart() bool {
+	return atomic.AddInt32(&b.parts, -1) <= 0
+}
+
+// splitRange splits [from, to] into consecutive ranges containing at most maxBlocks blocks
+func splitRange(from, to uint64, maxBlocks uint64) [][2]uint64 {
+	var result [][2]uint64
+	for start := from; start <= to; start += maxBlocks {
+		end := start + maxBlocks - 1
+		if end > to || end < start {
+			end = to
+		}
+		result = append(result, [2]uint64{start, end})
+		if end == to {
+			break
+		}
+	}
+	return result
 }
 
 type block struct {
@@ -29,28 +54,161 @@ type blockPeer struct {
 type blockRange struct {
 	BatchId uint32
 	Blocks  []*block
+	// More is set when the range is split into several messages and it isn't the last one
+	More bool
+}
+
+// blockRangeQueue holds block ranges waiting to be sent to a peer, the depth is limited by the number of batches.
+// Batch ids grow with every request, so when the queue is full a range of a newer batch replaces the queued ranges
+// of the oldest batch, they are likely re-requested by the peer already. A batch is kept or dropped as a whole:
+// parts of a queued batch are always added, the batch which parts are being sent is never evicted
+// and the remaining parts of an evicted or rejected batch are dropped
+type blockRangeQueue struct {
+	ranges   []*blockRange
+	maxDepth int
+	// inFlight is the batch which first parts are sent already, valid while sending is set
+	inFlight uint32
+	sending  bool
+	// dropped is the last evicted or rejected batch, valid while hasDropped is set
+	dropped    uint32
+	hasDropped bool
+	// ready is signaled while the queue is not empty
+	ready chan struct{}
+	mutex sync.Mutex
+}
+
+func newBlockRangeQueue(maxDepth int) *blockRangeQueue {
+	return &blockRangeQueue{
+		maxDepth: maxDepth,
+		ready:    make(chan struct{}, 1),
+	}
+}
+
+// TryEnqueue adds the range to the queue and returns the number of evicted stale ranges,
+// the range is not added if its batch is dropped or the queue is full and there are no evictable older batches
+func (q *blockRangeQueue) TryEnqueue(r *blockRange) (evicted int, ok bool) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	if q.hasDropped && q.dropped == r.BatchId {
+		return 0, false
+	}
+	batches := make(map[uint32]struct{})
+	for _, queued := range q.ranges {
+		batches[queued.BatchId] = struct{}{}
+	}
+	_, queued := batches[r.BatchId]
+	if !queued && !q.isInFlight(r.BatchId) && len(batches) >= q.maxDepth {
+		stale, found := r.BatchId, false
+		for batchId := range batches {
+			if !q.isInFlight(batchId) && batchId < stale {
+				stale, found = batchId, true
+			}
+		}
+		if !found {
+			q.drop(r.BatchId)
+			return 0, false
+		}
+		kept := q.ranges[:0]
+		for _, queued := range q.ranges {
+			if queued.BatchId != stale {
+				kept = append(kept, queued)
+			}
+		}
+		evicted = len(q.ranges) - len(kept)
+		for i := len(kept); i < len(q.ranges); i++ {
+			q.ranges[i] = nil
+		}
+		q.ranges = kept
+		q.drop(stale)
+	}
+	q.ranges = append(q.ranges, r)
+	q.signal()
+	return evicted, true
+}
+
+// Dequeue returns the oldest queued range or nil if the queue is empty
+func (q *blockRangeQueue) Dequeue() *blockRange {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	if len(q.ranges) == 0 {
+		return nil
+	}
+	r := q.ranges[0]
+	q.ranges[0] = nil
+	q.ranges = q.ranges[1:]
+	q.inFlight, q.sending = r.BatchId, r.More
+	if len(q.ranges) > 0 {
+		q.signal()
+	}
+	return r
+}
+
+func (q *blockRangeQueue) isInFlight(batchId uint32) bool {
+	return q.sending && q.inFlight == batchId
+}
+
+func (q *blockRangeQueue) drop(batchId uint32) {
+	q.dropped, q.hasDropped = batchId, true
+}
+
+func (q *blockRangeQueue) Len() int {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	return len(q.ranges)
+}
+
+func (q *blockRangeQueue) signal() {
+	select {
+	case q.ready <- struct{}{}:
+	default:
+	}
+}
+
+func (b *block) toProto() *models.ProtoGossipBlockRange_Block {
+	protoObj := new(models.ProtoGossipBlockRange_Block)
+	if b.Header != nil {
+		protoObj.Header = b.Header.ToProto()
+	}
+	if b.Cert != nil {
+		protoObj.Cert = b.Cert.ToProto()
+	}
+	if b.IdentityDiff != nil {
+		protoObj.Diff = b.IdentityDiff.ToProto()
+	}
+	return protoObj
 }
 
 func (r *blockRange) ToBytes() ([]byte, error) {
 	protoObj := &models.ProtoGossipBlockRange{
 		BatchId: r.BatchId,
+		More:    r.More,
 	}
 	for _, item := range r.Blocks {
-		b := new(models.ProtoGossipBlockRange_Block)
-		if item.Header != nil {
-			b.Header = item.Header.ToProto()
-		}
-		if item.Cert != nil {
-			b.Cert = item.Cert.ToProto()
-		}
-		if item.IdentityDiff != nil {
-			b.Diff = item.IdentityDiff.ToProto()
-		}
-		protoObj.Blocks = append(protoObj.Blocks, b)
+		protoObj.Blocks = append(protoObj.Blocks, item.toProto())
 	}
 	return proto.Marshal(protoObj)
 }
 
+// splitBlockRange splits blocks into parts with approximate encoded size not exceeding maxSize, a block larger than maxSize forms a part on its own
+func splitBlockRange(blocks []*block, maxSize int) [][]*block {
+	if maxSize <= 0 || len(blocks) == 0 {
+		return [][]*block{blocks}
+	}
+	var result [][]*block
+	var part []*block
+	size := 0
+	for _, b := range blocks {
+		blockSize := proto.Size(b.toProto())
+		if len(part) > 0 && size+blockSize > maxSize {
+			result = append(result, part)
+			part, size = nil, 0
+		}
+		part = append(part, b)
+		size += blockSize
+	}
+	return append(result, part)
+}
+
 func (r *blockRange) FromBytes(data []byte) error {
 	protoObj := new(models.ProtoGossipBlockRange)
 	if err := proto.Unmarshal(data, protoObj); err != nil {
@@ -58,6 +216,7 @@ func (r *blockRange) FromBytes(data []byte) error {
 	}
 
 	r.BatchId = protoObj.BatchId
+	r.More = protoObj.More
 	for _, item := range protoObj.Blocks {
 		b := new(block)
 		if item.Header != nil {
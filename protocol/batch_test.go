@@ -0,0 +1,121 @@
+package protocol
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func Test_splitBlockRange(t *testing.T) {
+	var blocks []*block
+	for i := uint64(1); i <= 10; i++ {
+		blocks = append(blocks, &block{
+			Header: &types.Header{ProposedHeader: &types.ProposedHeader{Height: i, TxBloom: make([]byte, 100)}},
+		})
+	}
+	blockSize := proto.Size(blocks[0].toProto())
+
+	require.Len(t, splitBlockRange(blocks, 0), 1)
+
+	parts := splitBlockRange(blocks, blockSize*3)
+	require.Len(t, parts, 4)
+	var joined []*block
+	for _, part := range parts {
+		require.NotEmpty(t, part)
+		joined = append(joined, part...)
+	}
+	require.Equal(t, blocks, joined)
+
+	// each block exceeds the limit and is sent separately
+	require.Len(t, splitBlockRange(blocks, 10), 10)
+
+	data, err := (&blockRange{BatchId: 1, Blocks: parts[0], More: true}).ToBytes()
+	require.NoError(t, err)
+	decoded := new(blockRange)
+	require.NoError(t, decoded.FromBytes(data))
+	require.True(t, decoded.More)
+	require.Len(t, decoded.Blocks, len(parts[0]))
+}
+
+func TestBlockRangeQueue_TryEnqueue(t *testing.T) {
+	q := newBlockRangeQueue(2)
+	batchIds := func() []uint32 {
+		var result []uint32
+		for _, r := range q.ranges {
+			result = append(result, r.BatchId)
+		}
+		return result
+	}
+
+	for _, id := range []uint32{5, 3, 3, 3} {
+		evicted, ok := q.TryEnqueue(&blockRange{BatchId: id})
+		require.True(t, ok)
+		require.Zero(t, evicted)
+	}
+
+	// older batches are rejected with all their parts
+	_, ok := q.TryEnqueue(&blockRange{BatchId: 2})
+	require.False(t, ok)
+	_, ok = q.TryEnqueue(&blockRange{BatchId: 2})
+	require.False(t, ok)
+	require.Equal(t, []uint32{5, 3, 3, 3}, batchIds())
+
+	// all parts of the oldest batch are replaced, its remaining parts are dropped
+	evicted, ok := q.TryEnqueue(&blockRange{BatchId: 4})
+	require.True(t, ok)
+	require.Equal(t, 3, evicted)
+	require.Equal(t, []uint32{5, 4}, batchIds())
+	_, ok = q.TryEnqueue(&blockRange{BatchId: 3})
+	require.False(t, ok)
+
+	require.Equal(t, uint32(5), q.Dequeue().BatchId)
+	<-q.ready
+	require.Equal(t, uint32(4), q.Dequeue().BatchId)
+	require.Nil(t, q.Dequeue())
+	require.Zero(t, q.Len())
+}
+
+func TestBlockRangeQueue_TryEnqueue_inFlight(t *testing.T) {
+	q := newBlockRangeQueue(2)
+	for _, r := range []*blockRange{{BatchId: 1, More: true}, {BatchId: 1, More: true}, {BatchId: 3}} {
+		_, ok := q.TryEnqueue(r)
+		require.True(t, ok)
+	}
+	require.Equal(t, uint32(1), q.Dequeue().BatchId)
+
+	// the batch which is being sent is not evicted and its last part is still added
+	evicted, ok := q.TryEnqueue(&blockRange{BatchId: 4})
+	require.True(t, ok)
+	require.Equal(t, 1, evicted)
+	_, ok = q.TryEnqueue(&blockRange{BatchId: 1})
+	require.True(t, ok)
+
+	var sent []*blockRange
+	for r := q.Dequeue(); r != nil; r = q.Dequeue() {
+		sent = append(sent, r)
+	}
+	require.Equal(t, []*blockRange{{BatchId: 1, More: true}, {BatchId: 4}, {BatchId: 1}}, sent)
+}
+
+func TestProtoPeer_sendBlockRange(t *testing.T) {
+	p, remote := newTestPeer("peer")
+	for i := uint32(1); i <= blockRangeQueueSize+1; i++ {
+		p.sendMsg(BlocksRange, &blockRange{BatchId: i}, common.MultiShard, false)
+	}
+	require.Equal(t, blockRangeQueueSize, p.blockRanges.Len())
+	require.Equal(t, uint64(1), p.Snapshot().DroppedMessages)
+	go p.broadcast()
+
+	require.NoError(t, remote.stream.SetReadDeadline(time.Now().Add(time.Second)))
+	for i := uint32(2); i <= blockRangeQueueSize+1; i++ {
+		msg, err := remote.ReadMsg()
+		require.NoError(t, err)
+		require.Equal(t, uint64(BlocksRange), msg.Code)
+		var r blockRange
+		require.NoError(t, r.FromBytes(msg.Payload))
+		require.Equal(t, i, r.BatchId)
+	}
+}
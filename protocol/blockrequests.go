@@ -0,0 +1,120 @@
+package protocol
+
+import (
+	"github.com/idena-network/idena-go/common"
+	models "github.com/idena-network/idena-go/protobuf"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"time"
+)
+
+const (
+	blockRequestPeers    = 3
+	blockRequestAttempts = 3
+)
+
+// blockRequest is a block requested by hash, it is sent to a few peers at a time and retried with other peers
+// until the block arrives or the attempts are exhausted
+type blockRequest struct {
+	hash     common.Hash
+	attempts int
+	sent     time.Time
+	asked    map[peer.ID]struct{}
+}
+
+// RequestBlockByHash requests the block from the peers with the lowest latency, only some of them may have the block,
+// so the requests are optional and an unanswered attempt is retried with other peers
+func (h *IdenaGossipHandler) RequestBlockByHash(hash common.Hash) {
+	h.blockRequestsMutex.Lock()
+	if h.blockRequests == nil {
+		h.blockRequests = make(map[common.Hash]*blockRequest)
+	}
+	// a request whose peers have disconnected is never retried by timeouts, so it's restarted by the next call
+	if req, ok := h.blockRequests[hash]; ok && time.Since(req.sent) < blockByHashRequestTimeout*2 {
+		h.blockRequestsMutex.Unlock()
+		return
+	}
+	req := &blockRequest{
+		hash:  hash,
+		asked: make(map[peer.ID]struct{}),
+	}
+	h.blockRequests[hash] = req
+	peers := h.nextBlockRequestAttempt(req)
+	h.blockRequestsMutex.Unlock()
+	sendBlockRequest(req.hash, peers)
+}
+
+// nextBlockRequestAttempt registers the next attempt of the request for peers which haven't been asked yet and returns them,
+// it's called under blockRequestsMutex
+func (h *IdenaGossipHandler) nextBlockRequestAttempt(req *blockRequest) []*protoPeer {
+	var ids []peer.ID
+	for _, p := range h.peers.Peers() {
+		if p.light {
+			continue
+		}
+		if _, ok := req.asked[p.id]; !ok {
+			ids = append(ids, p.id)
+		}
+	}
+	h.sortPeersByLatency(ids)
+	if len(ids) > blockRequestPeers {
+		ids = ids[:blockRequestPeers]
+	}
+	if len(ids) == 0 || req.attempts >= blockRequestAttempts {
+		h.log.Debug("Block request failed", "hash", req.hash.Hex(), "attempts", req.attempts)
+		delete(h.blockRequests, req.hash)
+		return nil
+	}
+	req.attempts++
+	req.sent = time.Now()
+	var peers []*protoPeer
+	for _, id := range ids {
+		p := h.peers.Peer(id)
+		if p == nil {
+			continue
+		}
+		req.asked[id] = struct{}{}
+		p.pendingRequests.AddOptional(GetBlockByHash, req.hash, blockByHashRequestTimeout, func() {
+			h.retryBlockRequest(req)
+		})
+		peers = append(peers, p)
+	}
+	return peers
+}
+
+func sendBlockRequest(hash common.Hash, peers []*protoPeer) {
+	request := &models.ProtoGetBlockByHashRequest{
+		Hash: hash[:],
+	}
+	for _, p := range peers {
+		p.sendMsg(GetBlockByHash, request, common.MultiShard, false)
+	}
+}
+
+// retryBlockRequest sends the next attempt once the current one is timed out, peers of the same attempt expire together,
+// so only the first of their timeouts starts a new attempt
+func (h *IdenaGossipHandler) retryBlockRequest(req *blockRequest) {
+	h.blockRequestsMutex.Lock()
+	if h.blockRequests[req.hash] != req || time.Since(req.sent) < blockByHashRequestTimeout {
+		h.blockRequestsMutex.Unlock()
+		return
+	}
+	peers := h.nextBlockRequestAttempt(req)
+	h.blockRequestsMutex.Unlock()
+	sendBlockRequest(req.hash, peers)
+}
+
+// completeBlockRequest stops the request once the block has arrived from any peer, requests of other peers are cancelled
+func (h *IdenaGossipHandler) completeBlockRequest(hash common.Hash) {
+	h.blockRequestsMutex.Lock()
+	req, ok := h.blockRequests[hash]
+	delete(h.blockRequests, hash)
+	h.blockRequestsMutex.Unlock()
+	if !ok {
+		return
+	}
+	for id := range req.asked {
+		if p := h.peers.Peer(id); p != nil {
+			p.pendingRequests.CancelByKey(GetBlockByHash, hash)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package protocol
+
+import (
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/log"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/require"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestIdenaGossipHandler_RequestBlockByHash(t *testing.T) {
+	h := &IdenaGossipHandler{
+		peers: newPeerSet(),
+		log:   log.New(),
+	}
+	var peers []*protoPeer
+	for i, id := range []peer.ID{"peer1", "peer2", "peer3", "peer4", "peer5"} {
+		p, _ := newTestPeer(id)
+		p.updateLatency(time.Duration(i+1) * time.Millisecond)
+		require.NoError(t, h.peers.Register(p))
+		peers = append(peers, p)
+	}
+	hash := common.Hash{0x1}
+	asked := func() []int {
+		var result []int
+		for _, p := range peers {
+			result = append(result, p.pendingRequests.Len())
+		}
+		return result
+	}
+
+	h.RequestBlockByHash(hash)
+	require.Equal(t, []int{1, 1, 1, 0, 0}, asked())
+	h.RequestBlockByHash(hash)
+	require.Equal(t, []int{1, 1, 1, 0, 0}, asked())
+
+	// peers without the block don't respond, the request is retried with other peers and the timeouts aren't penalized
+	h.blockRequests[hash].sent = time.Now().Add(-blockByHashRequestTimeout)
+	expiry := time.Now().Add(blockByHashRequestTimeout)
+	for _, p := range peers[:3] {
+		h.expirePendingRequests(p, expiry)
+		require.Zero(t, atomic.LoadUint32(&p.timeouts))
+	}
+	require.Equal(t, []int{0, 0, 0, 1, 1}, asked())
+
+	// the block arrives, the request of the other peer is cancelled
+	require.True(t, peers[3].pendingRequests.ResolveByKey(GetBlockByHash, hash))
+	require.NotZero(t, peers[3].pendingRequests.ResponseTime())
+	h.completeBlockRequest(hash)
+	require.Equal(t, []int{0, 0, 0, 0, 0}, asked())
+	require.Zero(t, peers[4].pendingRequests.ResponseTime())
+	require.Empty(t, h.blockRequests)
+
+	// all peers are asked and nobody has the block
+	h.RequestBlockByHash(hash)
+	for attempt := 0; attempt < blockRequestAttempts && len(h.blockRequests) > 0; attempt++ {
+		h.blockRequests[hash].sent = time.Now().Add(-blockByHashRequestTimeout)
+		expiry := time.Now().Add(blockByHashRequestTimeout)
+		for _, p := range peers {
+			h.expirePendingRequests(p, expiry)
+		}
+	}
+	require.Equal(t, []int{0, 0, 0, 0, 0}, asked())
+	require.Empty(t, h.blockRequests)
+}
@@ -0,0 +1,90 @@
+package protocol
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"math"
+	"sync"
+)
+
+// knownTxsFilterCapacity is the number of transaction pushes remembered by a single generation of the known txs filter
+const knownTxsFilterCapacity = 2000
+
+// knownFlipsFilterCapacity is the number of flip pushes remembered by a single generation of the known flips filter
+const knownFlipsFilterCapacity = 2000
+
+// rollingBloom is a Bloom filter of two generations, the older one is dropped once the current one is full,
+// so at least the last capacity keys are always reported as known. Lookups may return false positives
+// with a probability not higher than the configured rate, false negatives are possible only for keys older than two generations
+type rollingBloom struct {
+	generations [2][]uint64
+	bits        uint64
+	hashes      int
+	capacity    int
+	count       int
+	mutex       sync.Mutex
+}
+
+func newRollingBloom(capacity int, fpRate float64) *rollingBloom {
+	// a key may be falsely found in any of two generations, so each one is sized for a half of the rate
+	rate := fpRate / 2
+	bits := uint64(math.Ceil(-float64(capacity) * math.Log(rate) / (math.Ln2 * math.Ln2)))
+	hashes := int(math.Ceil(float64(bits) / float64(capacity) * math.Ln2))
+	words := (bits + 63) / 64
+	return &rollingBloom{
+		generations: [2][]uint64{make([]uint64, words), make([]uint64, words)},
+		bits:        words * 64,
+		hashes:      hashes,
+		capacity:    capacity,
+	}
+}
+
+func (b *rollingBloom) Add(key string) {
+	h1, h2 := bloomHashes(key)
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if b.count >= b.capacity {
+		b.generations[0], b.generations[1] = b.generations[1], b.generations[0]
+		for i := range b.generations[0] {
+			b.generations[0][i] = 0
+		}
+		b.count = 0
+	}
+	current := b.generations[0]
+	for i := 0; i < b.hashes; i++ {
+		bit := (h1 + uint64(i)*h2) % b.bits
+		current[bit/64] |= 1 << (bit % 64)
+	}
+	b.count++
+}
+
+func (b *rollingBloom) Has(key string) bool {
+	h1, h2 := bloomHashes(key)
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	for _, generation := range b.generations {
+		found := true
+		for i := 0; i < b.hashes; i++ {
+			bit := (h1 + uint64(i)*h2) % b.bits
+			if generation[bit/64]&(1<<(bit%64)) == 0 {
+				found = false
+				break
+			}
+		}
+		if found {
+			return true
+		}
+	}
+	return false
+}
+
+// bloomHashes returns two hashes of the key for double hashing, message keys are hashes themselves so their bytes are used directly
+func bloomHashes(key string) (uint64, uint64) {
+	if len(key) >= 16 {
+		return binary.LittleEndian.Uint64([]byte(key[:8])), binary.LittleEndian.Uint64([]byte(key[8:16])) | 1
+	}
+	h := fnv.New64a()
+	h.Write([]byte(key))
+	sum := h.Sum64()
+	return sum, (sum>>32 | sum<<32) | 1
+}
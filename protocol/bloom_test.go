@@ -0,0 +1,135 @@
+package protocol
+
+import (
+	"github.com/idena-network/idena-go/common/knowncache"
+	"github.com/stretchr/testify/require"
+	"math/rand"
+	"testing"
+)
+
+func randomKeys(rnd *rand.Rand, count int) []string {
+	keys := make([]string, count)
+	for i := range keys {
+		data := make([]byte, 32)
+		rnd.Read(data)
+		keys[i] = msgKey(data)
+	}
+	return keys
+}
+
+func TestRollingBloom(t *testing.T) {
+	const capacity = 1000
+	const fpRate = 0.01
+	rnd := rand.New(rand.NewSource(1))
+	filter := newRollingBloom(capacity, fpRate)
+
+	// both generations are almost full
+	added := randomKeys(rnd, capacity*2-1)
+	for _, key := range added {
+		filter.Add(key)
+	}
+	for _, key := range added {
+		require.True(t, filter.Has(key))
+	}
+
+	falsePositives := 0
+	sample := randomKeys(rnd, 20000)
+	for _, key := range sample {
+		if filter.Has(key) {
+			falsePositives++
+		}
+	}
+	require.LessOrEqual(t, float64(falsePositives)/float64(len(sample)), fpRate)
+
+	// the current generation gets full and the oldest one is dropped
+	for _, key := range randomKeys(rnd, 2) {
+		filter.Add(key)
+	}
+	forgotten := 0
+	for _, key := range added[:capacity] {
+		if !filter.Has(key) {
+			forgotten++
+		}
+	}
+	require.Greater(t, forgotten, capacity*9/10)
+	for _, key := range added[capacity:] {
+		require.True(t, filter.Has(key))
+	}
+}
+
+func TestProtoPeer_knownTxs(t *testing.T) {
+	p, _ := newTestPeer("peer")
+	p.knownTxs = newRollingBloom(knownTxsFilterCapacity, 0.001)
+	hash := pushPullHash{Type: pushTx}
+	data, _ := hash.ToBytes()
+	key := msgKey(data)
+
+	require.False(t, p.knowsMsg(Push, hash, key))
+	p.markMsg(Push, hash, key, msgCacheAliveTime)
+	require.True(t, p.knowsMsg(Push, hash, key))
+	// transaction pushes don't occupy the message cache
+	require.False(t, p.knowsKey(key))
+
+	flipHash := pushPullHash{Type: pushFlip}
+	p.markMsg(Push, flipHash, "flip", msgCacheAliveTime)
+	require.True(t, p.knowsKey("flip"))
+}
+
+func BenchmarkKnownTxs(b *testing.B) {
+	keys := randomKeys(rand.New(rand.NewSource(1)), knownTxsFilterCapacity)
+	b.Run("cache/fill", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			c := knowncache.New(defaultMsgCacheSize, msgCacheAliveTime)
+			for _, key := range keys {
+				c.Add(key)
+			}
+		}
+	})
+	b.Run("bloom/fill", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			filter := newRollingBloom(knownTxsFilterCapacity, 0.001)
+			for _, key := range keys {
+				filter.Add(key)
+			}
+		}
+	})
+	b.Run("cache/lookup", func(b *testing.B) {
+		c := knowncache.New(defaultMsgCacheSize, msgCacheAliveTime)
+		for _, key := range keys {
+			c.Add(key)
+		}
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			c.Has(keys[i%len(keys)])
+		}
+	})
+	b.Run("bloom/lookup", func(b *testing.B) {
+		filter := newRollingBloom(knownTxsFilterCapacity, 0.001)
+		for _, key := range keys {
+			filter.Add(key)
+		}
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			filter.Has(keys[i%len(keys)])
+		}
+	})
+}
+
+func TestProtoPeer_knownFlips(t *testing.T) {
+	p, _ := newTestPeer("peer")
+	p.knownFlips = newRollingBloom(knownFlipsFilterCapacity, 0.001)
+	hash := pushPullHash{Type: pushFlip}
+	data, _ := hash.ToBytes()
+	key := msgKey(data)
+
+	require.False(t, p.knowsMsg(Push, hash, key))
+	p.markMsg(Push, hash, key, msgCacheAliveTime)
+	require.True(t, p.knowsMsg(Push, hash, key))
+	require.False(t, p.knowsKey(key))
+
+	p.markFlipKey("flip")
+	require.True(t, p.knownFlips.Has("flip"))
+	require.False(t, p.knowsKey("flip"))
+}
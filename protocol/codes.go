@@ -1,10 +1,46 @@
 package protocol
 
-import "github.com/coreos/go-semver/semver"
+import (
+	"fmt"
+	"github.com/coreos/go-semver/semver"
+	"math"
+	"strings"
+)
 
 type PeerFeature = string
 
-const Batches = PeerFeature("batches")
+const (
+	Batches        = PeerFeature("batches")
+	BlockAnnounce  = PeerFeature("blockAnnounce")
+	VoteBatches    = PeerFeature("voteBatches")
+	PingPong       = PeerFeature("pingPong")
+	TxPull         = PeerFeature("txPull")
+	SplitRanges    = PeerFeature("splitRanges")
+	PoolSync       = PeerFeature("poolSync")
+	StateProofs    = PeerFeature("stateProofs")
+	LightClients   = PeerFeature("lightClients")
+	StatusUpdates  = PeerFeature("statusUpdates")
+	FlipRequests   = PeerFeature("flipRequests")
+	HeaderRequests = PeerFeature("headerRequests")
+)
+
+// CurrentProtocolVersion is sent to peers during handshake, peers which don't send their version are considered to run legacyProtocolVersion
+const CurrentProtocolVersion = uint32(12)
+
+const (
+	legacyProtocolVersion         = uint32(1)
+	blockAnnounceProtocolVersion  = uint32(2)
+	voteBatchesProtocolVersion    = uint32(3)
+	pingPongProtocolVersion       = uint32(4)
+	txPullProtocolVersion         = uint32(5)
+	splitRangesProtocolVersion    = uint32(6)
+	poolSyncProtocolVersion       = uint32(7)
+	stateProofsProtocolVersion    = uint32(8)
+	lightClientsProtocolVersion   = uint32(9)
+	statusUpdatesProtocolVersion  = uint32(10)
+	flipRequestsProtocolVersion   = uint32(11)
+	headerRequestsProtocolVersion = uint32(12)
+)
 
 const (
 	Handshake         = 0x01
@@ -27,8 +63,105 @@ const (
 	BatchPush         = 0x12
 	BatchFlipKey      = 0x13
 	Disconnect        = 0x14
+	NewBlockHash      = 0x15
+	BatchVote         = 0x16
+	Ping              = 0x17
+	Pong              = 0x18
+	GetTransactions   = 0x19
+	Transactions      = 0x1A
+	// GetPooledTransactions asks for hashes of transactions in the peer mempool
+	GetPooledTransactions = 0x1B
+	PooledTransactions    = 0x1C
+	// GetStateProof asks for Merkle proofs of an address state, light clients verify them against the state root of a block
+	GetStateProof = 0x1D
+	StateProof    = 0x1E
+	// GetBlockHeaders asks for canonical headers starting from a height with an optional skip between them, light clients
+	// download headers only and full nodes compare them with the own chain before blocks are requested
+	GetBlockHeaders = 0x1F
+	BlockHeaders    = 0x20
+	// Status reports the head of the peer periodically, so its known height stays fresh without other messages
+	Status = 0x21
+	// GetFlip asks for a flip by its cid, peers answer with the flips they have loaded for the validation ceremony
+	GetFlip = 0x22
+	Flip    = 0x23
 )
 
+type DiscReason uint8
+
+const (
+	DiscQuitting DiscReason = iota
+	DiscWriteError
+	DiscWriteTimeout
+	DiscHandshakeFailed
+	DiscTooManySkippedRequests
+	DiscHighPriorityTimeout
+	DiscNoSlots
+	DiscUnnecessaryShard
+	DiscRenewPeers
+	DiscLowScore
+	DiscRateLimit
+	DiscRequestTimeouts
+	DiscPingTimeout
+	DiscStalled
+	DiscSelfConnection
+	DiscAlreadyConnected
+	DiscRemoved
+	DiscRequested
+)
+
+func (r DiscReason) String() string {
+	switch r {
+	case DiscQuitting:
+		return "quitting"
+	case DiscWriteError:
+		return "error while writing to stream"
+	case DiscWriteTimeout:
+		return "timeout while writing to stream"
+	case DiscHandshakeFailed:
+		return "handshake failed"
+	case DiscTooManySkippedRequests:
+		return "too many skipped requests"
+	case DiscHighPriorityTimeout:
+		return "timeout while sending message (high priority)"
+	case DiscNoSlots:
+		return "no slots for shard"
+	case DiscUnnecessaryShard:
+		return "unnecessary shard"
+	case DiscRenewPeers:
+		return "peer was selected to disconnect while renewing peers"
+	case DiscLowScore:
+		return "low score"
+	case DiscRateLimit:
+		return "rate limit exceeded"
+	case DiscRequestTimeouts:
+		return "too many request timeouts"
+	case DiscPingTimeout:
+		return "ping timeout"
+	case DiscStalled:
+		return "requests stalled"
+	case DiscSelfConnection:
+		return "connection to self"
+	case DiscAlreadyConnected:
+		return "peer is already connected"
+	case DiscRemoved:
+		return "peer was removed by operator"
+	case DiscRequested:
+		return "disconnect was requested by operator"
+	default:
+		return fmt.Sprintf("unknown reason %d", r)
+	}
+}
+
+// notifyRemote reports whether the reason should be sent to remote peer, it makes no sense when the stream is broken or was never established
+func (r DiscReason) notifyRemote() bool {
+	switch r {
+	case DiscQuitting, DiscWriteError, DiscWriteTimeout, DiscHandshakeFailed:
+		return false
+	default:
+		return true
+	}
+}
+
 var batchSupportVersion *semver.Version
 
 func init() {
@@ -39,4 +172,200 @@ func SetSupportedFeatures(peer *protoPeer) {
 	if peer.version.Compare(*batchSupportVersion) >= 0 {
 		peer.supportedFeatures[Batches] = struct{}{}
 	}
+	if peer.protocolVersion >= blockAnnounceProtocolVersion {
+		peer.supportedFeatures[BlockAnnounce] = struct{}{}
+	}
+	if peer.protocolVersion >= voteBatchesProtocolVersion {
+		peer.supportedFeatures[VoteBatches] = struct{}{}
+	}
+	if peer.protocolVersion >= pingPongProtocolVersion {
+		peer.supportedFeatures[PingPong] = struct{}{}
+	}
+	if peer.protocolVersion >= txPullProtocolVersion {
+		peer.supportedFeatures[TxPull] = struct{}{}
+	}
+	if peer.protocolVersion >= splitRangesProtocolVersion {
+		peer.supportedFeatures[SplitRanges] = struct{}{}
+	}
+	if peer.protocolVersion >= poolSyncProtocolVersion {
+		peer.supportedFeatures[PoolSync] = struct{}{}
+	}
+	if peer.protocolVersion >= stateProofsProtocolVersion {
+		peer.supportedFeatures[StateProofs] = struct{}{}
+	}
+	if peer.protocolVersion >= lightClientsProtocolVersion {
+		peer.supportedFeatures[LightClients] = struct{}{}
+	}
+	if peer.protocolVersion >= statusUpdatesProtocolVersion {
+		peer.supportedFeatures[StatusUpdates] = struct{}{}
+	}
+	if peer.protocolVersion >= flipRequestsProtocolVersion {
+		peer.supportedFeatures[FlipRequests] = struct{}{}
+	}
+	if peer.protocolVersion >= headerRequestsProtocolVersion {
+		peer.supportedFeatures[HeaderRequests] = struct{}{}
+	}
+}
+
+// isConsensusMsg reports whether the message is critical for consensus and should be sent before any other
+func isConsensusMsg(code uint64) bool {
+	switch code {
+	case Vote, BatchVote, ProposeProof, ProposeBlock:
+		return true
+	default:
+		return false
+	}
+}
+
+// isConsensusRequest reports whether the message is sent in the consensus lane
+func isConsensusRequest(code uint64, payload interface{}) bool {
+	return isConsensusMsg(code) || code == Push && isConsensusPush(payload)
+}
+
+// isConsensusPush reports whether the push announces a vote, a proof or a block proposal, such pushes aren't batched
+// with other ones and are sent as consensus messages
+func isConsensusPush(payload interface{}) bool {
+	hash, ok := payload.(pushPullHash)
+	if !ok {
+		return false
+	}
+	switch hash.Type {
+	case pushVote, pushProof, pushBlock:
+		return true
+	default:
+		return false
+	}
+}
+
+// isControlMsg reports whether the message is required to keep the connection alive and shouldn't be throttled
+func isControlMsg(code uint64) bool {
+	switch code {
+	case Handshake, Disconnect, Ping, Pong:
+		return true
+	default:
+		return false
+	}
+}
+
+// isGossipMsg reports whether the message is relayed through the network or feeds consensus
+func isGossipMsg(code uint64) bool {
+	switch code {
+	case ProposeBlock, ProposeProof, Vote, NewTx, GetTransactions, Transactions, FlipBody, FlipKey, FlipKeysPackage, Push, Pull, BatchPush, BatchFlipKey, BatchVote, NewBlockHash:
+		return true
+	default:
+		return false
+	}
+}
+
+// isServingRequest reports whether the message asks for chain data the node serves from its storage
+func isServingRequest(code uint64) bool {
+	switch code {
+	case GetBlockByHash, GetBlocksRange, GetForkBlockRange, GetPooledTransactions, GetStateProof, GetBlockHeaders, GetFlip:
+		return true
+	default:
+		return false
+	}
+}
+
+// isServedMsg reports whether the message is a response to a serving request
+func isServedMsg(code uint64) bool {
+	switch code {
+	case Block, BlocksRange, PooledTransactions, StateProof, BlockHeaders, Flip:
+		return true
+	default:
+		return false
+	}
+}
+
+// msgCodesByName maps message names to codes, all codes fit in a single byte
+var msgCodesByName = func() map[string]uint64 {
+	result := make(map[string]uint64)
+	for code := uint64(0); code <= math.MaxUint8; code++ {
+		if name := msgCodeToString(code); !strings.HasPrefix(name, "unknown") {
+			result[name] = code
+		}
+	}
+	return result
+}()
+
+func msgCodeFromString(name string) (uint64, bool) {
+	code, ok := msgCodesByName[name]
+	return code, ok
+}
+
+func msgCodeToString(code uint64) string {
+	switch code {
+	case Handshake:
+		return "handshake"
+	case ProposeBlock:
+		return "proposeBlock"
+	case ProposeProof:
+		return "proposeProof"
+	case Vote:
+		return "vote"
+	case NewTx:
+		return "newTx"
+	case GetBlockByHash:
+		return "getBlockByHash"
+	case GetBlocksRange:
+		return "getBlocksRange"
+	case BlocksRange:
+		return "blockRange"
+	case FlipBody:
+		return "flipBody"
+	case FlipKey:
+		return "flipKey"
+	case SnapshotManifest:
+		return "snapshotManifest"
+	case Push:
+		return "push"
+	case Pull:
+		return "pull"
+	case GetForkBlockRange:
+		return "getForkBlockRange"
+	case FlipKeysPackage:
+		return "flipKeysPackage"
+	case Block:
+		return "block"
+	case BatchPush:
+		return "batchPush"
+	case BatchFlipKey:
+		return "batchFlipKey"
+	case UpdateShardId:
+		return "updateShardId"
+	case Disconnect:
+		return "disconnect"
+	case NewBlockHash:
+		return "newBlockHash"
+	case BatchVote:
+		return "batchVote"
+	case Ping:
+		return "ping"
+	case Pong:
+		return "pong"
+	case GetTransactions:
+		return "getTransactions"
+	case Transactions:
+		return "transactions"
+	case GetPooledTransactions:
+		return "getPooledTransactions"
+	case PooledTransactions:
+		return "pooledTransactions"
+	case GetStateProof:
+		return "getStateProof"
+	case StateProof:
+		return "stateProof"
+	case GetBlockHeaders:
+		return "getBlockHeaders"
+	case BlockHeaders:
+		return "blockHeaders"
+	case Status:
+		return "status"
+	case GetFlip:
+		return "getFlip"
+	case Flip:
+		return "flip"
+	default:
+		return fmt.Sprintf("unknown code %v", code)
+	}
 }
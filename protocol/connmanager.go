@@ -2,17 +2,21 @@ package protocol
 
 import (
 	"context"
-	"github.com/deckarep/golang-set"
+	"encoding/json"
 	"github.com/idena-network/idena-go/common"
 	"github.com/idena-network/idena-go/config"
+	"github.com/idena-network/idena-go/log"
 	core "github.com/libp2p/go-libp2p-core"
 	"github.com/libp2p/go-libp2p-core/helpers"
 	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/libp2p/go-libp2p-core/protocol"
 	"github.com/libp2p/go-yamux"
+	"github.com/multiformats/go-multiaddr"
 	"github.com/pkg/errors"
+	"io/ioutil"
 	"math/rand"
+	"net"
 	"strings"
 	"sync"
 	"time"
@@ -26,29 +30,37 @@ var FailedToDialPeer = errors.New("failed to select peer")
 var NoPeersToDial = errors.New("no peers to dial")
 
 type ConnManager struct {
-	bannedPeers       mapset.Set
+	bannedPeers       *banList
 	activeConnections map[peer.ID]network.Conn
 	discTimes         map[peer.ID]time.Time
 	resetTimes        map[peer.ID]time.Time
 
 	inboundPeers  map[peer.ID]common.ShardId
 	outboundPeers map[peer.ID]common.ShardId
+	inboundIPs    map[peer.ID]net.IP
 
 	peerMutex sync.RWMutex
 	connMutex sync.Mutex
 	host      core.Host
 	cfg       config.P2P
+	// limitsMutex guards peer limits of cfg, they can be changed at runtime
+	limitsMutex sync.RWMutex
 
 	ownShardId common.ShardId
 }
 
 func NewConnManager(host core.Host, cfg config.P2P) *ConnManager {
+	bannedPeers := newBanList(MaxBannedPeers)
+	if cfg.BansFile != "" {
+		bannedPeers.restore(cfg.BansFile)
+	}
 	return &ConnManager{
 		host:              host,
 		cfg:               cfg,
-		bannedPeers:       mapset.NewSet(),
+		bannedPeers:       bannedPeers,
 		activeConnections: make(map[peer.ID]network.Conn),
 		inboundPeers:      make(map[peer.ID]common.ShardId),
+		inboundIPs:        make(map[peer.ID]net.IP),
 		outboundPeers:     make(map[peer.ID]common.ShardId),
 		discTimes:         make(map[peer.ID]time.Time),
 		resetTimes:        make(map[peer.ID]time.Time),
@@ -84,11 +96,15 @@ func (m *ConnManager) CanConnect(id peer.ID) bool {
 	return false
 }
 
-func (m *ConnManager) Connected(id peer.ID, inbound bool, shardId common.ShardId) {
+// Connected registers the peer after handshake, the remote address is used to limit inbound peers per IP and subnet
+func (m *ConnManager) Connected(id peer.ID, inbound bool, shardId common.ShardId, addr multiaddr.Multiaddr) {
 	m.peerMutex.Lock()
 	defer m.peerMutex.Unlock()
 	if inbound {
 		m.inboundPeers[id] = shardId
+		if ip := remoteIP(addr); ip != nil {
+			m.inboundIPs[id] = ip
+		}
 	} else {
 		m.outboundPeers[id] = shardId
 	}
@@ -122,14 +138,21 @@ func (m *ConnManager) Disconnected(id peer.ID, reason error) {
 		m.resetTimes[id] = time.Now().UTC()
 	}
 	delete(m.inboundPeers, id)
+	delete(m.inboundIPs, id)
 	delete(m.outboundPeers, id)
 }
 
 func (m *ConnManager) BanPeer(id peer.ID) {
-	m.bannedPeers.Add(id)
-	if m.bannedPeers.Cardinality() > MaxBannedPeers {
-		m.bannedPeers.Pop()
-	}
+	m.BanPeerFor(id, DefaultBanDuration)
+}
+
+// BanPeerFor rejects connections with the peer until the ban expires
+func (m *ConnManager) BanPeerFor(id peer.ID, duration time.Duration) {
+	m.bannedPeers.Add(id, duration)
+}
+
+func (m *ConnManager) IsBanned(id peer.ID) bool {
+	return m.bannedPeers.Contains(id)
 }
 
 func (m *ConnManager) DialRandomPeer() (network.Stream, error) {
@@ -241,7 +264,8 @@ func (m *ConnManager) RemoveConnection(conn network.Conn) {
 func (m *ConnManager) CanAcceptStream() bool {
 	m.peerMutex.RLock()
 	defer m.peerMutex.RUnlock()
-	return len(m.inboundPeers) < m.cfg.MaxInboundPeers+m.cfg.MaxInboundOwnShardPeers
+	limits := m.limits()
+	return len(m.inboundPeers) < limits.MaxInboundPeers+limits.MaxInboundOwnShardPeers
 }
 
 func (m *ConnManager) NeedPeerFromSomeShard(shardsNum int) bool {
@@ -268,7 +292,7 @@ func (m *ConnManager) NeedInboundOwnShardPeers() bool {
 			cnt++
 		}
 	}
-	return cnt < m.cfg.MaxInboundOwnShardPeers
+	return cnt < m.limits().MaxInboundOwnShardPeers
 }
 
 func (m *ConnManager) NeedOutboundOwnShardPeers() bool {
@@ -287,11 +311,30 @@ func (m *ConnManager) NeedOutboundOwnShardPeers() bool {
 }
 
 func (m *ConnManager) MaxOutboundPeers() int {
-	return m.cfg.MaxOutboundPeers
+	return m.limits().MaxOutboundPeers
 }
 
 func (m *ConnManager) MaxOutboundOwnPeers() int {
-	return m.cfg.MaxOutboundOwnShardPeers
+	return m.limits().MaxOutboundOwnShardPeers
+}
+
+func (m *ConnManager) limits() config.P2P {
+	m.limitsMutex.RLock()
+	defer m.limitsMutex.RUnlock()
+	return m.cfg
+}
+
+// SetPeerLimits replaces limits of inbound and outbound peers, connected peers over the new limits are not disconnected
+// but new ones aren't accepted until the number of peers goes down
+func (m *ConnManager) SetPeerLimits(cfg config.P2P) {
+	m.limitsMutex.Lock()
+	defer m.limitsMutex.Unlock()
+	m.cfg.MaxInboundPeers = cfg.MaxInboundPeers
+	m.cfg.MaxOutboundPeers = cfg.MaxOutboundPeers
+	m.cfg.MaxInboundOwnShardPeers = cfg.MaxInboundOwnShardPeers
+	m.cfg.MaxOutboundOwnShardPeers = cfg.MaxOutboundOwnShardPeers
+	m.cfg.MaxInboundPeersPerIP = cfg.MaxInboundPeersPerIP
+	m.cfg.MaxInboundPeersPerSubnet = cfg.MaxInboundPeersPerSubnet
 }
 
 func (m *ConnManager) CanDial() bool {
@@ -420,3 +463,131 @@ func (m *ConnManager) peersCntFromShard(shardId common.ShardId) int {
 	}
 	return cnt
 }
+
+// banList keeps banned peers until their bans expire, if path is set bans are saved to the file to survive restarts
+type banList struct {
+	entries map[peer.ID]time.Time
+	maxSize int
+	now     func() time.Time
+	path    string
+	mutex   sync.Mutex
+
+	// bans are written in background, seq orders the writes so an older state never overwrites a newer one
+	seq        uint64
+	writtenSeq uint64
+	writeMutex sync.Mutex
+	writes     sync.WaitGroup
+}
+
+type persistedBan struct {
+	Id    string    `json:"id"`
+	Until time.Time `json:"until"`
+}
+
+func newBanList(maxSize int) *banList {
+	return &banList{
+		entries: make(map[peer.ID]time.Time),
+		maxSize: maxSize,
+		now:     time.Now,
+	}
+}
+
+func (b *banList) Add(id peer.ID, duration time.Duration) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	now := b.now()
+	expiresAt := now.Add(duration)
+	if prev, ok := b.entries[id]; ok && prev.After(expiresAt) {
+		return
+	}
+	b.entries[id] = expiresAt
+	defer b.persistAsync()
+	if len(b.entries) <= b.maxSize {
+		return
+	}
+	for bannedId, bannedUntil := range b.entries {
+		if !now.Before(bannedUntil) {
+			delete(b.entries, bannedId)
+		}
+	}
+	for bannedId := range b.entries {
+		if len(b.entries) <= b.maxSize {
+			break
+		}
+		if bannedId != id {
+			delete(b.entries, bannedId)
+		}
+	}
+}
+
+func (b *banList) Contains(id peer.ID) bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	bannedUntil, ok := b.entries[id]
+	if !ok {
+		return false
+	}
+	if !b.now().Before(bannedUntil) {
+		delete(b.entries, id)
+		return false
+	}
+	return true
+}
+
+// restore loads bans which are not expired yet from the file, further bans are saved to it
+func (b *banList) restore(path string) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.path = path
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return
+	}
+	var bans []persistedBan
+	if err := json.Unmarshal(data, &bans); err != nil {
+		log.Warn("cannot parse banned peers", "path", path, "err", err)
+		return
+	}
+	now := b.now()
+	for _, ban := range bans {
+		id, err := peer.Decode(ban.Id)
+		if err != nil || !now.Before(ban.Until) || len(b.entries) >= b.maxSize {
+			continue
+		}
+		b.entries[id] = ban.Until
+	}
+}
+
+// persistAsync saves the current bans in background, the caller must hold the mutex
+func (b *banList) persistAsync() {
+	if b.path == "" {
+		return
+	}
+	now := b.now()
+	bans := make([]persistedBan, 0, len(b.entries))
+	for id, bannedUntil := range b.entries {
+		if now.Before(bannedUntil) {
+			bans = append(bans, persistedBan{Id: id.Pretty(), Until: bannedUntil})
+		}
+	}
+	data, err := json.Marshal(bans)
+	if err != nil {
+		return
+	}
+	b.seq++
+	seq, path := b.seq, b.path
+	b.writes.Add(1)
+	go func() {
+		defer b.writes.Done()
+		b.writeMutex.Lock()
+		defer b.writeMutex.Unlock()
+		if seq < b.writtenSeq {
+			return
+		}
+		if err := ioutil.WriteFile(path, data, 0600); err != nil {
+			log.Warn("cannot save banned peers", "path", path, "err", err)
+			return
+		}
+		b.writtenSeq = seq
+	}()
+}
@@ -21,15 +21,22 @@ import (
 	"github.com/idena-network/idena-go/stats/collector"
 	"github.com/idena-network/idena-go/subscriptions"
 	"github.com/libp2p/go-libp2p-core/peer"
+	"sort"
 	"time"
 )
 
 const (
 	MaxAttemptsCountPerBatch = 10
+	// forkProbeHeaders headers ending at the own head are compared with the chain of a peer before blocks are requested
+	// from it, forkProbeSkip blocks are left out between them, so the probe shows how deep the fork is
+	forkProbeHeaders = 4
+	forkProbeSkip    = 49
 )
 
 var (
 	BanReasonTimeout = errors.New("timeout")
+
+	errDownloaderStopped = errors.New("downloader is stopped")
 )
 
 type Syncer interface {
@@ -69,6 +76,8 @@ type Downloader struct {
 	keyStore             *keystore.KeyStore
 	subManager           *subscriptions.Manager
 	upgrader             *upgrade.Upgrader
+	// term is closed on node shutdown to interrupt synchronization
+	term chan struct{}
 }
 
 func (d *Downloader) IsSyncing() bool {
@@ -101,7 +110,7 @@ func NewDownloader(
 		pm:                   pm,
 		cfg:                  cfg,
 		chain:                chain,
-		log:                  log.New("component", "downloader"),
+		log:                  log.New(log.ModuleKey, "sync", "component", "downloader"),
 		ipfs:                 ipfs,
 		appState:             appState,
 		isSyncing:            false,
@@ -113,17 +122,57 @@ func NewDownloader(
 		subManager:           subManager,
 		keyStore:             keyStore,
 		upgrader:             upgrader,
+		term:                 make(chan struct{}),
+	}
+}
+
+// Stop interrupts synchronization, blocks which are already received are applied before SyncBlockchain returns
+func (d *Downloader) Stop() {
+	select {
+	case <-d.term:
+	default:
+		close(d.term)
+	}
+}
+
+func (d *Downloader) stopped() bool {
+	select {
+	case <-d.term:
+		return true
+	default:
+		return false
 	}
 }
 
-func getTopHeight(heights map[peer.ID]uint64) uint64 {
-	max := uint64(0)
+const defaultMinTipConfirmations = 2
+
+// getTopHeight returns the highest height reached by at least minConfirmations peers, so a single peer can't lead
+// the node to an unconfirmed tip, the requirement is relaxed when fewer peers are connected
+func getTopHeight(heights map[peer.ID]uint64, minConfirmations int) uint64 {
+	if len(heights) == 0 {
+		return 0
+	}
+	values := make([]uint64, 0, len(heights))
 	for _, value := range heights {
-		if value > max {
-			max = value
-		}
+		values = append(values, value)
+	}
+	sort.Slice(values, func(i, j int) bool {
+		return values[i] > values[j]
+	})
+	if minConfirmations < 1 {
+		minConfirmations = 1
+	}
+	if minConfirmations > len(values) {
+		minConfirmations = len(values)
+	}
+	return values[minConfirmations-1]
+}
+
+func (d *Downloader) minTipConfirmations() int {
+	if d.cfg.Sync.MinTipConfirmations > 0 {
+		return d.cfg.Sync.MinTipConfirmations
 	}
-	return max
+	return defaultMinTipConfirmations
 }
 
 func (d *Downloader) filterForkedPeers(peers map[peer.ID]uint64) {
@@ -132,9 +181,72 @@ func (d *Downloader) filterForkedPeers(peers map[peer.ID]uint64) {
 	}
 }
 
-func (d *Downloader) SyncBlockchain(forkResolver ForkResolver) error {
+// forkProbeRange returns the first height and the number of headers of the fork probe for the given head
+func forkProbeRange(head uint64) (from uint64, count uint32) {
+	step := uint64(forkProbeSkip + 1)
+	count = forkProbeHeaders
+	for count > 1 && uint64(count-1)*step >= head {
+		count--
+	}
+	return head - uint64(count-1)*step, count
+}
+
+// compareHeaders compares headers of a peer with own canonical headers of the same heights, the peer is forked if the
+// last of them differs, matched is the highest height where the chains match or zero if none of them match
+func compareHeaders(headers []*types.Header, own func(height uint64) *types.Header) (forked bool, matched uint64) {
+	for _, header := range headers {
+		if ownHeader := own(header.Height()); ownHeader != nil && ownHeader.Hash() == header.Hash() {
+			matched = header.Height()
+		}
+	}
+	return len(headers) > 0 && matched != headers[len(headers)-1].Height(), matched
+}
 
+// probeForks requests headers of peers which are ahead at heights up to the own head before blocks are requested from
+// them. Headers are much smaller than block ranges with certificates and identity diffs, so peers on another chain are
+// detected without loading their blocks and are left to the fork resolver. Each peer is probed once per sync
+func (d *Downloader) probeForks(knownHeights map[peer.ID]uint64, probed map[peer.ID]struct{}) {
+	head := d.chain.Head.Height()
+	from, count := forkProbeRange(head)
+	type result struct {
+		id      peer.ID
+		headers []*types.Header
+	}
+	results := make(chan result, len(knownHeights))
+	requested := 0
+	for id, height := range knownHeights {
+		if _, ok := probed[id]; ok || height <= head {
+			continue
+		}
+		probed[id] = struct{}{}
+		requested++
+		go func(id peer.ID) {
+			headers, err := d.pm.GetBlockHeaders(id, from, count, forkProbeSkip)
+			if err != nil {
+				d.log.Debug("Failed to probe peer headers", "peer", id.Pretty(), "err", err)
+			}
+			results <- result{id, headers}
+		}(id)
+	}
+	for i := 0; i < requested; i++ {
+		r := <-results
+		if len(r.headers) != int(count) {
+			continue
+		}
+		if forked, matched := compareHeaders(r.headers, d.chain.GetBlockHeaderByHeight); forked {
+			d.log.Info("Peer is on another chain", "peer", r.id.Pretty(), "head", head, "matched", matched)
+			d.potentialForkedPeers.Add(r.id)
+			delete(knownHeights, r.id)
+		}
+	}
+}
+
+func (d *Downloader) SyncBlockchain(forkResolver ForkResolver) error {
+	probed := make(map[peer.ID]struct{})
 	for {
+		if d.stopped() {
+			return errDownloaderStopped
+		}
 		if forkResolver.HasLoadedFork() {
 			return errors.New("loaded fork is detected")
 		}
@@ -145,13 +257,17 @@ func (d *Downloader) SyncBlockchain(forkResolver ForkResolver) error {
 		}
 
 		d.filterForkedPeers(knownHeights)
+		d.probeForks(knownHeights, probed)
 
 		if len(knownHeights) == 0 {
 			return errors.New("all connected peers are in fork")
 		}
 
 		head := d.chain.Head
-		d.top = getTopHeight(knownHeights)
+		d.top = getTopHeight(knownHeights, d.minTipConfirmations())
+		if max := getTopHeight(knownHeights, 1); max > d.top {
+			d.log.Info("Peers report unconfirmed tip", "height", max, "confirmed", d.top)
+		}
 		if head.Height() >= d.top {
 			d.log.Info(fmt.Sprintf("Node is synchronized"))
 			return nil
@@ -183,7 +299,7 @@ func (d *Downloader) Load() {
 	completed := make(chan interface{})
 	go d.consumeBlocks(applier, term, completed)
 
-	knownHeights := d.pm.GetKnownHeights()
+	knownHeights := selectSyncPeers(d.pm.GetKnownHeights(), from, d.cfg.Sync.MaxSyncPeers, d.pm.sortPeersByLatency)
 loop:
 	for from <= toHeight && len(knownHeights) > 0 {
 		for peer, height := range knownHeights {
@@ -200,6 +316,8 @@ loop:
 				case d.batches <- batch:
 				case <-term:
 					break loop
+				case <-d.term:
+					break loop
 				}
 			}
 			from = to + 1
@@ -214,6 +332,26 @@ loop:
 	}
 }
 
+// selectSyncPeers keeps at most maxPeers peers which have blocks starting from the given height, peers which come first
+// in the given order are preferred, batches are requested from selected peers in parallel
+func selectSyncPeers(knownHeights map[peer.ID]uint64, from uint64, maxPeers int, order func([]peer.ID)) map[peer.ID]uint64 {
+	var ids []peer.ID
+	for id, height := range knownHeights {
+		if height >= from {
+			ids = append(ids, id)
+		}
+	}
+	if maxPeers > 0 && len(ids) > maxPeers {
+		order(ids)
+		ids = ids[:maxPeers]
+	}
+	result := make(map[peer.ID]uint64, len(ids))
+	for _, id := range ids {
+		result[id] = knownHeights[id]
+	}
+	return result
+}
+
 func (d *Downloader) consumeBlocks(applier blockApplier, term chan interface{}, completed chan interface{}) {
 	defer close(term)
 
@@ -255,6 +393,8 @@ func (d *Downloader) consumeBlocks(applier blockApplier, term chan interface{},
 			return
 		case <-timeout:
 			return
+		case <-d.term:
+			return
 		}
 	}
 }
@@ -319,6 +459,10 @@ func (d *Downloader) getBestManifest() *snapshot.Manifest {
 
 	var best *snapshot.Manifest
 	for _, m := range manifests {
+		if !matchesCheckpoints(d.cfg.Sync.TrustedCheckpoints, m) {
+			d.log.Warn("Snapshot manifest doesn't match trusted checkpoints", "height", m.Height, "root", m.Root.Hex())
+			continue
+		}
 		if (best == nil || best.Height < m.Height) && !d.sm.IsInvalidManifest(m.CidV2) {
 			best = m
 		}
@@ -331,6 +475,14 @@ func (d *Downloader) getBestManifest() *snapshot.Manifest {
 	return best
 }
 
+func matchesCheckpoints(checkpoints map[uint64]string, m *snapshot.Manifest) bool {
+	if len(checkpoints) == 0 {
+		return true
+	}
+	root, ok := checkpoints[m.Height]
+	return ok && common.HexToHash(root) == m.Root
+}
+
 func (d *Downloader) startSync() {
 	d.isSyncing = true
 	d.chain.StartSync()
@@ -350,17 +502,38 @@ func (d *Downloader) BanPeer(peerId peer.ID, reason error) {
 	}
 }
 
+// requestBatch retries loading of a batch from other peers, attempts to load the same batch are delayed with growing back-off
 func requestBatch(pm *IdenaGossipHandler, from, to uint64, ignoredPeer peer.ID) *batch {
-	knownHeights := pm.GetKnownHeights()
-	if knownHeights == nil {
-		return nil
+	delay := pm.syncRetryDelay(from, to)
+	pm.log.Debug("Retry batch loading", "from", from, "to", to, "delay", delay)
+	time.Sleep(delay)
+	var candidates []*protoPeer
+	for _, p := range pm.peers.Peers() {
+		if !p.observer {
+			candidates = append(candidates, p)
+		}
 	}
-	for peerId, height := range knownHeights {
-		if (peerId != ignoredPeer || len(knownHeights) == 1) && height >= to {
-			if batch, err := pm.GetBlocksRange(peerId, from, to); err != nil {
-				continue
-			} else {
-				return batch
+	if len(candidates) > 1 {
+		for i, p := range candidates {
+			if p.id == ignoredPeer {
+				candidates = append(candidates[:i], candidates[i+1:]...)
+				break
+			}
+		}
+	}
+	hint := SelectHint{From: from, To: to}
+	for len(candidates) > 0 {
+		p := pm.selector().Select(candidates, hint)
+		if p == nil {
+			return nil
+		}
+		if batch, err := pm.GetBlocksRange(p.id, from, to); err == nil {
+			return batch
+		}
+		for i, candidate := range candidates {
+			if candidate == p {
+				candidates = append(candidates[:i], candidates[i+1:]...)
+				break
 			}
 		}
 	}
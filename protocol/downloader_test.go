@@ -0,0 +1,175 @@
+package protocol
+
+import (
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/eventbus"
+	"github.com/idena-network/idena-go/config"
+	"github.com/idena-network/idena-go/core/state"
+	"github.com/idena-network/idena-go/core/state/snapshot"
+	"github.com/idena-network/idena-go/log"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	db "github.com/tendermint/tm-db"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+func Test_matchesCheckpoints(t *testing.T) {
+	root := common.Hash{0x1, 0x2}
+	manifest := &snapshot.Manifest{Root: root, Height: 100}
+
+	require.True(t, matchesCheckpoints(nil, manifest))
+	require.True(t, matchesCheckpoints(map[uint64]string{100: root.Hex()}, manifest))
+	require.False(t, matchesCheckpoints(map[uint64]string{100: common.Hash{0x3}.Hex()}, manifest))
+	require.False(t, matchesCheckpoints(map[uint64]string{200: root.Hex()}, manifest))
+}
+
+func Test_checkBlockCheckpoint(t *testing.T) {
+	header := &types.Header{EmptyBlockHeader: &types.EmptyBlockHeader{Height: 100}}
+
+	require.NoError(t, checkBlockCheckpoint(nil, header))
+	require.NoError(t, checkBlockCheckpoint(map[uint64]string{100: header.Hash().Hex()}, header))
+	require.NoError(t, checkBlockCheckpoint(map[uint64]string{200: common.Hash{0x1}.Hex()}, header))
+	err := checkBlockCheckpoint(map[uint64]string{100: common.Hash{0x1}.Hex()}, header)
+	require.Equal(t, BlockCheckpointMismatch, errors.Cause(err))
+}
+
+func Test_getTopHeight(t *testing.T) {
+	require.Zero(t, getTopHeight(nil, defaultMinTipConfirmations))
+
+	// a single peer announces a tip nobody else confirms
+	heights := map[peer.ID]uint64{
+		"fast":  1000,
+		"peer1": 100,
+		"peer2": 98,
+		"peer3": 90,
+	}
+	require.Equal(t, uint64(100), getTopHeight(heights, 2))
+	require.Equal(t, uint64(98), getTopHeight(heights, 3))
+	require.Equal(t, uint64(1000), getTopHeight(heights, 1))
+
+	heights["peer4"] = 1000
+	require.Equal(t, uint64(1000), getTopHeight(heights, 2))
+
+	// the only connected peer is trusted as it is
+	require.Equal(t, uint64(50), getTopHeight(map[peer.ID]uint64{"peer": 50}, 2))
+}
+
+func Test_forkProbeRange(t *testing.T) {
+	check := func(head uint64, from uint64, count uint32) {
+		actualFrom, actualCount := forkProbeRange(head)
+		require.Equal(t, from, actualFrom, "head %v", head)
+		require.Equal(t, count, actualCount, "head %v", head)
+	}
+	check(1000, 850, 4)
+	check(151, 1, 4)
+	check(150, 50, 3)
+	check(10, 10, 1)
+}
+
+func Test_compareHeaders(t *testing.T) {
+	header := func(height uint64, fork byte) *types.Header {
+		return &types.Header{EmptyBlockHeader: &types.EmptyBlockHeader{Height: height, Root: common.Hash{fork}}}
+	}
+	own := func(height uint64) *types.Header {
+		if height > 200 {
+			return nil
+		}
+		return header(height, 0)
+	}
+
+	forked, matched := compareHeaders([]*types.Header{header(50, 0), header(100, 0), header(150, 0)}, own)
+	require.False(t, forked)
+	require.Equal(t, uint64(150), matched)
+
+	forked, matched = compareHeaders([]*types.Header{header(50, 0), header(100, 1), header(150, 1)}, own)
+	require.True(t, forked)
+	require.Equal(t, uint64(50), matched)
+
+	forked, matched = compareHeaders([]*types.Header{header(150, 1), header(250, 0)}, own)
+	require.True(t, forked)
+	require.Zero(t, matched)
+
+	forked, _ = compareHeaders(nil, own)
+	require.False(t, forked)
+}
+
+func Test_selectSyncPeers(t *testing.T) {
+	heights := map[peer.ID]uint64{
+		"behind": 50,
+		"slow":   200,
+		"fast":   150,
+		"medium": 300,
+	}
+	byName := func(ids []peer.ID) {
+		rank := map[peer.ID]int{"fast": 0, "medium": 1, "slow": 2, "behind": 3}
+		sort.Slice(ids, func(i, j int) bool {
+			return rank[ids[i]] < rank[ids[j]]
+		})
+	}
+
+	require.Equal(t, map[peer.ID]uint64{"slow": 200, "fast": 150, "medium": 300}, selectSyncPeers(heights, 100, 0, byName))
+	require.Equal(t, map[peer.ID]uint64{"fast": 150, "medium": 300}, selectSyncPeers(heights, 100, 2, byName))
+	require.Equal(t, map[peer.ID]uint64{"medium": 300}, selectSyncPeers(heights, 250, 2, byName))
+	require.Empty(t, selectSyncPeers(nil, 100, 2, byName))
+}
+
+func TestDownloader_getBestManifest(t *testing.T) {
+	cfg := &config.Config{Sync: &config.SyncConfig{}}
+	h := &IdenaGossipHandler{peers: newPeerSet()}
+	d := &Downloader{
+		pm:  h,
+		cfg: cfg,
+		sm:  state.NewSnapshotManager(db.NewMemDB(), nil, eventbus.New(), nil, cfg),
+		log: log.New(),
+	}
+	addPeer := func(id peer.ID, manifest *snapshot.Manifest) {
+		p, _ := newTestPeer(id)
+		p.manifest = manifest
+		require.NoError(t, h.peers.Register(p))
+	}
+	addPeer("low", &snapshot.Manifest{Height: 100, Root: common.Hash{0x1}, CidV2: []byte{0x1}})
+	addPeer("high", &snapshot.Manifest{Height: 300, Root: common.Hash{0x3}, CidV2: []byte{0x3}})
+	addPeer("middle", &snapshot.Manifest{Height: 200, Root: common.Hash{0x2}, CidV2: []byte{0x2}})
+
+	require.Equal(t, uint64(300), d.getBestManifest().Height)
+
+	d.sm.AddInvalidManifest([]byte{0x3})
+	require.Equal(t, uint64(200), d.getBestManifest().Height)
+
+	cfg.Sync.TrustedCheckpoints = map[uint64]string{100: common.Hash{0x1}.Hex()}
+	require.Equal(t, uint64(100), d.getBestManifest().Height)
+
+	cfg.Sync.TrustedCheckpoints = map[uint64]string{100: common.Hash{0x9}.Hex()}
+	require.Nil(t, d.getBestManifest())
+}
+
+func Test_requestBatch(t *testing.T) {
+	h := &IdenaGossipHandler{
+		cfg:           config.P2P{SyncRetryInitialDelay: time.Millisecond},
+		peers:         newPeerSet(),
+		incomeBatches: &sync.Map{},
+		log:           log.New(),
+	}
+	for id, height := range map[peer.ID]uint64{"failed": 100, "other": 50} {
+		p, _ := newTestPeer(id)
+		p.knownHeight.Store(height)
+		require.NoError(t, h.peers.Register(p))
+	}
+
+	// the batch is reassigned to another peer even if the failed one is preferred by the selector
+	b := requestBatch(h, 1, 10, "failed")
+	require.NotNil(t, b)
+	require.Equal(t, peer.ID("other"), b.p.id)
+	require.Equal(t, uint64(1), b.from)
+	require.Equal(t, uint64(10), b.to)
+
+	h.peers.Unregister("other")
+	b = requestBatch(h, 1, 10, "failed")
+	require.NotNil(t, b)
+	require.Equal(t, peer.ID("failed"), b.p.id)
+}
@@ -45,6 +45,7 @@ type fastSync struct {
 	subManager           *subscriptions.Manager
 	upgrader             *upgrade.Upgrader
 	prevConfig           *config.ConsensusConf
+	checkpoints          map[uint64]string
 
 	pubKeyToAddrCache map[string]common.Address
 }
@@ -78,6 +79,7 @@ func NewFastSync(pm *IdenaGossipHandler, log log.Logger,
 		subManager:           subManager,
 		upgrader:             upgrader,
 		pubKeyToAddrCache:    map[string]common.Address{},
+		checkpoints:          chain.Config().BlockCheckpoints(),
 	}
 }
 
@@ -164,7 +166,7 @@ func (fs *fastSync) applyDeferredBlocks() (uint64, error) {
 		if !b.IdentityDiff.Empty() {
 			fs.validators.UpdateFromIdentityStateDiff(b.IdentityDiff)
 		}
-		fs.chain.WriteIdentityStateDiff(b.Header.Height(), b.IdentityDiff)
+		fs.chain.WriteIdentityStateDiff(b.Header.Height(), b.IdentityDiff, nil)
 		if !b.Cert.Empty() {
 			fs.chain.WriteCertificate(b.Header.Hash(), b.Cert, true)
 		}
@@ -180,14 +182,14 @@ func (fs *fastSync) applyDeferredBlocks() (uint64, error) {
 			if err != nil {
 				return b.Header.Height(), err
 			}
-			fs.chain.WriteTxIndex(b.Header.Hash(), txs)
+			fs.chain.WriteTxIndex(b.Header.Hash(), txs, nil)
 			fs.chain.Indexer().HandleBlockTransactions(b.Header, txs)
 
 			receipts, err := fs.GetTxReceipts(b.Header.ProposedHeader.TxReceiptsCid)
 			if err != nil {
 				return b.Header.Height(), err
 			}
-			fs.chain.WriteTxReceipts(b.Header.ProposedHeader.TxReceiptsCid, receipts)
+			fs.chain.WriteTxReceipts(b.Header.ProposedHeader.TxReceiptsCid, receipts, nil)
 		}
 	}
 	return 0, nil
@@ -241,6 +243,7 @@ func (fs *fastSync) processBatch(batch *batch, attemptNum int) error {
 	}
 	fs.log.Info("Start process batch", "from", batch.from, "to", batch.to)
 	if attemptNum > MaxAttemptsCountPerBatch {
+		fs.pm.resetSyncBackoff(batch.to)
 		return errors.New("number of attempts exceeded limit")
 	}
 	reload := func(from uint64) error {
@@ -281,13 +284,14 @@ func (fs *fastSync) processBatch(batch *batch, attemptNum int) error {
 			}
 
 		case <-timeout:
-			fs.log.Warn("process batch - timeout was reached", "peer", batch.p.id)
+			batch.p.Log().Warn("process batch - timeout was reached")
 			if batch.p.addTimeout() {
 				fs.pm.BanPeer(batch.p.id, BanReasonTimeout)
 			}
 			return reload(i)
 		}
 	}
+	fs.pm.resetSyncBackoff(batch.to)
 	fs.log.Info("Finish process batch", "from", batch.from, "to", batch.to)
 	return nil
 }
@@ -310,6 +314,9 @@ func (fs *fastSync) validateHeader(block *block) error {
 	if err != nil {
 		return err
 	}
+	if err := checkBlockCheckpoint(fs.checkpoints, block.Header); err != nil {
+		return err
+	}
 
 	if block.Header.Flags().HasFlag(types.IdentityUpdate|types.Snapshot|types.NewGenesis) ||
 		block.Header.ProposedHeader != nil && block.Header.ProposedHeader.Upgrade > 0 {
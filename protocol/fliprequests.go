@@ -0,0 +1,86 @@
+package protocol
+
+import (
+	"github.com/idena-network/idena-go/common"
+	models "github.com/idena-network/idena-go/protobuf"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"time"
+)
+
+const (
+	flipRequestPeers   = 3
+	flipRequestTimeout = time.Second * 20
+	// maxFlipCidLength bounds the cid of a requested flip, cids of flips are about 36 bytes long
+	maxFlipCidLength = 128
+)
+
+// RequestFlip asks peers with the lowest latency for the flip which can't be loaded from ipfs, the flip is added
+// to the flipper once any of them responds. Repeated calls for the same flip are ignored until the requests time out
+func (h *IdenaGossipHandler) RequestFlip(cid []byte) {
+	key := string(cid)
+	h.flipRequestsMutex.Lock()
+	if h.flipRequests == nil {
+		h.flipRequests = make(map[string]time.Time)
+	}
+	now := time.Now()
+	for requested, sent := range h.flipRequests {
+		if now.Sub(sent) >= flipRequestTimeout {
+			delete(h.flipRequests, requested)
+		}
+	}
+	if _, ok := h.flipRequests[key]; ok {
+		h.flipRequestsMutex.Unlock()
+		return
+	}
+	h.flipRequests[key] = now
+	h.flipRequestsMutex.Unlock()
+
+	var ids []peer.ID
+	for _, p := range h.peers.Peers() {
+		if p.light || !p.Supports(FlipRequests) {
+			continue
+		}
+		ids = append(ids, p.id)
+	}
+	h.sortPeersByLatency(ids)
+	if len(ids) > flipRequestPeers {
+		ids = ids[:flipRequestPeers]
+	}
+	for _, id := range ids {
+		p := h.peers.Peer(id)
+		if p == nil {
+			continue
+		}
+		req := p.pendingRequests.AddOptional(GetFlip, key, flipRequestTimeout, nil)
+		p.sendMsg(GetFlip, &models.ProtoGetFlipRequest{Id: req.seqNo, Cid: cid}, common.MultiShard, false)
+	}
+}
+
+// provideFlip sends the flip if it's loaded for the validation ceremony, an empty response tells that the flip is missing
+func (h *IdenaGossipHandler) provideFlip(p *protoPeer, query *models.ProtoGetFlipRequest) {
+	response := &models.ProtoFlipResponse{
+		Id:  query.Id,
+		Cid: query.Cid,
+	}
+	if h.flipper != nil {
+		response.Data, _ = h.flipper.GetRawFlipFromMemory(query.Cid)
+	}
+	p.sendMsg(Flip, response, common.MultiShard, false)
+}
+
+func (h *IdenaGossipHandler) onFlipResponse(p *protoPeer, response *models.ProtoFlipResponse) {
+	if !p.pendingRequests.ResolveByKey(GetFlip, string(response.Cid)) {
+		return
+	}
+	h.onResponse(p)
+	if len(response.Data) == 0 || h.flipper == nil {
+		return
+	}
+	if err := h.flipper.AddFlipFromPeer(response.Cid, response.Data); err != nil {
+		h.penalize(p, invalidMsgScorePenalty, "invalid flip received")
+		return
+	}
+	h.flipRequestsMutex.Lock()
+	delete(h.flipRequests, string(response.Cid))
+	h.flipRequestsMutex.Unlock()
+}
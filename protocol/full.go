@@ -18,7 +18,8 @@ import (
 const FullSyncBatchSize = 200
 
 var (
-	BlockCertIsMissing = errors.New("block cert is missing")
+	BlockCertIsMissing      = errors.New("block cert is missing")
+	BlockCheckpointMismatch = errors.New("block doesn't match checkpoint")
 )
 
 type fullSync struct {
@@ -33,6 +34,7 @@ type fullSync struct {
 	deferredHeaders      []blockPeer
 	targetHeight         uint64
 	statsCollector       collector.StatsCollector
+	checkpoints          map[uint64]string
 }
 
 func (fs *fullSync) batchSize() uint64 {
@@ -61,6 +63,7 @@ func NewFullSync(
 		ipfs:                 ipfs,
 		targetHeight:         targetHeight,
 		statsCollector:       statsCollector,
+		checkpoints:          chain.Config().BlockCheckpoints(),
 	}
 }
 
@@ -110,6 +113,7 @@ func (fs *fullSync) postConsuming() error {
 func (fs *fullSync) processBatch(batch *batch, attemptNum int) error {
 	fs.log.Info("Start process batch", "from", batch.from, "to", batch.to)
 	if attemptNum > MaxAttemptsCountPerBatch {
+		fs.pm.resetSyncBackoff(batch.to)
 		return errors.New("number of attempts exceeded limit")
 	}
 
@@ -153,13 +157,14 @@ func (fs *fullSync) processBatch(batch *batch, attemptNum int) error {
 				}
 			}
 		case <-timeout:
-			fs.log.Warn("process batch - timeout was reached", "peer", batch.p.id)
+			batch.p.Log().Warn("process batch - timeout was reached")
 			if batch.p.addTimeout() {
 				fs.pm.BanPeer(batch.p.id, BanReasonTimeout)
 			}
 			return reload(i)
 		}
 	}
+	fs.pm.resetSyncBackoff(batch.to)
 	fs.log.Info("Finish process batch", "from", batch.from, "to", batch.to)
 	return nil
 }
@@ -174,6 +179,9 @@ func (fs *fullSync) validateHeader(block *block, p *protoPeer) error {
 	if err != nil {
 		return err
 	}
+	if err := checkBlockCheckpoint(fs.checkpoints, block.Header); err != nil {
+		return err
+	}
 
 	if block.Header.Flags().HasFlag(types.IdentityUpdate|types.Snapshot|types.NewGenesis) || block.Header.Height() == p.knownHeight.Read() ||
 		block.Header.ProposedHeader != nil && block.Header.ProposedHeader.Upgrade > 0 {
@@ -188,6 +196,18 @@ func (fs *fullSync) validateHeader(block *block, p *protoPeer) error {
 	return nil
 }
 
+// checkBlockCheckpoint returns an error if the block hash conflicts with the checkpoint of its height
+func checkBlockCheckpoint(checkpoints map[uint64]string, header *types.Header) error {
+	hash, ok := checkpoints[header.Height()]
+	if !ok {
+		return nil
+	}
+	if common.HexToHash(hash) != header.Hash() {
+		return errors.Wrapf(BlockCheckpointMismatch, "height %v, expected %v, got %v", header.Height(), common.HexToHash(hash).Hex(), header.Hash().Hex())
+	}
+	return nil
+}
+
 func (fs *fullSync) GetBlock(header *types.Header) (*types.Block, error) {
 	if header.EmptyBlockHeader != nil {
 		return &types.Block{
@@ -10,6 +10,7 @@ import (
 	"github.com/idena-network/idena-go/blockchain/validation"
 	"github.com/idena-network/idena-go/common"
 	"github.com/idena-network/idena-go/common/eventbus"
+	"github.com/idena-network/idena-go/common/knowncache"
 	"github.com/idena-network/idena-go/common/maputil"
 	"github.com/idena-network/idena-go/common/pushpull"
 	"github.com/idena-network/idena-go/config"
@@ -24,9 +25,13 @@ import (
 	"github.com/libp2p/go-libp2p-core/helpers"
 	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/peerstore"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/multiformats/go-multiaddr"
+	"github.com/patrickmn/go-cache"
 	"github.com/pkg/errors"
+	"runtime/debug"
+	"sort"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -36,10 +41,30 @@ import (
 var IdenaProtocolPath = "/idena/gossip"
 var IdenaProtocol = core.ProtocolID(IdenaProtocolPath + "/1.1.0")
 
-const MempoolSyncDelay = time.Second * 5
+const (
+	MempoolSyncDelay     = time.Second * 5
+	maxTxsPerRequest     = 200
+	headRequestTimeout   = time.Second * 20
+	maxAnnouncedBlockAge = time.Minute
+
+	defaultMaxBlockRangeResponseSize = 4 * 1024 * 1024
+	defaultMaxPoolSyncTxs            = 5000
+	// transactions pulled after a mempool sync are requested poolSyncRequestsBurst at once and then one per
+	// poolSyncRequestInterval, it keeps the requests within the default GetTransactions rate limit of the peer
+	poolSyncRequestsBurst   = 10
+	poolSyncRequestInterval = time.Millisecond * 250
+	// fast sync requests FastSyncBatchSize+1 blocks at once, so peers running older versions must fit in
+	defaultMaxBlocksPerRange = FastSyncBatchSize + 1
+
+	ownTxOrderTrusted = "trusted"
+	ownTxOrderLatency = "latency"
+	ownTxOrderRandom  = "random"
+)
 
 var (
 	batchId = uint32(1)
+
+	errImplausibleHeight = errors.New("reported height is implausible")
 )
 
 type IdenaGossipHandler struct {
@@ -70,19 +95,56 @@ type IdenaGossipHandler struct {
 	mutex            sync.Mutex
 	pendingPeers     map[peer.ID]struct{}
 	metrics          *metricCollector
+	traffic          *peerTraffic
 	ceremonyChecker  CeremonyChecker
 	connManager      *ConnManager
 	pubsub           *pubsub.PubSub
+	trustedPeers     map[peer.ID]struct{}
+	staticPeers      map[peer.ID]struct{}
+	pinnedMutex      sync.RWMutex
+	rateLimits       map[uint64]rateLimit
+	handshakeRules   handshakeRules
+	progress         *progressTracker
+	syncRetries      syncRetries
+	// poolSynced is set once the mempool is requested from a peer after start
+	poolSynced    uint32
+	heightUpdates chan PeerHeight
+	// stopped is set once the handler is stopped, new peers are rejected after that
+	stopped      uint32
+	reconnects   *reconnector
+	knownPeers   *peerBook
+	peerSelector PeerSelector
+	// proofs are validated by the proof workers
+	proofs chan *types.ProofProposal
+	// votes are verified by the vote workers, voters of verified votes are cached by the vote hash
+	voteBatches    chan []*types.Vote
+	verifiedVoters *cache.Cache
+
+	blockRequests      map[common.Hash]*blockRequest
+	blockRequestsMutex sync.Mutex
+	flipRequests       map[string]time.Time
+	flipRequestsMutex  sync.Mutex
+
+	headerRequests      map[headersRequestKey]*headersRequest
+	headerRequestsMutex sync.Mutex
+}
+
+// PeerHeight is sent to HeightUpdates subscribers when a peer reports a taller chain
+type PeerHeight struct {
+	Id     peer.ID
+	Height uint64
 }
 
 type metricCollector struct {
 	incomeMessage  func(code uint64, size int, duration time.Duration, peerId string)
 	outcomeMessage func(code uint64, size int, duration time.Duration, peerId string)
 	compress       func(code uint64, size int)
+	dropMessage    func(code uint64)
+	rateLimit      func(code uint64)
 }
 
 func NewIdenaGossipHandler(host core.Host, pubsub *pubsub.PubSub, cfg config.P2P, chain *blockchain.Blockchain, proposals *pengings.Proposals, votes *pengings.Votes, txpool *mempool.TxPool, fp *flip.Flipper, bus eventbus.Bus, flipKeyPool *mempool.KeysPool, appVersion string, ceremonyChecker CeremonyChecker) *IdenaGossipHandler {
-	logger := log.New()
+	logger := log.New(log.ModuleKey, "p2p")
 	throttlingLogger := log.NewThrottlingLogger(logger)
 	handler := &IdenaGossipHandler{
 		host:                host,
@@ -109,11 +171,23 @@ func NewIdenaGossipHandler(host core.Host, pubsub *pubsub.PubSub, cfg config.P2P
 		metrics:             new(metricCollector),
 		ceremonyChecker:     ceremonyChecker,
 		connManager:         NewConnManager(host, cfg),
+		trustedPeers:        parseTrustedPeers(cfg.TrustedPeers),
+		rateLimits:          buildRateLimits(cfg.RateLimits),
+		handshakeRules:      newHandshakeRules(cfg),
+		progress:            &progressTracker{},
+		peerSelector:        newPeerSelector(cfg.PeerSelector),
+		heightUpdates:       make(chan PeerHeight, 1),
+		traffic:             newPeerTraffic(),
+	}
+	handler.reconnects = handler.newReconnector()
+	handler.knownPeers = newPeerBook()
+	if cfg.PeersFile != "" {
+		handler.knownPeers.restore(cfg.PeersFile)
 	}
-	handler.pushPullManager.AddEntryHolder(pushVote, pushpull.NewDefaultHolder(1, pushpull.NewDefaultPushTracker(time.Millisecond*300)))
-	handler.pushPullManager.AddEntryHolder(pushBlock, pushpull.NewDefaultHolder(1, pushpull.NewDefaultPushTracker(time.Second*3)))
-	handler.pushPullManager.AddEntryHolder(pushProof, pushpull.NewDefaultHolder(1, pushpull.NewDefaultPushTracker(time.Second*1)))
-	handler.pushPullManager.AddEntryHolder(pushFlip, pushpull.NewDefaultHolder(1, pushpull.NewDefaultPushTracker(time.Second*5)))
+	handler.pushPullManager.AddEntryHolder(pushVote, newSeenCache(cfg.SeenCaches, pushVote, 1, pushpull.NewDefaultPushTracker(time.Millisecond*300)))
+	handler.pushPullManager.AddEntryHolder(pushBlock, newSeenCache(cfg.SeenCaches, pushBlock, 1, pushpull.NewDefaultPushTracker(time.Second*3)))
+	handler.pushPullManager.AddEntryHolder(pushProof, newSeenCache(cfg.SeenCaches, pushProof, 1, pushpull.NewDefaultPushTracker(time.Second*1)))
+	handler.pushPullManager.AddEntryHolder(pushFlip, newSeenCache(cfg.SeenCaches, pushFlip, 1, pushpull.NewDefaultPushTracker(time.Second*5)))
 	handler.pushPullManager.AddEntryHolder(pushKeyPackage, flipKeyPool)
 	handler.pushPullManager.AddEntryHolder(pushTx, txpool)
 	handler.pushPullManager.Run()
@@ -133,7 +207,24 @@ func (h *IdenaGossipHandler) Start() {
 		h.host.Network().Notify(notifiee)
 	}
 	setHandler()
+	go h.dialKnownPeers()
 
+	h.bus.Subscribe(events.IpfsPortChangedEventId, func(e eventbus.Event) {
+		portChangedEvent := e.(*events.IpfsPortChangedEvent)
+		h.host = portChangedEvent.Host
+		h.pubsub = portChangedEvent.PubSub
+		setHandler()
+	})
+	h.startGossip()
+
+	go h.checkTime()
+	go h.background()
+	go h.watchShardSubscription()
+	go h.connectStaticPeers(h.cfg.StaticPeers)
+}
+
+// startGossip subscribes to local events which are relayed to peers and starts loops which don't depend on the host
+func (h *IdenaGossipHandler) startGossip() {
 	h.bus.Subscribe(events.NewTxEventID, func(e eventbus.Event) {
 		newTxEvent := e.(*events.NewTxEvent)
 		h.txChan <- newTxEvent
@@ -150,14 +241,9 @@ func (h *IdenaGossipHandler) Start() {
 		newFlipEvent := e.(*events.NewFlipEvent)
 		h.sendFlip(newFlipEvent.Flip)
 	})
-	h.bus.Subscribe(events.IpfsPortChangedEventId, func(e eventbus.Event) {
-		portChangedEvent := e.(*events.IpfsPortChangedEvent)
-		h.host = portChangedEvent.Host
-		h.pubsub = portChangedEvent.PubSub
-		setHandler()
-	})
-
 	h.bus.Subscribe(events.AddBlockEventID, func(e eventbus.Event) {
+		newBlockEvent := e.(*events.NewBlockEvent)
+		h.AnnounceBlockAsync(newBlockEvent.Block)
 		shardId := h.OwnPeeringShardId()
 		if h.connManager.SetShardId(shardId) {
 			h.notifyAboutShardUpdate(shardId)
@@ -169,15 +255,17 @@ func (h *IdenaGossipHandler) Start() {
 	h.connManager.SetShardId(shardId)
 	h.peers.SetOwnShardId(shardId)
 
+	h.startProofWorkers()
+	h.startVoteWorkers()
 	go h.broadcastLoop()
-	go h.checkTime()
-	go h.background()
-	go h.watchShardSubscription()
+	go h.checkPendingRequests()
 }
 
 func (h *IdenaGossipHandler) background() {
 	dialTicker := time.NewTicker(time.Second * 15)
 	renewTicker := time.NewTicker(time.Minute * 5)
+	scoreTicker := time.NewTicker(peerScoreCheckInterval)
+	knownPeersTicker := time.NewTicker(knownPeersSaveInterval)
 
 	for {
 		select {
@@ -185,6 +273,10 @@ func (h *IdenaGossipHandler) background() {
 			h.dialPeers()
 		case <-renewTicker.C:
 			h.renewPeers()
+		case <-scoreTicker.C:
+			h.disconnectLowScorePeers()
+		case <-knownPeersTicker.C:
+			h.saveKnownPeers()
 		}
 	}
 }
@@ -196,11 +288,24 @@ func (h *IdenaGossipHandler) checkTime() {
 	}
 }
 
-func (h *IdenaGossipHandler) handle(p *protoPeer) error {
+func (h *IdenaGossipHandler) handle(p *protoPeer) (err error) {
 	msg, err := p.ReadMsg()
 	if err != nil {
 		return err
 	}
+	// a message which crashes the handler can only be crafted on purpose, so the peer is banned
+	defer func() {
+		if r := recover(); r != nil {
+			p.log.Error("Message handler panicked", "code", msgCodeToString(msg.Code), "err", r, "stack", string(debug.Stack()))
+			err = errResp(PanicErr, "%v handler panicked: %v", msgCodeToString(msg.Code), r)
+		}
+	}()
+	if allowed, err := h.allowMsg(p, msg.Code, len(msg.Payload)); !allowed {
+		return err
+	}
+	if p.observer && isGossipMsg(msg.Code) {
+		return nil
+	}
 	switch msg.Code {
 	case BlocksRange:
 		var response blockRange
@@ -212,6 +317,13 @@ func (h *IdenaGossipHandler) handle(p *protoPeer) error {
 			return errResp(ValidationErr, "%v", msg)
 		}
 		p.log.Trace("Income blocks range", "batchId", response.BatchId)
+		if p.pendingRequests.Resolve(GetBlocksRange, uint64(response.BatchId)) || p.pendingRequests.Resolve(GetForkBlockRange, uint64(response.BatchId)) {
+			h.onResponse(p)
+		} else if !h.hasIncomeBatch(p.id, response.BatchId) {
+			// the penalty is small since the response may come after the request is expired
+			h.penalize(p, unsolicitedScorePenalty, "unsolicited blocks range")
+			return nil
+		}
 		if ib, ok := h.incomeBatches.Load(p.id); ok {
 			peerBatches := ib.(*sync.Map)
 			if pb, ok := peerBatches.Load(response.BatchId); ok {
@@ -220,7 +332,12 @@ func (h *IdenaGossipHandler) handle(p *protoPeer) error {
 					batch.headers <- b
 					p.setHeight(b.Header.Height())
 				}
-				close(batch.headers)
+				if response.More {
+					return nil
+				}
+				if batch.completePart() {
+					close(batch.headers)
+				}
 				h.batchedLock.Lock()
 				peerBatches.Delete(response.BatchId)
 				if maputil.IsSyncMapEmpty(peerBatches) {
@@ -242,8 +359,9 @@ func (h *IdenaGossipHandler) handle(p *protoPeer) error {
 		p.markKey(key)
 		// if peer proposes this msg it should be on `query.Round-1` height
 		p.setHeight(proposal.Round - 1)
-		if ok, _ := h.proposals.AddProposeProof(proposal); ok {
-			h.ProposeProof(proposal)
+		// the peer's loop is held up by the proof check only if the workers are overloaded
+		if !h.enqueueProof(proposal) {
+			h.processProof(proposal)
 		}
 	case ProposeBlock:
 		proposal := new(types.BlockProposal)
@@ -267,21 +385,37 @@ func (h *IdenaGossipHandler) handle(p *protoPeer) error {
 			h.ProposeBlock(proposal)
 		}
 	case Vote:
-		vote := new(types.Vote)
-		if err := vote.FromBytes(msg.Payload); err != nil {
+		vote, err := h.processVote(p, msg.Payload)
+		if err != nil {
+			return err
+		}
+		if vote != nil {
+			h.handleVotes([]*types.Vote{vote})
+		}
+	case BatchVote:
+		batch := new(msgBatch)
+		if err := batch.FromBytes(msg.Payload); err != nil {
 			return errResp(DecodeErr, "%v: %v", msg, err)
 		}
-		if !vote.IsValid() {
-			return errResp(ValidationErr, "%v", msg)
+		if len(batch.Data) > voteBatchSize {
+			return errResp(ValidationErr, "too many votes in batch: %v", len(batch.Data))
 		}
-		key := msgKey(msg.Payload)
-		if h.isProcessed(key) {
-			return nil
+		votes := make([]*types.Vote, 0, len(batch.Data))
+		for _, item := range batch.Data {
+			// batched votes are limited as single ones, so batching doesn't raise the vote rate
+			if allowed, err := h.allowMsg(p, Vote, len(item.Payload)); !allowed {
+				return err
+			}
+			vote, err := h.processVote(p, item.Payload)
+			if err != nil {
+				return err
+			}
+			if vote != nil {
+				votes = append(votes, vote)
+			}
 		}
-		p.markKey(key)
-		p.setPotentialHeight(vote.Header.Round - 1)
-		if h.votes.AddVote(vote) {
-			h.SendVote(vote)
+		if len(votes) > 0 {
+			h.handleVotes(votes)
 		}
 	case NewTx:
 		tx := new(types.Transaction)
@@ -305,11 +439,131 @@ func (h *IdenaGossipHandler) handle(p *protoPeer) error {
 		if block != nil {
 			p.sendMsg(Block, block, common.MultiShard, false)
 		}
+	case GetTransactions:
+		query := new(models.ProtoGetTransactionsRequest)
+		if err := proto.Unmarshal(msg.Payload, query); err != nil {
+			return errResp(DecodeErr, "%v: %v", msg, err)
+		}
+		h.provideTransactions(p, query)
+	case Transactions:
+		response := new(models.ProtoTransactions)
+		if err := proto.Unmarshal(msg.Payload, response); err != nil {
+			return errResp(DecodeErr, "%v: %v", msg, err)
+		}
+		if len(response.Transactions) > maxTxsPerRequest {
+			return errResp(ValidationErr, "%v: too many transactions", msg)
+		}
+		if !p.pendingRequests.Resolve(GetTransactions, response.Id) {
+			return nil
+		}
+		h.onResponse(p)
+		var txs []*types.Transaction
+		for _, protoTx := range response.Transactions {
+			tx := new(types.Transaction).FromProto(protoTx)
+			data, _ := tx.ToBytes()
+			key := msgKey(data)
+			if h.isProcessed(key) {
+				continue
+			}
+			p.markKey(key)
+			txs = append(txs, tx)
+		}
+		if len(txs) > 0 {
+			if err := h.txpool.AddExternalTxs(validation.InboundTx, txs...); err != nil {
+				h.throttlingLogger.Warn("Failed to add external txs", "err", err)
+			}
+		}
+	case GetPooledTransactions:
+		query := new(models.ProtoGetPooledTransactionsRequest)
+		if err := proto.Unmarshal(msg.Payload, query); err != nil {
+			return errResp(DecodeErr, "%v: %v", msg, err)
+		}
+		h.providePooledTransactions(p, query.Id)
+	case PooledTransactions:
+		response := new(models.ProtoPooledTransactions)
+		if err := proto.Unmarshal(msg.Payload, response); err != nil {
+			return errResp(DecodeErr, "%v: %v", msg, err)
+		}
+		if !p.pendingRequests.Resolve(GetPooledTransactions, response.Id) {
+			return nil
+		}
+		h.onResponse(p)
+		h.requestMissingTransactions(p, response.Hashes)
+	case GetStateProof:
+		if !h.handshakeRules.serveLight {
+			return nil
+		}
+		query := new(models.ProtoGetStateProofRequest)
+		if err := proto.Unmarshal(msg.Payload, query); err != nil {
+			return errResp(DecodeErr, "%v: %v", msg, err)
+		}
+		if len(query.Address) != common.AddressLength {
+			return errResp(ValidationErr, "%v: invalid address", msg)
+		}
+		h.provideStateProof(p, query)
+	case StateProof:
+		// state proofs are requested by light clients, full nodes don't request them
+	case GetBlockHeaders:
+		if p.light && !h.handshakeRules.serveLight {
+			return nil
+		}
+		query := new(models.ProtoGetBlockHeadersRequest)
+		if err := proto.Unmarshal(msg.Payload, query); err != nil {
+			return errResp(DecodeErr, "%v: %v", msg, err)
+		}
+		h.provideBlockHeaders(p, query)
+	case BlockHeaders:
+		response := new(models.ProtoBlockHeaders)
+		if err := proto.Unmarshal(msg.Payload, response); err != nil {
+			return errResp(DecodeErr, "%v: %v", msg, err)
+		}
+		return h.onBlockHeaders(p, response)
+	case GetFlip:
+		query := new(models.ProtoGetFlipRequest)
+		if err := proto.Unmarshal(msg.Payload, query); err != nil {
+			return errResp(DecodeErr, "%v: %v", msg, err)
+		}
+		if len(query.Cid) == 0 || len(query.Cid) > maxFlipCidLength {
+			return errResp(ValidationErr, "%v: invalid cid", msg)
+		}
+		h.provideFlip(p, query)
+	case Flip:
+		response := new(models.ProtoFlipResponse)
+		if err := proto.Unmarshal(msg.Payload, response); err != nil {
+			return errResp(DecodeErr, "%v: %v", msg, err)
+		}
+		h.onFlipResponse(p, response)
+	case Ping:
+		ping := new(models.ProtoPing)
+		if err := proto.Unmarshal(msg.Payload, ping); err != nil {
+			return errResp(DecodeErr, "%v: %v", msg, err)
+		}
+		p.sendMsg(Pong, ping, common.MultiShard, true)
+	case Status:
+		status := new(models.ProtoStatus)
+		if err := proto.Unmarshal(msg.Payload, status); err != nil {
+			return errResp(DecodeErr, "%v: %v", msg, err)
+		}
+		p.setHead(status.Height, common.BytesToHash(status.HeadHash))
+	case Pong:
+		pong := new(models.ProtoPing)
+		if err := proto.Unmarshal(msg.Payload, pong); err != nil {
+			return errResp(DecodeErr, "%v: %v", msg, err)
+		}
+		select {
+		case p.pongs <- pong.Nonce:
+		default:
+		}
 	case GetBlocksRange:
 		query := new(models.ProtoGetBlocksRangeRequest)
 		if err := proto.Unmarshal(msg.Payload, query); err != nil {
 			return errResp(DecodeErr, "%v: %v", msg, err)
 		}
+		if query.To < query.From || query.To-query.From >= h.maxBlocksPerRange() {
+			h.penalize(p, invalidMsgScorePenalty, "too large blocks range requested")
+			p.sendMsg(BlocksRange, &blockRange{BatchId: query.BatchId}, common.MultiShard, false)
+			return nil
+		}
 		h.provideBlocks(p, query.BatchId, query.From, query.To)
 	case GetForkBlockRange:
 		query := new(models.ProtoGetForkBlockRangeRequest)
@@ -354,6 +608,9 @@ func (h *IdenaGossipHandler) handle(p *protoPeer) error {
 		if err := batch.FromBytes(msg.Payload); err != nil {
 			return errResp(DecodeErr, "%v: %v", msg, err)
 		}
+		if len(batch.Data) > maxBatchItems {
+			return errResp(ValidationErr, "too many items in batch: %v", len(batch.Data))
+		}
 		for _, i := range batch.Data {
 			flipKey := new(types.PublicFlipKey)
 			if err := flipKey.FromBytes(i.Payload); err != nil {
@@ -402,9 +659,14 @@ func (h *IdenaGossipHandler) handle(p *protoPeer) error {
 			return errResp(ValidationErr, "%v", msg)
 		}
 		key := msgKey(msg.Payload)
-		if pushHash.Type == pushKeyPackage {
+		switch pushHash.Type {
+		case pushKeyPackage:
 			p.markKeyWithExpiration(key, flipKeyMsgCacheAliveTime)
-		} else {
+		case pushTx:
+			p.markTxKey(key)
+		case pushFlip:
+			p.markFlipKey(key)
+		default:
 			p.markKey(key)
 		}
 		h.pushPullManager.addPush(p.id, *pushHash)
@@ -413,6 +675,9 @@ func (h *IdenaGossipHandler) handle(p *protoPeer) error {
 		if err := batch.FromBytes(msg.Payload); err != nil {
 			return errResp(DecodeErr, "%v: %v", msg, err)
 		}
+		if len(batch.Data) > maxBatchItems {
+			return errResp(ValidationErr, "too many items in batch: %v", len(batch.Data))
+		}
 		for _, item := range batch.Data {
 			pushHash := new(pushPullHash)
 			if err := pushHash.FromBytes(item.Payload); err != nil {
@@ -422,9 +687,14 @@ func (h *IdenaGossipHandler) handle(p *protoPeer) error {
 				return errResp(ValidationErr, "%v", msg)
 			}
 			key := msgKey(item.Payload)
-			if pushHash.Type == pushKeyPackage {
+			switch pushHash.Type {
+			case pushKeyPackage:
 				p.markKeyWithExpiration(key, flipKeyMsgCacheAliveTime)
-			} else {
+			case pushTx:
+				p.markTxKey(key)
+			case pushFlip:
+				p.markFlipKey(key)
+			default:
 				p.markKey(key)
 			}
 			h.pushPullManager.addPush(p.id, *pushHash)
@@ -449,6 +719,10 @@ func (h *IdenaGossipHandler) handle(p *protoPeer) error {
 		if !block.IsValid() {
 			return errResp(ValidationErr, "%v", msg)
 		}
+		if p.pendingRequests.ResolveByKey(GetBlockByHash, block.Hash()) {
+			h.onResponse(p)
+		}
+		h.completeBlockRequest(block.Hash())
 		key := msgKey(msg.Payload)
 		if h.isProcessed(key) {
 			return nil
@@ -468,19 +742,37 @@ func (h *IdenaGossipHandler) handle(p *protoPeer) error {
 			return errResp(DecodeErr, "%v: %v", msg, err)
 		}
 		p.disconnectReason = dc.Reason
+	case NewBlockHash:
+		announcement := new(newBlockHash)
+		if err := announcement.FromBytes(msg.Payload); err != nil {
+			return errResp(DecodeErr, "%v: %v", msg, err)
+		}
+		p.setHeight(announcement.Height)
+		key := msgKey(msg.Payload)
+		if h.isProcessed(key) {
+			p.markKey(key)
+			return nil
+		}
+		p.markKey(key)
+		h.handleBlockAnnouncement(p, announcement)
 	}
 
 	return nil
 }
 
 func (h *IdenaGossipHandler) acceptStream(stream network.Stream) {
-	if h.connManager.CanConnect(stream.Conn().RemotePeer()) && (h.connManager.CanAcceptStream() ||
+	id := stream.Conn().RemotePeer()
+	if !h.isPinned(id) && !h.connManager.CanAcceptFrom(stream.Conn().RemoteMultiaddr()) {
+		h.log.Debug("cannot accept stream, too many peers from the address", "peerId", id.Pretty(), "addr", stream.Conn().RemoteMultiaddr())
+		stream.Reset()
+		return
+	}
+	if h.connManager.CanConnect(id) && (h.connManager.CanAcceptStream() || h.isPinned(id) ||
 		h.connManager.NeedInboundOwnShardPeers() || h.connManager.NeedPeerFromSomeShard(int(h.bcn.ShardsNum()))) {
 		if _, err := h.runPeer(stream, true); err != nil {
 			h.log.Debug("failed to run inbound peer", "err", err)
 		}
 	} else {
-		id := stream.Conn().RemotePeer()
 		h.log.Debug("cannot accept stream", "peerId", id.Pretty(), "canConnect", h.connManager.CanConnect(stream.Conn().RemotePeer()), "canAccept", h.connManager.CanAcceptStream(), "needOwn", h.connManager.NeedInboundOwnShardPeers())
 	}
 }
@@ -509,6 +801,11 @@ func (h *IdenaGossipHandler) runPeer(stream network.Stream, inbound bool) (*prot
 		h.mutex.Unlock()
 		return nil, errors.New("peer is already connecting")
 	}
+	if atomic.LoadUint32(&h.stopped) == 1 {
+		h.mutex.Unlock()
+		stream.Reset()
+		return nil, errors.New("handler is stopped")
+	}
 	h.pendingPeers[peerId] = struct{}{}
 	h.mutex.Unlock()
 
@@ -518,22 +815,47 @@ func (h *IdenaGossipHandler) runPeer(stream network.Stream, inbound bool) (*prot
 		h.mutex.Unlock()
 	}()
 
-	peer := newPeer(stream, h.cfg.MaxDelay, h.metrics)
+	peer := newPeer(stream, h.cfg.MaxDelay, h.metrics, h.rateLimits, h.cfg.PeerQueues)
+	peer.sendLimiter = newByteLimiter(h.cfg.MaxOutboundBytesPerSecondPerPeer)
+	peer.serveLimiter = newByteLimiter(h.cfg.MaxServedBytesPerSecondPerPeer)
+	if h.cfg.MsgCacheSize > 0 || h.cfg.MsgCacheTTL > 0 {
+		peer.msgCache = knowncache.New(h.msgCacheSize(), h.msgCacheTTL())
+	}
+	if h.cfg.KnownTxsFilterFPRate > 0 {
+		peer.knownTxs = newRollingBloom(knownTxsFilterCapacity, h.cfg.KnownTxsFilterFPRate)
+		peer.knownFlips = newRollingBloom(knownFlipsFilterCapacity, h.cfg.KnownTxsFilterFPRate)
+	}
+	peer.pendingRequests.tracker = h.progress
+	peer.traffic.total = h.traffic
+	peer.heightIncreased = h.onPeerHeightIncreased
 
-	if err := peer.Handshake(h.bcn.Network(), h.bcn.Head.Height(), h.bcn.GenesisInfo(), h.appVersion, uint32(h.peers.Len()), h.OwnPeeringShardId()); err != nil {
+	rules := h.handshakeRules
+	rules.listenAddrs = h.advertisedAddrs()
+	if err := peer.Handshake(h.bcn.Network(), h.bcn.Head.Height(), h.bcn.Head.Hash(), h.bcn.GenesisInfo(), h.appVersion, uint32(h.peers.Len()), h.OwnPeeringShardId(), rules); err != nil {
 		current := semver.New(h.appVersion)
 		if other, errS := semver.NewVersion(peer.appVersion); errS != nil || other.Major > current.Major || other.Minor >= current.Minor && other.Major == current.Major {
 			peer.log.Debug("Idena handshake failed", "err", err)
 		}
-		peer.disconnect("")
+		if errors.Cause(err) == errImplausibleHeight && !h.isTrusted(peer.id) {
+			peer.log.Info("Peer is banned due to implausible height", "err", err)
+			h.connManager.BanPeerFor(peer.id, lowScoreBanDuration)
+		}
+		peer.disconnect(DiscHandshakeFailed, err)
 		return nil, err
 	}
 
+	if err := h.checkRemoteId(peer); err != nil {
+		return nil, err
+	}
+	if peer.light {
+		peer.rateLimiter = newRateLimiter(withLightRateLimits(h.rateLimits))
+	}
+
 	canConnect, shouldDisconnectAnotherPeer := h.connManager.NeedPeerFromShard(inbound, peer.shardId)
 
-	if !canConnect {
-		log.Info("no slots for shard, peer will be disconnected", "peerId", peer.id, "shardId", peer.shardId)
-		peer.disconnect("no slots for shard")
+	if !canConnect && !h.isPinned(peer.id) {
+		peer.Log().Info("no slots for shard, peer will be disconnected", "shardId", peer.shardId)
+		peer.disconnect(DiscNoSlots, nil)
 		return nil, errors.New("no slots")
 	}
 
@@ -545,21 +867,39 @@ func (h *IdenaGossipHandler) runPeer(stream network.Stream, inbound bool) (*prot
 		if peer != nil {
 			dcPeer = peer.ID()
 			dcShard = peer.shardId
-			peer.disconnect("unnecessary shard")
+			peer.disconnect(DiscUnnecessaryShard, nil)
 		}
 	}
 
-	h.peers.Register(peer)
-	h.connManager.Connected(peer.id, inbound, peer.shardId)
+	if err := h.peers.Register(peer); err != nil {
+		peer.disconnect(DiscAlreadyConnected, err)
+		return nil, err
+	}
+	h.connManager.Connected(peer.id, inbound, peer.shardId, peer.stream.Conn().RemoteMultiaddr())
+	if len(peer.listenAddrs) > 0 && !peer.light {
+		// inbound peers are connected from ephemeral ports, so advertised addresses are the way to dial them later
+		h.host.Peerstore().AddAddrs(peer.id, peer.listenAddrs, peerstore.AddressTTL)
+	}
 	h.host.ConnManager().TagPeer(peer.id, "idena", IdenaProtocolWeight)
+	if h.reconnects != nil {
+		h.reconnects.Reset(peer.id)
+	}
 
 	go h.runListening(peer)
 	go peer.broadcast()
+	go h.requestHead(peer, h.bcn.Head.Height())
+	if peer.Supports(PingPong) {
+		go h.pingLoop(peer)
+	}
+	if peer.Supports(StatusUpdates) {
+		go h.statusLoop(peer)
+	}
 
 	go h.highPrioritySync(peer)
 	go func() {
 		time.Sleep(MempoolSyncDelay)
-		if !peer.closed {
+		if !peer.isClosed() {
+			h.requestPooledTransactions(peer)
 			h.syncTxPool(peer)
 			h.syncFlipKeyPool(peer)
 		}
@@ -567,13 +907,56 @@ func (h *IdenaGossipHandler) runPeer(stream network.Stream, inbound bool) (*prot
 
 	h.sendManifest(peer)
 
-	h.log.Info("Peer connected", "id", peer.id.Pretty(), "inbound", inbound, "shardId", peer.shardId)
+	peer.Log().Info("Peer connected", "inbound", inbound, "shardId", peer.shardId)
 	if shouldDisconnectAnotherPeer {
 		h.log.Info("Selected to dc", "id", dcPeer, "shardId", dcShard)
 	}
 	return peer, nil
 }
 
+// checkRemoteId disconnects the peer if it's the node itself or another connection to an already connected peer
+func (h *IdenaGossipHandler) checkRemoteId(p *protoPeer) error {
+	var reason DiscReason
+	switch {
+	case p.id == h.host.ID():
+		reason = DiscSelfConnection
+	case h.peers.Peer(p.id) != nil:
+		reason = DiscAlreadyConnected
+	default:
+		return nil
+	}
+	err := errors.New(reason.String())
+	p.disconnect(reason, err)
+	return err
+}
+
+// Stop rejects new connections and closes connections to all peers after sending messages which are already queued for them,
+// it returns once all peers are closed
+func (h *IdenaGossipHandler) Stop() {
+	if !atomic.CompareAndSwapUint32(&h.stopped, 0, 1) {
+		return
+	}
+	if h.host != nil {
+		h.host.RemoveStreamHandler(IdenaProtocol)
+	}
+	if h.knownPeers != nil {
+		h.saveKnownPeers()
+	}
+	drainTimeout := h.cfg.ShutdownDrainTimeout
+	if drainTimeout <= 0 {
+		drainTimeout = defaultDrainTimeout
+	}
+	wg := sync.WaitGroup{}
+	for _, p := range h.peers.Peers() {
+		wg.Add(1)
+		go func(p *protoPeer) {
+			defer wg.Done()
+			p.Close(drainTimeout)
+		}(p)
+	}
+	wg.Wait()
+}
+
 func (h *IdenaGossipHandler) unregisterPeer(peerId peer.ID) {
 	peer := h.peers.Peer(peerId)
 	if peer == nil {
@@ -582,9 +965,8 @@ func (h *IdenaGossipHandler) unregisterPeer(peerId peer.ID) {
 	if err := h.peers.Unregister(peerId); err != nil {
 		return
 	}
-	peer.closed = true
-	close(peer.term)
-	peer.disconnect("")
+	atomic.StoreUint32(&peer.closed, 1)
+	peer.disconnect(DiscQuitting, nil)
 
 	var err error
 	select {
@@ -594,14 +976,24 @@ func (h *IdenaGossipHandler) unregisterPeer(peerId peer.ID) {
 
 	h.connManager.Disconnected(peerId, err)
 	h.host.ConnManager().UntagPeer(peerId, "idena")
+	if h.knownPeers != nil {
+		h.recordPeer(peer)
+	}
 	if peer.disconnectReason == "" {
-		h.log.Info("Peer disconnected", "id", peerId.Pretty(), "shardId", peer.shardId)
+		reason, _ := peer.LastDisconnectReason()
+		peer.Log().Info("Peer disconnected", "shardId", peer.shardId, "reason", reason)
 	} else {
-		h.log.Info("Peer aborts connection", "id", peerId.Pretty(), "shardId", peer.shardId, "reason", peer.disconnectReason)
+		peer.Log().Info("Peer aborts connection", "shardId", peer.shardId, "reason", peer.disconnectReason)
+	}
+	if h.reconnects != nil && h.isPinned(peerId) {
+		h.reconnects.Schedule(peerId)
 	}
 }
 
 func (h *IdenaGossipHandler) dialPeers() {
+	if atomic.LoadUint32(&h.stopped) == 1 {
+		return
+	}
 	go func() {
 		attempts := make(map[peer.ID]struct{})
 		for i := 0; i < 5; i++ {
@@ -631,16 +1023,16 @@ func (h *IdenaGossipHandler) renewPeers() {
 	if !h.connManager.CanDial() {
 		peerId := h.connManager.GetRandomPeer(false)
 		peer := h.peers.Peer(peerId)
-		if peer != nil {
-			peer.disconnect("peer was selected to disconnect while renewing peers")
+		if peer != nil && !h.isPinned(peerId) {
+			peer.disconnect(DiscRenewPeers, nil)
 		}
 	}
 
 	if !h.connManager.CanAcceptStream() {
 		peerId := h.connManager.GetRandomPeer(true)
 		peer := h.peers.Peer(peerId)
-		if peer != nil {
-			peer.disconnect("peer was selected to disconnect while renewing peers")
+		if peer != nil && !h.isPinned(peerId) {
+			peer.disconnect(DiscRenewPeers, nil)
 		}
 	}
 }
@@ -661,6 +1053,15 @@ func (h *IdenaGossipHandler) isProcessed(msgKey string) bool {
 	return h.peers.hasKey(msgKey)
 }
 
+func (h *IdenaGossipHandler) hasIncomeBatch(peerId peer.ID, batchId uint32) bool {
+	ib, ok := h.incomeBatches.Load(peerId)
+	if !ok {
+		return false
+	}
+	_, ok = ib.(*sync.Map).Load(batchId)
+	return ok
+}
+
 func (h *IdenaGossipHandler) provideBlocks(p *protoPeer, batchId uint32, from uint64, to uint64) {
 	var result []*block
 	p.log.Trace("blocks requested", "from", from, "to", to)
@@ -678,10 +1079,21 @@ func (h *IdenaGossipHandler) provideBlocks(p *protoPeer, batchId uint32, from ui
 		}
 	}
 	p.log.Trace("blocks returned", "len", len(result))
-	p.sendMsg(BlocksRange, &blockRange{
-		BatchId: batchId,
-		Blocks:  result,
-	}, common.MultiShard, false)
+	parts := [][]*block{result}
+	if p.Supports(SplitRanges) {
+		maxSize := h.cfg.MaxBlockRangeResponseSize
+		if maxSize <= 0 {
+			maxSize = defaultMaxBlockRangeResponseSize
+		}
+		parts = splitBlockRange(result, maxSize)
+	}
+	for idx, part := range parts {
+		p.sendMsg(BlocksRange, &blockRange{
+			BatchId: batchId,
+			Blocks:  part,
+			More:    idx < len(parts)-1,
+		}, common.MultiShard, false)
+	}
 }
 
 func (h *IdenaGossipHandler) provideForkBlocks(p *protoPeer, batchId uint32, blocks []common.Hash) {
@@ -706,6 +1118,13 @@ func (h *IdenaGossipHandler) runListening(peer *protoPeer) {
 	for {
 		if err := h.handle(peer); err != nil {
 			peer.log.Debug("Idena message handling failed", "err", err)
+			if msgErr, ok := err.(*msgError); ok {
+				if msgErr.code == PanicErr {
+					h.BanPeer(peer.id, msgErr)
+				} else {
+					h.penalize(peer, invalidMsgScorePenalty, msgErr.Error())
+				}
+			}
 			return
 		}
 	}
@@ -733,11 +1152,24 @@ func (h *IdenaGossipHandler) GetKnownHeights() map[peer.ID]uint64 {
 		return nil
 	}
 	for _, peer := range peers {
+		if peer.observer {
+			continue
+		}
 		result[peer.id] = peer.knownHeight.Read()
 	}
 	return result
 }
 
+// BestSyncPeer returns the id of the peer chosen by the configured selector among peers which have the next block,
+// the id is empty if there is no such peer
+func (h *IdenaGossipHandler) BestSyncPeer() peer.ID {
+	next := h.bcn.Head.Height() + 1
+	if p := h.selector().Select(h.peers.Peers(), SelectHint{From: next, To: next}); p != nil {
+		return p.id
+	}
+	return ""
+}
+
 func (h *IdenaGossipHandler) GetKnownManifests() map[peer.ID]*snapshot.Manifest {
 	result := make(map[peer.ID]*snapshot.Manifest)
 	peers := h.peers.Peers()
@@ -771,17 +1203,63 @@ func (h *IdenaGossipHandler) GetBlocksRange(peerId peer.ID, from uint64, to uint
 		peerBatches = &sync.Map{}
 		h.incomeBatches.Store(peerId, peerBatches)
 	}
-	id := atomic.AddUint32(&batchId, 1)
-	peerBatches.(*sync.Map).Store(id, b)
+	ranges := splitRange(from, to, h.maxBlocksPerRange())
+	b.parts = int32(len(ranges))
+	ids := make([]uint32, len(ranges))
+	for i := range ranges {
+		ids[i] = atomic.AddUint32(&batchId, 1)
+		peerBatches.(*sync.Map).Store(ids[i], b)
+	}
 	h.batchedLock.Unlock()
-	peer.sendMsg(GetBlocksRange, &models.ProtoGetBlocksRangeRequest{
-		BatchId: batchId,
-		From:    from,
-		To:      to,
-	}, common.MultiShard, false)
+	for i, r := range ranges {
+		peer.pendingRequests.Add(uint64(ids[i]), GetBlocksRange, nil, blocksRangeRequestTimeout)
+		peer.sendMsg(GetBlocksRange, &models.ProtoGetBlocksRangeRequest{
+			BatchId: ids[i],
+			From:    r[0],
+			To:      r[1],
+		}, common.MultiShard, false)
+	}
 	return b, nil
 }
 
+// maxBlocksPerRange returns the max number of blocks which can be requested by a single range request
+func (h *IdenaGossipHandler) maxBlocksPerRange() uint64 {
+	if h.cfg.MaxBlocksPerRange > 0 {
+		return h.cfg.MaxBlocksPerRange
+	}
+	return defaultMaxBlocksPerRange
+}
+
+// requestHead asks a registered peer for the header of its head block right after handshake if the peer is ahead of us,
+// so its height is confirmed without waiting for the next sync round
+func (h *IdenaGossipHandler) requestHead(p *protoPeer, ownHeight uint64) {
+	height := p.knownHeight.Read()
+	if height <= ownHeight {
+		return
+	}
+	if p.Supports(HeaderRequests) {
+		// the header is enough to confirm the height, so the certificate and the identity diff aren't loaded
+		if headers, err := h.GetBlockHeaders(p.id, height, 1, 0); err == nil && len(headers) > 0 {
+			p.log.Debug("Peer head received", "height", headers[0].Height(), "hash", headers[0].Hash().Hex())
+		}
+		return
+	}
+	b, err := h.GetBlocksRange(p.id, height, height)
+	if err != nil {
+		return
+	}
+	timeout := time.NewTimer(headRequestTimeout)
+	defer timeout.Stop()
+	select {
+	case block, ok := <-b.headers:
+		if ok && block != nil {
+			p.log.Debug("Peer head received", "height", block.Header.Height(), "hash", block.Header.Hash().Hex())
+		}
+	case <-timeout.C:
+	case <-p.finished:
+	}
+}
+
 func (h *IdenaGossipHandler) GetForkBlockRange(peerId peer.ID, ownBlocks []common.Hash) (*batch, error) {
 	peer := h.peers.Peer(peerId)
 	if peer == nil {
@@ -804,8 +1282,9 @@ func (h *IdenaGossipHandler) GetForkBlockRange(peerId peer.ID, ownBlocks []commo
 	for idx := range ownBlocks {
 		data = append(data, ownBlocks[idx][:])
 	}
+	peer.pendingRequests.Add(uint64(id), GetForkBlockRange, nil, blocksRangeRequestTimeout)
 	peer.sendMsg(GetForkBlockRange, &models.ProtoGetForkBlockRangeRequest{
-		BatchId: batchId,
+		BatchId: id,
 		Blocks:  data,
 	}, common.MultiShard, false)
 	return b, nil
@@ -870,23 +1349,118 @@ func (h *IdenaGossipHandler) sendEntry(p *protoPeer, hash pushPullHash, entry in
 	}
 }
 
+// processVote decodes and validates the vote and marks it as known by the peer, nil vote is returned if the vote has been already processed
+// allowMsg charges the peer's rate limiter for a message, requests for chain data are also dropped while the data served
+// to the peer exceeds its bandwidth limit, the peer is penalized and disconnected if it keeps exceeding limits
+func (h *IdenaGossipHandler) allowMsg(p *protoPeer, code uint64, size int) (bool, error) {
+	allowed := p.rateLimiter.Allow(code, size)
+	if allowed && isServingRequest(code) && p.serveLimiter.exhausted() {
+		p.rateLimiter.Reject(code)
+		allowed = false
+	}
+	if allowed {
+		return true, nil
+	}
+	if p.rateLimiter.Violations() > maxRateLimitViolations {
+		h.penalize(p, rateLimitScorePenalty, "rate limit exceeded")
+		p.disconnect(DiscRateLimit, nil)
+		return false, errors.Errorf("rate limit exceeded, code %v", code)
+	}
+	p.metrics.rateLimit(code)
+	p.throttlingLogger.Debug("Message dropped by rate limiter", "code", msgCodeToString(code))
+	return false, nil
+}
+
+func (h *IdenaGossipHandler) processVote(p *protoPeer, payload []byte) (*types.Vote, error) {
+	vote := new(types.Vote)
+	if err := vote.FromBytes(payload); err != nil {
+		return nil, errResp(DecodeErr, "%v", err)
+	}
+	if !vote.IsValid() {
+		return nil, errResp(ValidationErr, "invalid vote")
+	}
+	key := msgKey(payload)
+	if h.isProcessed(key) {
+		return nil, nil
+	}
+	p.markKey(key)
+	p.setPotentialHeight(vote.Header.Round - 1)
+	return vote, nil
+}
+
+// msgError is returned by the message handler for malformed or invalid messages
+type msgError struct {
+	code int
+	msg  string
+}
+
+func (e *msgError) Error() string {
+	return fmt.Sprintf("%v - %v", e.code, e.msg)
+}
+
 func errResp(code int, format string, v ...interface{}) error {
-	return fmt.Errorf("%v - %v", code, fmt.Sprintf(format, v...))
+	return &msgError{code: code, msg: fmt.Sprintf(format, v...)}
 }
 
 func (h *IdenaGossipHandler) broadcastTx(tx *types.Transaction, shardId common.ShardId, own bool) {
+	if !h.txpool.IsRelayable(tx) {
+		h.log.Debug("Underpriced tx is not relayed", "hash", tx.Hash().Hex())
+		return
+	}
 	hash := pushPullHash{
 		Type: pushTx,
 		Hash: tx.Hash128(),
 	}
 	h.pushPullManager.AddEntry(hash, tx, shardId, own)
 	data, _ := hash.ToBytes()
-	h.peers.SendWithFilter(Push, msgKey(data), hash, shardId, own)
+	var order peersOrder
+	if own {
+		order = h.ownTxPeersOrder()
+	}
+	h.peers.SendWithOrder(Push, msgKey(data), hash, shardId, own, 0, order)
 	if own {
 		h.log.Info("Sent own tx push", "hash", tx.Hash().Hex())
 	}
 }
 
+// RebroadcastTx sends the own transaction to peers again, peers which have already received it are skipped
+func (h *IdenaGossipHandler) RebroadcastTx(tx *types.Transaction) {
+	h.txChan <- &events.NewTxEvent{
+		Tx:      tx,
+		Own:     true,
+		ShardId: tx.LoadShardId(),
+	}
+}
+
+// ownTxPeersOrder returns the order of peers for propagation of own transactions, relayed transactions are sent in random order
+func (h *IdenaGossipHandler) ownTxPeersOrder() peersOrder {
+	var less func(a, b *protoPeer) bool
+	switch h.cfg.OwnTxPeersOrder {
+	case ownTxOrderRandom:
+		return nil
+	case ownTxOrderTrusted:
+		less = func(a, b *protoPeer) bool {
+			return h.isTrusted(a.id) && !h.isTrusted(b.id)
+		}
+	case ownTxOrderLatency:
+		less = func(a, b *protoPeer) bool {
+			return lowerLatency(a.Latency(), b.Latency())
+		}
+	default:
+		less = func(a, b *protoPeer) bool {
+			if aTrusted, bTrusted := h.isTrusted(a.id), h.isTrusted(b.id); aTrusted != bTrusted {
+				return aTrusted
+			}
+			return lowerLatency(a.Latency(), b.Latency())
+		}
+	}
+	return func(peers []*protoPeer) {
+		sort.SliceStable(peers, func(i, j int) bool {
+			return less(peers[i], peers[j])
+		})
+	}
+}
+
 func (h *IdenaGossipHandler) sendFlip(flip *types.Flip) {
 	hash := pushPullHash{
 		Type: pushFlip,
@@ -916,10 +1490,83 @@ func (h *IdenaGossipHandler) sendPull(peerId peer.ID, hash pushPullHash) {
 	}
 }
 
-func (h *IdenaGossipHandler) RequestBlockByHash(hash common.Hash) {
-	h.peers.Send(GetBlockByHash, &models.ProtoGetBlockByHashRequest{
-		Hash: hash[:],
-	}, common.MultiShard)
+// announceBlock sends hash and height of a freshly added block to peers, blocks added during synchronization are not announced
+func (h *IdenaGossipHandler) announceBlock(block *types.Block) {
+	if time.Since(time.Unix(block.Header.Time(), 0)) > maxAnnouncedBlockAge {
+		return
+	}
+	announcement := &newBlockHash{
+		Hash:   block.Hash(),
+		Height: block.Height(),
+	}
+	data, _ := announcement.ToBytes()
+	key := msgKey(data)
+	for _, p := range h.peers.Peers() {
+		if p.observer || !p.Supports(BlockAnnounce) {
+			continue
+		}
+		if !p.knowsKey(key) {
+			p.markKey(key)
+			p.sendMsg(NewBlockHash, announcement, common.MultiShard, false)
+		}
+	}
+}
+
+// AnnounceBlockAsync announces the block to peers which haven't got it yet without blocking the caller,
+// it is used on block import so adding a block doesn't wait for peer queues
+func (h *IdenaGossipHandler) AnnounceBlockAsync(block *types.Block) {
+	go h.announceBlock(block)
+}
+
+func (h *IdenaGossipHandler) handleBlockAnnouncement(p *protoPeer, announcement *newBlockHash) {
+	head := h.bcn.Head
+	if announcement.Height != head.Height()+1 || h.bcn.GetBlock(announcement.Hash) != nil {
+		return
+	}
+	// the block may be awaited by consensus, request it from the peer that has surely got it
+	if h.proposals.GetBlock(announcement.Hash) == nil && h.proposals.IsBlockApproved(announcement.Hash) {
+		p.pendingRequests.Add(0, GetBlockByHash, announcement.Hash, blockByHashRequestTimeout)
+		p.sendMsg(GetBlockByHash, &models.ProtoGetBlockByHashRequest{
+			Hash: announcement.Hash[:],
+		}, common.MultiShard, false)
+	}
+}
+
+// RequestTransactions asks the peer for transactions by hashes, unknown hashes are ignored by the peer
+func (h *IdenaGossipHandler) RequestTransactions(peerId peer.ID, hashes []common.Hash) error {
+	p := h.peers.Peer(peerId)
+	if p == nil {
+		return errors.New("protoPeer is not found")
+	}
+	if !p.Supports(TxPull) {
+		return errors.New("peer doesn't support transaction requests")
+	}
+	if len(hashes) > maxTxsPerRequest {
+		hashes = hashes[:maxTxsPerRequest]
+	}
+	request := &models.ProtoGetTransactionsRequest{
+		Id: p.pendingRequests.Add(0, GetTransactions, nil, txsRequestTimeout).seqNo,
+	}
+	for _, hash := range hashes {
+		request.Hashes = append(request.Hashes, hash.Bytes())
+	}
+	p.sendMsg(GetTransactions, request, common.MultiShard, false)
+	return nil
+}
+
+func (h *IdenaGossipHandler) provideTransactions(p *protoPeer, query *models.ProtoGetTransactionsRequest) {
+	response := &models.ProtoTransactions{
+		Id: query.Id,
+	}
+	for idx, hash := range query.Hashes {
+		if idx >= maxTxsPerRequest {
+			break
+		}
+		if tx := h.txpool.GetTx(common.BytesToHash(hash)); tx != nil {
+			response.Transactions = append(response.Transactions, tx.ToProto())
+		}
+	}
+	p.sendMsg(Transactions, response, common.MultiShard, false)
 }
 
 func (h *IdenaGossipHandler) highPrioritySync(p *protoPeer) {
@@ -968,6 +1615,117 @@ func (h *IdenaGossipHandler) syncTxPool(p *protoPeer) {
 	}
 }
 
+// requestPooledTransactions asks the peer for its mempool once after start if the peer has the highest known height,
+// the flag is reset if the peer doesn't answer so the mempool is requested from the next connected peer
+func (h *IdenaGossipHandler) requestPooledTransactions(p *protoPeer) {
+	if !p.Supports(PoolSync) || p.observer {
+		return
+	}
+	height := p.knownHeight.Read()
+	for _, other := range h.peers.Peers() {
+		if !other.observer && other.knownHeight.Read() > height {
+			return
+		}
+	}
+	if !atomic.CompareAndSwapUint32(&h.poolSynced, 0, 1) {
+		return
+	}
+	p.log.Debug("Requesting mempool")
+	req := p.pendingRequests.AddWithCallback(0, GetPooledTransactions, nil, pooledTxsRequestTimeout, func() {
+		atomic.StoreUint32(&h.poolSynced, 0)
+	})
+	p.sendMsg(GetPooledTransactions, &models.ProtoGetPooledTransactionsRequest{
+		Id: req.seqNo,
+	}, common.MultiShard, false)
+}
+
+func (h *IdenaGossipHandler) providePooledTransactions(p *protoPeer, id uint64) {
+	response := &models.ProtoPooledTransactions{
+		Id: id,
+	}
+	maxTxs := h.maxPoolSyncTxs()
+	for _, tx := range h.txpool.GetPendingTransaction(true, true, p.shardId, false) {
+		if len(response.Hashes) >= maxTxs {
+			break
+		}
+		hash := tx.Hash()
+		response.Hashes = append(response.Hashes, hash[:])
+	}
+	p.sendMsg(PooledTransactions, response, common.MultiShard, false)
+}
+
+// requestMissingTransactions pulls transactions which are absent in own mempool
+func (h *IdenaGossipHandler) requestMissingTransactions(p *protoPeer, hashes [][]byte) {
+	if maxTxs := h.maxPoolSyncTxs(); len(hashes) > maxTxs {
+		hashes = hashes[:maxTxs]
+	}
+	var missing []common.Hash
+	for _, data := range hashes {
+		hash := common.BytesToHash(data)
+		if h.txpool.GetTx(hash) == nil {
+			missing = append(missing, hash)
+		}
+	}
+	p.log.Debug("Mempool received", "txs", len(hashes), "missing", len(missing))
+	var chunks [][]common.Hash
+	for len(missing) > 0 {
+		count := maxTxsPerRequest
+		if len(missing) < count {
+			count = len(missing)
+		}
+		chunks = append(chunks, missing[:count])
+		missing = missing[count:]
+	}
+	burst := chunks
+	if len(burst) > poolSyncRequestsBurst {
+		burst = burst[:poolSyncRequestsBurst]
+	}
+	for _, chunk := range burst {
+		if err := h.RequestTransactions(p.id, chunk); err != nil {
+			return
+		}
+	}
+	paced := chunks[len(burst):]
+	if len(paced) == 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(poolSyncRequestInterval)
+		defer ticker.Stop()
+		for _, chunk := range paced {
+			select {
+			case <-ticker.C:
+			case <-p.term:
+				return
+			}
+			if err := h.RequestTransactions(p.id, chunk); err != nil {
+				return
+			}
+		}
+	}()
+}
+
+func (h *IdenaGossipHandler) msgCacheSize() int {
+	if h.cfg.MsgCacheSize > 0 {
+		return h.cfg.MsgCacheSize
+	}
+	return defaultMsgCacheSize
+}
+
+func (h *IdenaGossipHandler) msgCacheTTL() time.Duration {
+	if h.cfg.MsgCacheTTL > 0 {
+		return h.cfg.MsgCacheTTL
+	}
+	return msgCacheAliveTime
+}
+
+func (h *IdenaGossipHandler) maxPoolSyncTxs() int {
+	if h.cfg.MaxPoolSyncTxs > 0 {
+		return h.cfg.MaxPoolSyncTxs
+	}
+	return defaultMaxPoolSyncTxs
+}
+
 func (h *IdenaGossipHandler) sendManifest(p *protoPeer) {
 	manifest := h.bcn.ReadSnapshotManifest()
 	if manifest == nil {
@@ -1005,6 +1763,22 @@ func (h *IdenaGossipHandler) PotentialForwardPeers(round uint64) []peer.ID {
 	return result
 }
 
+// HeightUpdates returns a channel which receives peer heights when peers report taller chains,
+// updates are dropped while the previous one is not consumed
+func (h *IdenaGossipHandler) HeightUpdates() <-chan PeerHeight {
+	return h.heightUpdates
+}
+
+func (h *IdenaGossipHandler) onPeerHeightIncreased(id peer.ID, height uint64) {
+	if height <= h.bcn.Head.Height() {
+		return
+	}
+	select {
+	case h.heightUpdates <- PeerHeight{Id: id, Height: height}:
+	default:
+	}
+}
+
 func (h *IdenaGossipHandler) HasPeers() bool {
 	return h.peers.Len() > 0
 }
@@ -1012,6 +1786,11 @@ func (h *IdenaGossipHandler) PeersCount() int {
 	return h.peers.Len()
 }
 
+// SetPeerLimits applies changed limits of the number of peers
+func (h *IdenaGossipHandler) SetPeerLimits(cfg config.P2P) {
+	h.connManager.SetPeerLimits(cfg)
+}
+
 func (h *IdenaGossipHandler) OwnShardPeersCount() int {
 	return h.peers.FromShard(h.OwnPeeringShardId())
 }
@@ -1019,6 +1798,16 @@ func (h *IdenaGossipHandler) Peers() []*protoPeer {
 	return h.peers.Peers()
 }
 
+// PeerSnapshots returns the state of all connected peers
+func (h *IdenaGossipHandler) PeerSnapshots() []PeerSnapshot {
+	peers := h.peers.Peers()
+	result := make([]PeerSnapshot, 0, len(peers))
+	for _, p := range peers {
+		result = append(result, p.Snapshot())
+	}
+	return result
+}
+
 func (h *IdenaGossipHandler) PeerHeights() []uint64 {
 	result := make([]uint64, 0)
 	peers := h.peers.Peers()
@@ -1039,6 +1828,29 @@ func (h *IdenaGossipHandler) Endpoint() string {
 	return h.host.ID().Pretty()
 }
 
+// NodeInfo describes the local node for API consumers
+type NodeInfo struct {
+	ID              string         `json:"id"`
+	Endpoint        string         `json:"endpoint"`
+	Endpoints       []string       `json:"endpoints"`
+	AppVersion      string         `json:"appVersion"`
+	ProtocolVersion uint32         `json:"protocolVersion"`
+	ShardId         common.ShardId `json:"shardId"`
+	Peers           int            `json:"peers"`
+}
+
+func (h *IdenaGossipHandler) NodeInfo() NodeInfo {
+	return NodeInfo{
+		ID:              h.host.ID().Pretty(),
+		Endpoint:        h.Endpoint(),
+		Endpoints:       h.Endpoints(),
+		AppVersion:      h.appVersion,
+		ProtocolVersion: CurrentProtocolVersion,
+		ShardId:         h.OwnPeeringShardId(),
+		Peers:           h.PeersCount(),
+	}
+}
+
 func (h *IdenaGossipHandler) AddPeer(url string) error {
 	ma, err := multiaddr.NewMultiaddr(url)
 
@@ -0,0 +1,722 @@
+package protocol
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/idena-network/idena-go/blockchain"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/blockchain/validation"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/config"
+	"github.com/idena-network/idena-go/pengings"
+	models "github.com/idena-network/idena-go/protobuf"
+	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/require"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestIdenaGossipHandler_requestHead(t *testing.T) {
+	h := &IdenaGossipHandler{
+		peers:         newPeerSet(),
+		incomeBatches: &sync.Map{},
+	}
+	p, remote := newTestPeer("peer")
+	p.knownHeight.Store(10)
+	require.NoError(t, h.peers.Register(p))
+	go p.broadcast()
+
+	go h.requestHead(p, 5)
+
+	require.NoError(t, remote.stream.SetReadDeadline(time.Now().Add(time.Second)))
+	msg, err := remote.ReadMsg()
+	require.NoError(t, err)
+	require.Equal(t, uint64(GetBlocksRange), msg.Code)
+	request := new(models.ProtoGetBlocksRangeRequest)
+	require.NoError(t, proto.Unmarshal(msg.Payload, request))
+	require.Equal(t, uint64(10), request.From)
+	require.Equal(t, uint64(10), request.To)
+	require.Equal(t, 1, p.pendingRequests.Len())
+}
+
+func TestIdenaGossipHandler_requestHead_peerIsBehind(t *testing.T) {
+	h := &IdenaGossipHandler{
+		peers:         newPeerSet(),
+		incomeBatches: &sync.Map{},
+	}
+	p, remote := newTestPeer("peer")
+	p.knownHeight.Store(5)
+	require.NoError(t, h.peers.Register(p))
+	go p.broadcast()
+
+	h.requestHead(p, 5)
+
+	require.NoError(t, remote.stream.SetReadDeadline(time.Now().Add(time.Millisecond*200)))
+	_, err := remote.ReadMsg()
+	require.Error(t, err)
+	require.Equal(t, 0, p.pendingRequests.Len())
+}
+
+func TestIdenaGossipHandler_announceBlock(t *testing.T) {
+	h := &IdenaGossipHandler{
+		peers: newPeerSet(),
+	}
+	p, remote := newTestPeer("peer")
+	p.protocolVersion = CurrentProtocolVersion
+	SetSupportedFeatures(p)
+	legacy, legacyRemote := newTestPeer("legacy")
+	require.NoError(t, h.peers.Register(p))
+	require.NoError(t, h.peers.Register(legacy))
+	go p.broadcast()
+	go legacy.broadcast()
+
+	oldBlock := &types.Block{Header: &types.Header{ProposedHeader: &types.ProposedHeader{Height: 9, Time: time.Now().Add(-time.Hour).Unix()}}}
+	h.announceBlock(oldBlock)
+	block := &types.Block{Header: &types.Header{ProposedHeader: &types.ProposedHeader{Height: 10, Time: time.Now().Unix()}}}
+	h.announceBlock(block)
+	h.announceBlock(block)
+
+	require.NoError(t, remote.stream.SetReadDeadline(time.Now().Add(time.Second)))
+	msg, err := remote.ReadMsg()
+	require.NoError(t, err)
+	require.Equal(t, uint64(NewBlockHash), msg.Code)
+	announcement := new(newBlockHash)
+	require.NoError(t, announcement.FromBytes(msg.Payload))
+	require.Equal(t, block.Hash(), announcement.Hash)
+	require.Equal(t, uint64(10), announcement.Height)
+
+	require.NoError(t, remote.stream.SetReadDeadline(time.Now().Add(time.Millisecond*200)))
+	_, err = remote.ReadMsg()
+	require.Error(t, err)
+
+	require.NoError(t, legacyRemote.stream.SetReadDeadline(time.Now().Add(time.Millisecond*200)))
+	_, err = legacyRemote.ReadMsg()
+	require.Error(t, err)
+}
+
+func TestIdenaGossipHandler_AnnounceBlockAsync(t *testing.T) {
+	h := &IdenaGossipHandler{
+		peers: newPeerSet(),
+	}
+	aware, awareRemote := newTestPeer("aware")
+	unaware, unawareRemote := newTestPeer("unaware")
+	for _, p := range []*protoPeer{aware, unaware} {
+		p.protocolVersion = CurrentProtocolVersion
+		SetSupportedFeatures(p)
+		require.NoError(t, h.peers.Register(p))
+		go p.broadcast()
+	}
+	block := &types.Block{Header: &types.Header{ProposedHeader: &types.ProposedHeader{Height: 10, Time: time.Now().Unix()}}}
+	data, _ := (&newBlockHash{Hash: block.Hash(), Height: block.Height()}).ToBytes()
+	aware.markKey(msgKey(data))
+
+	h.AnnounceBlockAsync(block)
+
+	require.NoError(t, unawareRemote.stream.SetReadDeadline(time.Now().Add(time.Second)))
+	msg, err := unawareRemote.ReadMsg()
+	require.NoError(t, err)
+	require.Equal(t, uint64(NewBlockHash), msg.Code)
+
+	require.NoError(t, awareRemote.stream.SetReadDeadline(time.Now().Add(time.Millisecond*200)))
+	_, err = awareRemote.ReadMsg()
+	require.Error(t, err)
+}
+
+func TestIdenaGossipHandler_handleBlockAnnouncement(t *testing.T) {
+	chain, appState, _, _ := blockchain.NewTestBlockchain(false, nil)
+	proposals, _ := pengings.NewProposals(chain.Blockchain, appState, nil, nil, nil)
+	h := &IdenaGossipHandler{
+		peers:     newPeerSet(),
+		bcn:       chain.Blockchain,
+		proposals: proposals,
+	}
+	announcement := &newBlockHash{Hash: common.Hash{0x1}, Height: chain.Head.Height() + 1}
+	proposals.ApproveBlock(announcement.Hash)
+
+	announce := func(id peer.ID) *protoPeer {
+		p, remote := newTestPeer(id)
+		require.NoError(t, h.peers.Register(p))
+		go p.broadcast()
+		go remote.broadcast()
+		remote.sendMsg(NewBlockHash, announcement, common.MultiShard, false)
+		require.NoError(t, h.handle(p))
+		require.NoError(t, remote.stream.SetReadDeadline(time.Now().Add(time.Millisecond*200)))
+		return remote
+	}
+
+	// the body is pulled from the first peer announcing the block
+	remote := announce("unaware")
+	msg, err := remote.ReadMsg()
+	require.NoError(t, err)
+	require.Equal(t, uint64(GetBlockByHash), msg.Code)
+	request := new(models.ProtoGetBlockByHashRequest)
+	require.NoError(t, proto.Unmarshal(msg.Payload, request))
+	require.Equal(t, announcement.Hash.Bytes(), request.Hash)
+
+	// the hash is already known, so the same announcement from another peer doesn't trigger a pull
+	remote = announce("aware")
+	_, err = remote.ReadMsg()
+	require.Error(t, err)
+	require.Zero(t, h.peers.Peer("aware").pendingRequests.Len())
+}
+
+type testHost struct {
+	host.Host
+	id peer.ID
+}
+
+func (h *testHost) ID() peer.ID {
+	return h.id
+}
+
+func TestIdenaGossipHandler_checkRemoteId(t *testing.T) {
+	h := &IdenaGossipHandler{
+		host:  &testHost{id: "self"},
+		peers: newPeerSet(),
+	}
+	connected, _ := newTestPeer("peer")
+	require.NoError(t, h.peers.Register(connected))
+
+	fresh, _ := newTestPeer("fresh")
+	require.NoError(t, h.checkRemoteId(fresh))
+
+	for id, reason := range map[peer.ID]DiscReason{"self": DiscSelfConnection, "peer": DiscAlreadyConnected} {
+		p, remote := newTestPeer(id)
+		errc := make(chan error, 1)
+		go func() {
+			errc <- h.checkRemoteId(p)
+		}()
+		msg, err := remote.ReadMsg()
+		require.NoError(t, err)
+		require.Equal(t, uint64(Disconnect), msg.Code)
+		require.Error(t, <-errc)
+		actual, _ := p.LastDisconnectReason()
+		require.Equal(t, reason, actual)
+	}
+	// the existing connection is kept
+	require.Equal(t, connected, h.peers.Peer("peer"))
+	select {
+	case <-connected.term:
+		t.Fatal("connected peer is disconnected")
+	default:
+	}
+}
+
+func TestProtoPeer_voteBatches(t *testing.T) {
+	h := &IdenaGossipHandler{
+		peers: newPeerSet(),
+	}
+	p, remote := newTestPeer("peer")
+	p.protocolVersion = CurrentProtocolVersion
+	SetSupportedFeatures(p)
+	require.NoError(t, h.peers.Register(remote))
+
+	var votes []*types.Vote
+	for i := uint64(1); i <= 3; i++ {
+		vote := &types.Vote{Header: &types.VoteHeader{Round: i}}
+		votes = append(votes, vote)
+		p.sendMsg(Vote, vote, common.MultiShard, true)
+	}
+	// already known vote is not sent again
+	p.sendMsg(Vote, votes[0], common.MultiShard, true)
+	go p.broadcast()
+
+	require.NoError(t, remote.stream.SetReadDeadline(time.Now().Add(time.Second)))
+	msg, err := remote.ReadMsg()
+	require.NoError(t, err)
+	require.Equal(t, uint64(BatchVote), msg.Code)
+	batch := new(msgBatch)
+	require.NoError(t, batch.FromBytes(msg.Payload))
+	require.Len(t, batch.Data, 3)
+
+	for i, item := range batch.Data {
+		vote, err := h.processVote(remote, item.Payload)
+		require.NoError(t, err)
+		require.Equal(t, votes[i].Hash(), vote.Hash())
+		require.Equal(t, uint64(i), remote.potentialHeight.Read())
+	}
+	vote, err := h.processVote(remote, batch.Data[0].Payload)
+	require.NoError(t, err)
+	require.Nil(t, vote)
+
+	require.NoError(t, remote.stream.SetReadDeadline(time.Now().Add(time.Millisecond*200)))
+	_, err = remote.ReadMsg()
+	require.Error(t, err)
+}
+
+func TestProtoPeer_voteBatches_flush(t *testing.T) {
+	p, remote := newTestPeer("peer")
+	p.protocolVersion = CurrentProtocolVersion
+	SetSupportedFeatures(p)
+	go p.broadcast()
+	require.NoError(t, remote.stream.SetReadDeadline(time.Now().Add(time.Second)))
+
+	// a lone vote doesn't wait for the flush interval
+	start := time.Now()
+	p.sendMsg(Vote, &types.Vote{Header: &types.VoteHeader{Round: 1}}, common.MultiShard, true)
+	msg, err := remote.ReadMsg()
+	require.NoError(t, err)
+	require.Less(t, time.Since(start), voteBatchFlushInterval)
+	batch := new(msgBatch)
+	require.NoError(t, batch.FromBytes(msg.Payload))
+	require.Len(t, batch.Data, 1)
+
+	// a full batch is sent at once and the rest goes to the next one
+	p, remote = newTestPeer("peer")
+	p.protocolVersion = CurrentProtocolVersion
+	SetSupportedFeatures(p)
+	for i := uint64(0); i <= voteBatchSize; i++ {
+		p.sendMsg(Vote, &types.Vote{Header: &types.VoteHeader{Round: i + 2}}, common.MultiShard, true)
+	}
+	go p.broadcast()
+	require.NoError(t, remote.stream.SetReadDeadline(time.Now().Add(time.Second)))
+	for _, size := range []int{voteBatchSize, 1} {
+		msg, err = remote.ReadMsg()
+		require.NoError(t, err)
+		batch = new(msgBatch)
+		require.NoError(t, batch.FromBytes(msg.Payload))
+		require.Len(t, batch.Data, size)
+	}
+}
+
+func TestIdenaGossipHandler_handle_batchVote(t *testing.T) {
+	h := &IdenaGossipHandler{
+		peers:       newPeerSet(),
+		connManager: NewConnManager(nil, config.P2P{}),
+	}
+	p, remote := newTestPeer("peer")
+	// votes of a batch are charged one by one
+	p.rateLimiter = newRateLimiter(map[uint64]rateLimit{
+		Vote: {rate: 0.001, burst: 0},
+	})
+	newBatch := func(size int) *msgBatch {
+		batch := new(msgBatch)
+		for i := 0; i < size; i++ {
+			data, _ := (&types.Vote{Header: &types.VoteHeader{Round: uint64(i)}}).ToBytes()
+			batch.Data = append(batch.Data, &batchItem{Payload: data})
+		}
+		return batch
+	}
+
+	go remote.rw.WriteMsg(makeMsg(BatchVote, newBatch(3), 0))
+	require.NoError(t, h.handle(p))
+	require.Equal(t, map[uint64]uint64{Vote: 1}, p.rateLimiter.Stats())
+
+	go remote.rw.WriteMsg(makeMsg(BatchVote, newBatch(voteBatchSize+1), 0))
+	require.Error(t, h.handle(p))
+}
+
+type testTxPool struct {
+	txs      map[common.Hash]*types.Transaction
+	external []*types.Transaction
+}
+
+func (pool *testTxPool) AddInternalTx(tx *types.Transaction) error {
+	pool.txs[tx.Hash()] = tx
+	return nil
+}
+
+func (pool *testTxPool) AddExternalTxs(txType validation.TxType, txs ...*types.Transaction) error {
+	pool.external = append(pool.external, txs...)
+	return nil
+}
+
+func (pool *testTxPool) GetPendingTransaction(noFilter bool, addHighPriority bool, shardId common.ShardId, count bool) []*types.Transaction {
+	var result []*types.Transaction
+	for _, tx := range pool.txs {
+		result = append(result, tx)
+	}
+	return result
+}
+
+func (pool *testTxPool) GetPriorityTransaction() []*types.Transaction {
+	return nil
+}
+
+func (pool *testTxPool) GetTx(hash common.Hash) *types.Transaction {
+	return pool.txs[hash]
+}
+
+func (pool *testTxPool) IsSyncing() bool {
+	return false
+}
+
+func (pool *testTxPool) IsRelayable(tx *types.Transaction) bool {
+	return true
+}
+
+func TestIdenaGossipHandler_RequestTransactions(t *testing.T) {
+	known := &types.Transaction{AccountNonce: 1, Amount: big.NewInt(1)}
+	unknown := &types.Transaction{AccountNonce: 2, Amount: big.NewInt(1)}
+	pool := &testTxPool{txs: map[common.Hash]*types.Transaction{known.Hash(): known}}
+
+	h := &IdenaGossipHandler{
+		peers:  newPeerSet(),
+		txpool: pool,
+	}
+	p, remote := newTestPeer("peer")
+	p.protocolVersion = CurrentProtocolVersion
+	SetSupportedFeatures(p)
+	require.NoError(t, h.peers.Register(p))
+	go p.broadcast()
+	go remote.broadcast()
+
+	require.NoError(t, h.RequestTransactions(p.id, []common.Hash{known.Hash(), unknown.Hash()}))
+	require.Equal(t, 1, p.pendingRequests.Len())
+
+	require.NoError(t, remote.stream.SetReadDeadline(time.Now().Add(time.Second)))
+	msg, err := remote.ReadMsg()
+	require.NoError(t, err)
+	require.Equal(t, uint64(GetTransactions), msg.Code)
+	query := new(models.ProtoGetTransactionsRequest)
+	require.NoError(t, proto.Unmarshal(msg.Payload, query))
+	require.Len(t, query.Hashes, 2)
+
+	h.provideTransactions(remote, query)
+
+	require.NoError(t, p.stream.SetReadDeadline(time.Now().Add(time.Second)))
+	msg, err = p.ReadMsg()
+	require.NoError(t, err)
+	require.Equal(t, uint64(Transactions), msg.Code)
+	response := new(models.ProtoTransactions)
+	require.NoError(t, proto.Unmarshal(msg.Payload, response))
+	require.Equal(t, query.Id, response.Id)
+	require.Len(t, response.Transactions, 1)
+	require.Equal(t, known.Hash(), new(types.Transaction).FromProto(response.Transactions[0]).Hash())
+}
+
+func TestIdenaGossipHandler_handle_transactions(t *testing.T) {
+	pool := &testTxPool{txs: map[common.Hash]*types.Transaction{}}
+	h := &IdenaGossipHandler{
+		peers:  newPeerSet(),
+		txpool: pool,
+	}
+	p, remote := newTestPeer("peer")
+	p.protocolVersion = CurrentProtocolVersion
+	SetSupportedFeatures(p)
+	require.NoError(t, h.peers.Register(p))
+
+	send := func(id uint64, count int) {
+		response := &models.ProtoTransactions{Id: id}
+		for i := 0; i < count; i++ {
+			tx := &types.Transaction{AccountNonce: uint32(id)*1000 + uint32(i), Amount: big.NewInt(1)}
+			response.Transactions = append(response.Transactions, tx.ToProto())
+		}
+		go remote.rw.WriteMsg(makeMsg(Transactions, response, 0))
+	}
+
+	// unsolicited response is dropped
+	send(1, 1)
+	require.NoError(t, h.handle(p))
+	require.Empty(t, pool.external)
+
+	// response to a pending request is accepted
+	id := p.pendingRequests.Add(0, GetTransactions, nil, time.Minute).seqNo
+	send(id, 2)
+	require.NoError(t, h.handle(p))
+	require.Len(t, pool.external, 2)
+	require.Zero(t, p.pendingRequests.Len())
+
+	// a response can't carry more transactions than may be requested
+	id = p.pendingRequests.Add(0, GetTransactions, nil, time.Minute).seqNo
+	send(id, maxTxsPerRequest+1)
+	require.Error(t, h.handle(p))
+	require.Len(t, pool.external, 2)
+}
+
+func TestIdenaGossipHandler_RequestTransactions_notSupported(t *testing.T) {
+	h := &IdenaGossipHandler{
+		peers: newPeerSet(),
+	}
+	p, _ := newTestPeer("peer")
+	require.NoError(t, h.peers.Register(p))
+
+	require.Error(t, h.RequestTransactions(p.id, []common.Hash{{0x1}}))
+	require.Equal(t, 0, p.pendingRequests.Len())
+}
+
+func TestIdenaGossipHandler_poolSync(t *testing.T) {
+	known := &types.Transaction{AccountNonce: 1, Amount: big.NewInt(1)}
+	missing1 := &types.Transaction{AccountNonce: 2, Amount: big.NewInt(1)}
+	missing2 := &types.Transaction{AccountNonce: 3, Amount: big.NewInt(1)}
+	h := &IdenaGossipHandler{
+		peers:  newPeerSet(),
+		txpool: &testTxPool{txs: map[common.Hash]*types.Transaction{known.Hash(): known}},
+	}
+	remoteHandler := &IdenaGossipHandler{
+		peers: newPeerSet(),
+		txpool: &testTxPool{txs: map[common.Hash]*types.Transaction{
+			known.Hash(): known, missing1.Hash(): missing1, missing2.Hash(): missing2,
+		}},
+	}
+	p, remote := newTestPeer("peer")
+	for _, peer := range []*protoPeer{p, remote} {
+		peer.protocolVersion = CurrentProtocolVersion
+		SetSupportedFeatures(peer)
+		go peer.broadcast()
+	}
+	p.knownHeight.Store(10)
+	require.NoError(t, h.peers.Register(p))
+	require.NoError(t, remoteHandler.peers.Register(remote))
+
+	behind, _ := newTestPeer("behind")
+	behind.protocolVersion = CurrentProtocolVersion
+	SetSupportedFeatures(behind)
+	behind.knownHeight.Store(5)
+	require.NoError(t, h.peers.Register(behind))
+	h.requestPooledTransactions(behind)
+	require.Zero(t, behind.pendingRequests.Len())
+
+	h.requestPooledTransactions(p)
+	require.Equal(t, 1, p.pendingRequests.Len())
+	// the mempool is requested only once
+	h.requestPooledTransactions(p)
+	require.Equal(t, 1, p.pendingRequests.Len())
+
+	require.NoError(t, remoteHandler.handle(remote))
+	require.NoError(t, h.handle(p))
+
+	require.NoError(t, remote.stream.SetReadDeadline(time.Now().Add(time.Second)))
+	msg, err := remote.ReadMsg()
+	require.NoError(t, err)
+	require.Equal(t, uint64(GetTransactions), msg.Code)
+	query := new(models.ProtoGetTransactionsRequest)
+	require.NoError(t, proto.Unmarshal(msg.Payload, query))
+	require.ElementsMatch(t, [][]byte{missing1.Hash().Bytes(), missing2.Hash().Bytes()}, query.Hashes)
+	require.Equal(t, 1, p.pendingRequests.Len())
+}
+
+func TestIdenaGossipHandler_requestMissingTransactions(t *testing.T) {
+	h := &IdenaGossipHandler{
+		peers:  newPeerSet(),
+		txpool: &testTxPool{txs: map[common.Hash]*types.Transaction{}},
+		cfg:    config.P2P{MaxPoolSyncTxs: (poolSyncRequestsBurst + 2) * maxTxsPerRequest},
+	}
+	p, _ := newTestPeer("peer")
+	p.protocolVersion = CurrentProtocolVersion
+	SetSupportedFeatures(p)
+	require.NoError(t, h.peers.Register(p))
+
+	var hashes [][]byte
+	for i := 0; i < h.cfg.MaxPoolSyncTxs+1; i++ {
+		hash := common.Hash{byte(i), byte(i >> 8)}
+		hashes = append(hashes, hash.Bytes())
+	}
+	h.requestMissingTransactions(p, hashes)
+	require.Equal(t, poolSyncRequestsBurst, p.pendingRequests.Len())
+	require.Eventually(t, func() bool {
+		return p.pendingRequests.Len() == poolSyncRequestsBurst+2
+	}, poolSyncRequestInterval*4, poolSyncRequestInterval/10)
+}
+
+func TestIdenaGossipHandler_requestPooledTransactions_timeout(t *testing.T) {
+	h := &IdenaGossipHandler{
+		peers: newPeerSet(),
+	}
+	p, _ := newTestPeer("peer")
+	p.protocolVersion = CurrentProtocolVersion
+	SetSupportedFeatures(p)
+	require.NoError(t, h.peers.Register(p))
+
+	h.requestPooledTransactions(p)
+	require.Equal(t, uint32(1), h.poolSynced)
+	h.expirePendingRequests(p, time.Now().Add(pooledTxsRequestTimeout*2))
+	require.Zero(t, h.poolSynced)
+}
+
+func TestIdenaGossipHandler_BestSyncPeer(t *testing.T) {
+	chain := &blockchain.Blockchain{}
+	chain.Head = &types.Header{ProposedHeader: &types.ProposedHeader{Height: 100}}
+	h := &IdenaGossipHandler{
+		peers: newPeerSet(),
+		bcn:   chain,
+	}
+	require.Empty(t, h.BestSyncPeer())
+
+	addPeer := func(id peer.ID, height uint64, latency time.Duration) {
+		p, _ := newTestPeer(id)
+		p.knownHeight.Store(height)
+		p.latency = int64(latency)
+		require.NoError(t, h.peers.Register(p))
+	}
+	addPeer("behind", 90, time.Millisecond)
+	addPeer("same", 100, time.Millisecond)
+	require.Empty(t, h.BestSyncPeer())
+
+	addPeer("ahead", 110, time.Millisecond)
+	require.Equal(t, peer.ID("ahead"), h.BestSyncPeer())
+
+	addPeer("top-slow", 120, time.Millisecond*300)
+	addPeer("top-unmeasured", 120, 0)
+	addPeer("top-fast", 120, time.Millisecond*20)
+	require.Equal(t, peer.ID("top-fast"), h.BestSyncPeer())
+}
+
+func TestIdenaGossipHandler_ownTxPeersOrder(t *testing.T) {
+	h := &IdenaGossipHandler{
+		peers:        newPeerSet(),
+		trustedPeers: map[peer.ID]struct{}{"trusted-slow": {}},
+	}
+	latencies := map[peer.ID]time.Duration{
+		"trusted-slow": time.Millisecond * 500,
+		"fast":         time.Millisecond * 10,
+		"slow":         time.Millisecond * 300,
+		"unmeasured":   0,
+	}
+	for id, latency := range latencies {
+		p, _ := newTestPeer(id)
+		p.latency = int64(latency)
+		require.NoError(t, h.peers.Register(p))
+	}
+	ids := func(peers []*protoPeer) []peer.ID {
+		var result []peer.ID
+		for _, p := range peers {
+			result = append(result, p.id)
+		}
+		return result
+	}
+
+	var sentTo []*protoPeer
+	h.peers.SendWithOrder(Push, "key", pushPullHash{Type: pushTx}, common.MultiShard, true, msgCacheAliveTime, func(peers []*protoPeer) {
+		h.ownTxPeersOrder()(peers)
+		sentTo = append(sentTo, peers...)
+	})
+	require.Equal(t, []peer.ID{"trusted-slow", "fast", "slow", "unmeasured"}, ids(sentTo))
+	for _, p := range sentTo {
+		require.Len(t, p.highPriorityRequests, 1)
+	}
+
+	peers := h.peers.Peers()
+	h.cfg.OwnTxPeersOrder = ownTxOrderLatency
+	h.ownTxPeersOrder()(peers)
+	require.Equal(t, []peer.ID{"fast", "slow", "trusted-slow", "unmeasured"}, ids(peers))
+
+	h.cfg.OwnTxPeersOrder = ownTxOrderTrusted
+	h.ownTxPeersOrder()(peers)
+	require.Equal(t, peer.ID("trusted-slow"), peers[0].id)
+
+	h.cfg.OwnTxPeersOrder = ownTxOrderRandom
+	require.Nil(t, h.ownTxPeersOrder())
+}
+
+func TestIdenaGossipHandler_GetBlocksRange_oversized(t *testing.T) {
+	h := &IdenaGossipHandler{
+		cfg:           config.P2P{MaxBlocksPerRange: 10, MinPeerScore: -100},
+		peers:         newPeerSet(),
+		incomeBatches: &sync.Map{},
+	}
+	p, remote := newTestPeer("peer")
+	require.NoError(t, h.peers.Register(p))
+	go p.broadcast()
+	go remote.broadcast()
+
+	remote.sendMsg(GetBlocksRange, &models.ProtoGetBlocksRangeRequest{BatchId: 7, From: 1, To: 100}, common.MultiShard, false)
+	require.NoError(t, h.handle(p))
+	require.Equal(t, int32(-invalidMsgScorePenalty), p.Score())
+
+	require.NoError(t, remote.stream.SetReadDeadline(time.Now().Add(time.Second)))
+	msg, err := remote.ReadMsg()
+	require.NoError(t, err)
+	require.Equal(t, uint64(BlocksRange), msg.Code)
+	response := new(blockRange)
+	require.NoError(t, response.FromBytes(msg.Payload))
+	require.Equal(t, uint32(7), response.BatchId)
+	require.Empty(t, response.Blocks)
+}
+
+func TestIdenaGossipHandler_GetBlocksRange_split(t *testing.T) {
+	h := &IdenaGossipHandler{
+		cfg:           config.P2P{MaxBlocksPerRange: 10},
+		peers:         newPeerSet(),
+		incomeBatches: &sync.Map{},
+	}
+	p, remote := newTestPeer("peer")
+	require.NoError(t, h.peers.Register(p))
+	go p.broadcast()
+	go remote.broadcast()
+
+	b, err := h.GetBlocksRange(p.id, 1, 25)
+	require.NoError(t, err)
+	require.Equal(t, 3, p.pendingRequests.Len())
+
+	require.NoError(t, remote.stream.SetReadDeadline(time.Now().Add(time.Second)))
+	var requests []*models.ProtoGetBlocksRangeRequest
+	for i := 0; i < 3; i++ {
+		msg, err := remote.ReadMsg()
+		require.NoError(t, err)
+		require.Equal(t, uint64(GetBlocksRange), msg.Code)
+		request := new(models.ProtoGetBlocksRangeRequest)
+		require.NoError(t, proto.Unmarshal(msg.Payload, request))
+		requests = append(requests, request)
+	}
+	require.Equal(t, [][2]uint64{{1, 10}, {11, 20}, {21, 25}}, [][2]uint64{
+		{requests[0].From, requests[0].To}, {requests[1].From, requests[1].To}, {requests[2].From, requests[2].To},
+	})
+
+	for i, request := range requests {
+		remote.sendMsg(BlocksRange, &blockRange{BatchId: request.BatchId}, common.MultiShard, false)
+		require.NoError(t, h.handle(p))
+		select {
+		case _, ok := <-b.headers:
+			require.False(t, ok)
+			require.Equal(t, len(requests)-1, i)
+		default:
+			require.Less(t, i, len(requests)-1)
+		}
+	}
+	require.Zero(t, p.pendingRequests.Len())
+}
+
+func Test_splitRange(t *testing.T) {
+	require.Equal(t, [][2]uint64{{5, 5}}, splitRange(5, 5, 10))
+	require.Equal(t, [][2]uint64{{1, 10}, {11, 20}}, splitRange(1, 20, 10))
+	require.Equal(t, [][2]uint64{{1, 1001}}, splitRange(1, 1001, defaultMaxBlocksPerRange))
+}
+
+func TestIdenaGossipHandler_Stop(t *testing.T) {
+	h := &IdenaGossipHandler{
+		peers:        newPeerSet(),
+		pendingPeers: make(map[peer.ID]struct{}),
+		cfg:          config.P2P{ShutdownDrainTimeout: time.Second},
+	}
+	p, remote := newTestPeer("peer")
+	require.NoError(t, h.peers.Register(p))
+	for i := 0; i < 10; i++ {
+		p.sendMsg(NewTx, &types.Transaction{AccountNonce: uint32(i), Amount: big.NewInt(1)}, 0, false)
+	}
+	p.sendMsg(Vote, &types.Vote{Header: &types.VoteHeader{Round: 1}}, 0, false)
+	go p.broadcast()
+
+	stopped := make(chan struct{})
+	go func() {
+		h.Stop()
+		close(stopped)
+	}()
+
+	require.NoError(t, remote.stream.SetReadDeadline(time.Now().Add(time.Second)))
+	codes := make(map[uint64]int)
+	for i := 0; i < 11; i++ {
+		msg, err := remote.ReadMsg()
+		require.NoError(t, err)
+		codes[msg.Code]++
+	}
+	require.Equal(t, map[uint64]int{Vote: 1, NewTx: 10}, codes)
+
+	select {
+	case <-stopped:
+	case <-time.After(time.Second * 3):
+		t.Fatal("handler is not stopped")
+	}
+	select {
+	case <-p.finished:
+	default:
+		t.Fatal("broadcast loop is not finished")
+	}
+
+	stream, _ := newTestStreams("local", "newPeer")
+	_, err := h.runPeer(stream, true)
+	require.Error(t, err)
+	require.Empty(t, h.pendingPeers)
+}
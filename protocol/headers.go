@@ -0,0 +1,150 @@
+package protocol
+
+import (
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	models "github.com/idena-network/idena-go/protobuf"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/pkg/errors"
+	"time"
+)
+
+const (
+	// maxBlockHeadersPerRequest limits the number of headers sent in a single response
+	maxBlockHeadersPerRequest = 200
+	headersRequestTimeout     = time.Second * 20
+)
+
+// headersRequest waits for the response to GetBlockHeaders sent to the peer
+type headersRequest struct {
+	from   uint64
+	count  uint32
+	skip   uint32
+	result chan []*types.Header
+}
+
+type headersRequestKey struct {
+	peer  peer.ID
+	seqNo uint64
+}
+
+// headerHeight returns the height of the i-th header of the request, ok is false if the height overflows
+func headerHeight(from uint64, skip uint32, i uint64) (uint64, bool) {
+	height := from + i*(uint64(skip)+1)
+	return height, height >= from
+}
+
+// provideBlockHeaders sends canonical headers starting from the requested height with skip headers left out between
+// neighbouring ones, the response is cut at the head or at maxBlockHeadersPerRequest headers. Light clients request
+// bodies of the blocks they need by hash, full nodes load them by ranges
+func (h *IdenaGossipHandler) provideBlockHeaders(p *protoPeer, query *models.ProtoGetBlockHeadersRequest) {
+	count := uint64(query.Count)
+	if count > maxBlockHeadersPerRequest {
+		count = maxBlockHeadersPerRequest
+	}
+	headers := make([]*models.ProtoBlockHeader, 0, count)
+	for i := uint64(0); i < count; i++ {
+		height, ok := headerHeight(query.From, query.Skip, i)
+		if !ok {
+			break
+		}
+		header := h.bcn.GetBlockHeaderByHeight(height)
+		if header == nil {
+			break
+		}
+		headers = append(headers, header.ToProto())
+	}
+	p.sendMsg(BlockHeaders, &models.ProtoBlockHeaders{
+		Id:      query.Id,
+		Headers: headers,
+	}, common.MultiShard, false)
+}
+
+// GetBlockHeaders requests count canonical headers of the peer starting from the given height, skip headers are left
+// out between neighbouring ones. Only headers are transferred, so the call is cheap enough to compare chains before
+// blocks are requested. The result is shorter than count if the chain of the peer ends earlier
+func (h *IdenaGossipHandler) GetBlockHeaders(peerId peer.ID, from uint64, count uint32, skip uint32) ([]*types.Header, error) {
+	p := h.peers.Peer(peerId)
+	if p == nil {
+		return nil, errors.New("peer is not found")
+	}
+	if !p.Supports(HeaderRequests) {
+		return nil, errors.New("peer doesn't serve headers")
+	}
+	if count == 0 || count > maxBlockHeadersPerRequest {
+		return nil, errors.Errorf("invalid number of headers: %v", count)
+	}
+	req := p.pendingRequests.Add(0, GetBlockHeaders, nil, headersRequestTimeout)
+	key := headersRequestKey{peerId, req.seqNo}
+	pending := &headersRequest{
+		from:   from,
+		count:  count,
+		skip:   skip,
+		result: make(chan []*types.Header, 1),
+	}
+	h.headerRequestsMutex.Lock()
+	if h.headerRequests == nil {
+		h.headerRequests = make(map[headersRequestKey]*headersRequest)
+	}
+	h.headerRequests[key] = pending
+	h.headerRequestsMutex.Unlock()
+	defer func() {
+		h.headerRequestsMutex.Lock()
+		delete(h.headerRequests, key)
+		h.headerRequestsMutex.Unlock()
+	}()
+
+	p.sendMsg(GetBlockHeaders, &models.ProtoGetBlockHeadersRequest{
+		Id:    req.seqNo,
+		From:  from,
+		Count: count,
+		Skip:  skip,
+	}, common.MultiShard, false)
+
+	timeout := time.NewTimer(headersRequestTimeout)
+	defer timeout.Stop()
+	select {
+	case headers := <-pending.result:
+		return headers, nil
+	case <-timeout.C:
+		return nil, errors.New("headers request timeout")
+	case <-p.finished:
+		return nil, errors.New("peer is disconnected")
+	}
+}
+
+// onBlockHeaders passes the response to the waiting request, headers must follow heights of the request
+func (h *IdenaGossipHandler) onBlockHeaders(p *protoPeer, response *models.ProtoBlockHeaders) error {
+	if !p.pendingRequests.Resolve(GetBlockHeaders, response.Id) {
+		// the penalty is small since the response may come after the request is expired
+		h.penalize(p, unsolicitedScorePenalty, "unsolicited block headers")
+		return nil
+	}
+	h.onResponse(p)
+	h.headerRequestsMutex.Lock()
+	pending, ok := h.headerRequests[headersRequestKey{p.id, response.Id}]
+	delete(h.headerRequests, headersRequestKey{p.id, response.Id})
+	h.headerRequestsMutex.Unlock()
+	if !ok {
+		return nil
+	}
+	if len(response.Headers) > int(pending.count) {
+		return errResp(ValidationErr, "too many headers: %v", len(response.Headers))
+	}
+	headers := make([]*types.Header, 0, len(response.Headers))
+	for i, protoHeader := range response.Headers {
+		if protoHeader.EmptyHeader == nil && protoHeader.ProposedHeader == nil {
+			return errResp(ValidationErr, "empty header")
+		}
+		header := new(types.Header).FromProto(protoHeader)
+		if expected, _ := headerHeight(pending.from, pending.skip, uint64(i)); header.Height() != expected {
+			return errResp(ValidationErr, "unexpected header height: %v, expected %v", header.Height(), expected)
+		}
+		headers = append(headers, header)
+	}
+	if len(headers) > 0 {
+		p.setHeight(headers[len(headers)-1].Height())
+	}
+	pending.result <- headers
+	return nil
+}
@@ -0,0 +1,92 @@
+package protocol
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/idena-network/idena-go/blockchain"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/config"
+	models "github.com/idena-network/idena-go/protobuf"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestIdenaGossipHandler_GetBlockHeaders(t *testing.T) {
+	chain, _ := blockchain.NewTestBlockchainWithBlocks(30, 0)
+	h := &IdenaGossipHandler{
+		peers:       newPeerSet(),
+		bcn:         chain.Blockchain,
+		connManager: NewConnManager(nil, config.P2P{}),
+	}
+	p, remote := newTestPeer("peer")
+	p.protocolVersion = CurrentProtocolVersion
+	SetSupportedFeatures(p)
+	require.NoError(t, h.peers.Register(p))
+	go p.broadcast()
+	go remote.broadcast()
+
+	type result struct {
+		headers []*types.Header
+		err     error
+	}
+	results := make(chan result, 1)
+	go func() {
+		headers, err := h.GetBlockHeaders(p.id, 10, 5, 4)
+		results <- result{headers, err}
+	}()
+
+	require.NoError(t, remote.stream.SetReadDeadline(time.Now().Add(time.Second)))
+	msg, err := remote.ReadMsg()
+	require.NoError(t, err)
+	require.Equal(t, uint64(GetBlockHeaders), msg.Code)
+	query := new(models.ProtoGetBlockHeadersRequest)
+	require.NoError(t, proto.Unmarshal(msg.Payload, query))
+	require.Equal(t, uint64(10), query.From)
+	require.Equal(t, uint32(5), query.Count)
+	require.Equal(t, uint32(4), query.Skip)
+
+	// every fifth header starting from 10 is sent
+	h.provideBlockHeaders(remote, query)
+	require.NoError(t, p.stream.SetReadDeadline(time.Now().Add(time.Second)))
+	require.NoError(t, h.handle(p))
+
+	r := <-results
+	require.NoError(t, r.err)
+	require.Len(t, r.headers, 5)
+	for i, header := range r.headers {
+		require.Equal(t, uint64(10+i*5), header.Height())
+		require.Equal(t, chain.GetBlockHeaderByHeight(header.Height()).Hash(), header.Hash())
+	}
+	require.Equal(t, uint64(30), p.knownHeight.Read())
+	require.Zero(t, p.pendingRequests.Len())
+
+	// the response must follow heights of the request
+	go h.GetBlockHeaders(p.id, 10, 2, 0)
+	msg, err = remote.ReadMsg()
+	require.NoError(t, err)
+	require.NoError(t, proto.Unmarshal(msg.Payload, query))
+	response := &models.ProtoBlockHeaders{
+		Id:      query.Id,
+		Headers: []*models.ProtoBlockHeader{chain.GetBlockHeaderByHeight(10).ToProto(), chain.GetBlockHeaderByHeight(12).ToProto()},
+	}
+	err = h.onBlockHeaders(p, response)
+	require.Error(t, err)
+	require.Equal(t, ValidationErr, err.(*msgError).code)
+
+	// the response to a resolved request is unsolicited
+	score := p.Score()
+	require.NoError(t, h.onBlockHeaders(p, response))
+	require.Equal(t, score-unsolicitedScorePenalty, p.Score())
+}
+
+func TestIdenaGossipHandler_GetBlockHeaders_notSupported(t *testing.T) {
+	h := &IdenaGossipHandler{
+		peers: newPeerSet(),
+	}
+	p, _ := newTestPeer("peer")
+	require.NoError(t, h.peers.Register(p))
+
+	_, err := h.GetBlockHeaders(p.id, 1, 1, 0)
+	require.Error(t, err)
+	require.Zero(t, p.pendingRequests.Len())
+}
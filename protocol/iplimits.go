@@ -0,0 +1,53 @@
+package protocol
+
+import (
+	"github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
+	"net"
+)
+
+func remoteIP(addr multiaddr.Multiaddr) net.IP {
+	if addr == nil {
+		return nil
+	}
+	ip, err := manet.ToIP(addr)
+	if err != nil {
+		return nil
+	}
+	return ip
+}
+
+// subnetOf returns the /24 subnet of an IPv4 address and the /64 subnet of an IPv6 one
+func subnetOf(ip net.IP) string {
+	if v4 := ip.To4(); v4 != nil {
+		return v4.Mask(net.CIDRMask(24, 32)).String()
+	}
+	return ip.Mask(net.CIDRMask(64, 128)).String()
+}
+
+// CanAcceptFrom reports whether the limits of inbound peers per IP and per subnet allow one more inbound peer
+// from the address, it's checked before the handshake. Loopback addresses are not limited
+func (m *ConnManager) CanAcceptFrom(addr multiaddr.Multiaddr) bool {
+	limits := m.limits()
+	maxPerIP, maxPerSubnet := limits.MaxInboundPeersPerIP, limits.MaxInboundPeersPerSubnet
+	if maxPerIP <= 0 && maxPerSubnet <= 0 {
+		return true
+	}
+	ip := remoteIP(addr)
+	if ip == nil || ip.IsLoopback() {
+		return true
+	}
+	subnet := subnetOf(ip)
+	sameIP, sameSubnet := 0, 0
+	m.peerMutex.RLock()
+	defer m.peerMutex.RUnlock()
+	for _, other := range m.inboundIPs {
+		if other.Equal(ip) {
+			sameIP++
+		}
+		if subnetOf(other) == subnet {
+			sameSubnet++
+		}
+	}
+	return (maxPerIP <= 0 || sameIP < maxPerIP) && (maxPerSubnet <= 0 || sameSubnet < maxPerSubnet)
+}
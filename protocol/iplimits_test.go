@@ -0,0 +1,64 @@
+package protocol
+
+import (
+	"github.com/idena-network/idena-go/config"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestConnManager_CanAcceptFrom(t *testing.T) {
+	addr := func(s string) multiaddr.Multiaddr {
+		a, err := multiaddr.NewMultiaddr(s)
+		require.NoError(t, err)
+		return a
+	}
+	m := NewConnManager(nil, config.P2P{MaxInboundPeersPerIP: 2, MaxInboundPeersPerSubnet: 3})
+
+	require.True(t, m.CanAcceptFrom(addr("/ip4/10.0.0.1/tcp/40404")))
+	m.Connected(peer.ID("1"), true, 0, addr("/ip4/10.0.0.1/tcp/40404"))
+	m.Connected(peer.ID("2"), true, 0, addr("/ip4/10.0.0.1/tcp/40405"))
+	require.False(t, m.CanAcceptFrom(addr("/ip4/10.0.0.1/tcp/40406")))
+	require.True(t, m.CanAcceptFrom(addr("/ip4/10.0.0.2/tcp/40404")))
+
+	m.Connected(peer.ID("3"), true, 0, addr("/ip4/10.0.0.2/tcp/40404"))
+	require.False(t, m.CanAcceptFrom(addr("/ip4/10.0.0.3/tcp/40404")))
+	require.True(t, m.CanAcceptFrom(addr("/ip4/10.0.1.1/tcp/40404")))
+
+	// outbound peers are not counted
+	m.Connected(peer.ID("4"), false, 0, addr("/ip4/10.0.1.1/tcp/40404"))
+	m.Connected(peer.ID("5"), false, 0, addr("/ip4/10.0.1.1/tcp/40405"))
+	require.True(t, m.CanAcceptFrom(addr("/ip4/10.0.1.1/tcp/40406")))
+
+	m.Disconnected(peer.ID("1"), nil)
+	require.True(t, m.CanAcceptFrom(addr("/ip4/10.0.0.1/tcp/40406")))
+
+	require.True(t, m.CanAcceptFrom(addr("/ip4/127.0.0.1/tcp/40404")))
+	require.True(t, m.CanAcceptFrom(nil))
+
+	m.Connected(peer.ID("6"), true, 0, addr("/ip6/2001:db8::1/tcp/40404"))
+	m.Connected(peer.ID("7"), true, 0, addr("/ip6/2001:db8::2/tcp/40404"))
+	m.Connected(peer.ID("8"), true, 0, addr("/ip6/2001:db8::3/tcp/40404"))
+	require.False(t, m.CanAcceptFrom(addr("/ip6/2001:db8::4/tcp/40404")))
+	require.True(t, m.CanAcceptFrom(addr("/ip6/2001:db8:0:1::1/tcp/40404")))
+
+	require.True(t, NewConnManager(nil, config.P2P{}).CanAcceptFrom(addr("/ip4/10.0.0.1/tcp/40404")))
+}
+
+func TestConnManager_SetPeerLimits(t *testing.T) {
+	cfg := config.P2P{MaxInboundPeers: 1, MaxOutboundPeers: 1, MaxInboundPeersPerIP: 1}
+	m := NewConnManager(nil, cfg)
+	addr, err := multiaddr.NewMultiaddr("/ip4/10.0.0.1/tcp/40404")
+	require.NoError(t, err)
+	m.Connected(peer.ID("1"), true, 0, addr)
+	require.False(t, m.CanAcceptStream())
+	require.False(t, m.CanAcceptFrom(addr))
+
+	m.SetPeerLimits(config.P2P{MaxInboundPeers: 2, MaxOutboundPeers: 3, MaxInboundPeersPerIP: 2, MaxBlocksPerRange: 10})
+	require.True(t, m.CanAcceptStream())
+	require.True(t, m.CanAcceptFrom(addr))
+	require.Equal(t, 3, m.MaxOutboundPeers())
+	// other settings are not changed
+	require.Zero(t, m.cfg.MaxBlocksPerRange)
+}
@@ -139,6 +139,152 @@ func (rm *peersRateMetrics) loopLog(logger log.Logger) {
 	}
 }
 
+// TrafficStats holds the number of messages and bytes exchanged with peers
+type TrafficStats struct {
+	MessagesSent     uint64
+	BytesSent        uint64
+	MessagesReceived uint64
+	BytesReceived    uint64
+}
+
+func (s *TrafficStats) add(other TrafficStats) {
+	s.MessagesSent += other.MessagesSent
+	s.BytesSent += other.BytesSent
+	s.MessagesReceived += other.MessagesReceived
+	s.BytesReceived += other.BytesReceived
+}
+
+type peerTraffic struct {
+	statsByCode map[uint64]*TrafficStats
+	// total accumulates traffic of all peers, it outlives disconnected ones
+	total *peerTraffic
+	mutex sync.Mutex
+}
+
+func newPeerTraffic() *peerTraffic {
+	return &peerTraffic{
+		statsByCode: make(map[uint64]*TrafficStats),
+	}
+}
+
+func (t *peerTraffic) getStats(code uint64) *TrafficStats {
+	stats, ok := t.statsByCode[code]
+	if !ok {
+		stats = &TrafficStats{}
+		t.statsByCode[code] = stats
+	}
+	return stats
+}
+
+func (t *peerTraffic) addSent(code uint64, size int) {
+	if t == nil {
+		return
+	}
+	t.mutex.Lock()
+	stats := t.getStats(code)
+	stats.MessagesSent++
+	stats.BytesSent += uint64(size)
+	t.mutex.Unlock()
+	t.total.addSent(code, size)
+}
+
+func (t *peerTraffic) addReceived(code uint64, size int) {
+	if t == nil {
+		return
+	}
+	t.mutex.Lock()
+	stats := t.getStats(code)
+	stats.MessagesReceived++
+	stats.BytesReceived += uint64(size)
+	t.mutex.Unlock()
+	t.total.addReceived(code, size)
+}
+
+func (t *peerTraffic) snapshot() map[uint64]TrafficStats {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	result := make(map[uint64]TrafficStats, len(t.statsByCode))
+	for code, stats := range t.statsByCode {
+		result[code] = *stats
+	}
+	return result
+}
+
+func (p *protoPeer) Traffic() map[uint64]TrafficStats {
+	return p.traffic.snapshot()
+}
+
+// Metrics returns traffic stats of all peers since start aggregated by message name, disconnected peers are counted too
+func (h *IdenaGossipHandler) Metrics() map[string]TrafficStats {
+	result := make(map[string]TrafficStats)
+	if h.traffic == nil {
+		return result
+	}
+	for code, stats := range h.traffic.snapshot() {
+		result[msgCodeToString(code)] = stats
+	}
+	return result
+}
+
+func (h *IdenaGossipHandler) totalTraffic() TrafficStats {
+	var total TrafficStats
+	for _, stats := range h.Metrics() {
+		total.add(stats)
+	}
+	return total
+}
+
+func (h *IdenaGossipHandler) registerTrafficMetrics() {
+	gauges := map[string]func(stats TrafficStats) uint64{
+		"pt.messagesSent":     func(stats TrafficStats) uint64 { return stats.MessagesSent },
+		"pt.bytesSent":        func(stats TrafficStats) uint64 { return stats.BytesSent },
+		"pt.messagesReceived": func(stats TrafficStats) uint64 { return stats.MessagesReceived },
+		"pt.bytesReceived":    func(stats TrafficStats) uint64 { return stats.BytesReceived },
+	}
+	for name, value := range gauges {
+		value := value
+		metrics.GetOrRegister(name, metrics.NewFunctionalGauge(func() int64 {
+			return int64(value(h.totalTraffic()))
+		}))
+	}
+}
+
+// registerPeerMetrics registers gauges of the number of peers and total depths of their queues and message caches
+func (h *IdenaGossipHandler) registerPeerMetrics() {
+	metrics.GetOrRegister("pc.count", metrics.NewFunctionalGauge(func() int64 {
+		return int64(h.peers.Len())
+	}))
+	gauges := map[string]func(p *protoPeer) int{
+		"pq.requests":     func(p *protoPeer) int { return len(p.queuedRequests) },
+		"pq.highPriority": func(p *protoPeer) int { return len(p.highPriorityRequests) },
+		"pq.consensus":    func(p *protoPeer) int { return len(p.consensusRequests) },
+		"pq.pushes":       func(p *protoPeer) int { return len(p.pushQueue) },
+		"pq.flipKeys":     func(p *protoPeer) int { return len(p.flipKeyQueue) },
+		"pq.votes":        func(p *protoPeer) int { return len(p.voteQueue) },
+		"pq.blockRanges":  func(p *protoPeer) int { return p.blockRanges.Len() },
+		"pk.msgCache":     func(p *protoPeer) int { return p.msgCache.Len() },
+	}
+	for name, value := range gauges {
+		value := value
+		metrics.GetOrRegister(name, metrics.NewFunctionalGauge(func() int64 {
+			var total int64
+			for _, p := range h.peers.Peers() {
+				total += int64(value(p))
+			}
+			return total
+		}))
+	}
+}
+
+func (h *IdenaGossipHandler) registerCacheMetrics() {
+	for pushId := range h.pushPullManager.CacheStats() {
+		pushId := pushId
+		metrics.GetOrRegister("sc."+pushTypeToString(pushId)+".hitRate", metrics.NewFunctionalGaugeFloat64(func() float64 {
+			return h.pushPullManager.CacheStats()[pushId].hitRate()
+		}))
+	}
+}
+
 func (h *IdenaGossipHandler) registerMetrics() {
 
 	totalSent := metrics.GetOrRegisterCounter("bs.total", metrics.DefaultRegistry)
@@ -146,49 +292,6 @@ func (h *IdenaGossipHandler) registerMetrics() {
 	compressTotal := metrics.GetOrRegisterCounter("cd.total", metrics.DefaultRegistry)
 	rate := newPeersRateMetrics(h.ceremonyChecker.IsRunning)
 
-	msgCodeToString := func(code uint64) string {
-		switch code {
-		case Handshake:
-			return "handshake"
-		case ProposeBlock:
-			return "proposeBlock"
-		case ProposeProof:
-			return "proposeProof"
-		case Vote:
-			return "vote"
-		case NewTx:
-			return "newTx"
-		case GetBlockByHash:
-			return "getBlockByHash"
-		case GetBlocksRange:
-			return "getBlocksRange"
-		case BlocksRange:
-			return "blockRange"
-		case FlipBody:
-			return "flipBody"
-		case FlipKey:
-			return "flipKey"
-		case SnapshotManifest:
-			return "snapshotManifest"
-		case Push:
-			return "push"
-		case Pull:
-			return "pull"
-		case GetForkBlockRange:
-			return "getForkBlockRange"
-		case FlipKeysPackage:
-			return "flipKeysPackage"
-		case Block:
-			return "block"
-		case BatchPush:
-			return "batchPush"
-		case BatchFlipKey:
-			return "batchFlipKey"
-		default:
-			return fmt.Sprintf("unknown code %v", code)
-		}
-	}
-
 	sortedMetricCodes := []uint64{
 		BatchFlipKey,
 		BatchPush,
@@ -212,20 +315,8 @@ func (h *IdenaGossipHandler) registerMetrics() {
 
 	loopLog := func() {
 		startTime := time.Now()
-
-		cleanUp := func() {
-			totalSent.Clear()
-			totalReceived.Clear()
-			compressTotal.Clear()
-			for _, code := range sortedMetricCodes {
-				metrics.Unregister("br." + msgCodeToString(code))
-				metrics.Unregister("mr." + msgCodeToString(code))
-				metrics.Unregister("bs." + msgCodeToString(code))
-				metrics.Unregister("ms." + msgCodeToString(code))
-				metrics.Unregister("cd." + msgCodeToString(code))
-			}
-			startTime = time.Now()
-		}
+		// counters are exported and must not decrease, so the log shows differences with values at the start of the period
+		counterValuesAtStart := make(map[string]int64)
 
 		const codeTotal = "total"
 		type metricData struct {
@@ -233,6 +324,8 @@ func (h *IdenaGossipHandler) registerMetrics() {
 			bytesReceived    int64
 			messagesSent     int64
 			messagesReceived int64
+			// bytes saved by compression of received messages, raw size is bytesReceived + bytesSaved
+			bytesSaved int64
 		}
 		metricCodesMap := make(map[string]struct{})
 		for _, metricCode := range sortedMetricCodes {
@@ -240,52 +333,67 @@ func (h *IdenaGossipHandler) registerMetrics() {
 		}
 		metricCodesMap[codeTotal] = struct{}{}
 
+		eachCounter := func(f func(name string, metricType string, code string, value int64)) {
+			metrics.DefaultRegistry.Each(func(name string, i interface{}) {
+				metric, ok := i.(metrics.Counter)
+				if !ok {
+					return
+				}
+				nameParts := strings.Split(name, ".")
+				if len(nameParts) != 2 {
+					return
+				}
+				if _, ok := metricCodesMap[nameParts[1]]; !ok {
+					return
+				}
+				f(name, nameParts[0], nameParts[1], metric.Count())
+			})
+		}
+
+		cleanUp := func() {
+			eachCounter(func(name string, metricType string, code string, value int64) {
+				counterValuesAtStart[name] = value
+			})
+			startTime = time.Now()
+		}
+
 		logMetrics := func() {
 			metricsData := make(map[string]*metricData)
-			metrics.DefaultRegistry.Each(func(name string, i interface{}) {
-				switch metric := i.(type) {
-				case metrics.Counter:
-					nameParts := strings.Split(name, ".")
-					if len(nameParts) != 2 {
-						return
-					}
-					code := nameParts[1]
-					if _, ok := metricCodesMap[code]; !ok {
-						return
-					}
-					data, ok := metricsData[code]
-					if !ok {
-						data = &metricData{}
-						metricsData[code] = data
-					}
-					metricType := nameParts[0]
-					switch metricType {
-					case "bs":
-						data.bytesSent = metric.Count()
-					case "br":
-						data.bytesReceived = metric.Count()
-					case "ms":
-						data.messagesSent = metric.Count()
-					case "mr":
-						data.messagesReceived = metric.Count()
-					}
+			eachCounter(func(name string, metricType string, code string, value int64) {
+				data, ok := metricsData[code]
+				if !ok {
+					data = &metricData{}
+					metricsData[code] = data
+				}
+				value -= counterValuesAtStart[name]
+				switch metricType {
+				case "bs":
+					data.bytesSent = value
+				case "br":
+					data.bytesReceived = value
+				case "ms":
+					data.messagesSent = value
+				case "mr":
+					data.messagesReceived = value
+				case "cd":
+					data.bytesSaved = value
 				}
 			})
 			if len(metricsData) > 0 {
 				writer := new(tabwriter.Writer)
 				buffer := new(bytes.Buffer)
 				writer.Init(buffer, 8, 8, 1, ' ', 0)
-				fmt.Fprintf(writer, "\n %s\t%s\t%s\t%s\t%s\t", "name", "bytesSent", "bytesReceived", "msgSent", "msgReceived")
+				fmt.Fprintf(writer, "\n %s\t%s\t%s\t%s\t%s\t%s\t", "name", "bytesSent", "bytesReceived", "bytesSaved", "msgSent", "msgReceived")
 				for _, metricCode := range sortedMetricCodes {
 					strCode := msgCodeToString(metricCode)
 					data, ok := metricsData[strCode]
 					if !ok {
 						continue
 					}
-					fmt.Fprintf(writer, "\n %s\t%d\t%d\t%d\t%d\t", strCode, data.bytesSent, data.bytesReceived, data.messagesSent, data.messagesReceived)
+					fmt.Fprintf(writer, "\n %s\t%d\t%d\t%d\t%d\t%d\t", strCode, data.bytesSent, data.bytesReceived, data.bytesSaved, data.messagesSent, data.messagesReceived)
 				}
 				if data, ok := metricsData[codeTotal]; ok {
-					fmt.Fprintf(writer, "\n %s\t%d\t%d\t%d\t%d\t", codeTotal, data.bytesSent, data.bytesReceived, data.messagesSent, data.messagesReceived)
+					fmt.Fprintf(writer, "\n %s\t%d\t%d\t%d\t%d\t%d\t", codeTotal, data.bytesSent, data.bytesReceived, data.bytesSaved, data.messagesSent, data.messagesReceived)
 				}
 				writer.Flush()
 				log.Info(fmt.Sprintf("metric since %v", startTime.UTC().String()) + buffer.String())
@@ -330,16 +438,36 @@ func (h *IdenaGossipHandler) registerMetrics() {
 		rate.addOut(peerId, size, duration)
 	}
 
+	h.metrics.dropMessage = func(code uint64) {
+		if h.cfg.DisableMetrics {
+			return
+		}
+		metrics.GetOrRegisterCounter("md."+msgCodeToString(code), metrics.DefaultRegistry).Inc(1)
+	}
+
+	h.metrics.rateLimit = func(code uint64) {
+		if h.cfg.DisableMetrics {
+			return
+		}
+		metrics.GetOrRegisterCounter("rl."+msgCodeToString(code), metrics.DefaultRegistry).Inc(1)
+	}
+
 	h.metrics.compress = func(code uint64, size int) {
-		//if h.cfg.DisableMetrics {
-		//	return
-		//}
-		//compressCnt := metrics.GetOrRegisterCounter("cd."+msgCodeToString(code), metrics.DefaultRegistry)
-		//compressCnt.Inc(int64(size))
-		//compressTotal.Inc(int64(size))
+		if h.cfg.DisableMetrics {
+			return
+		}
+		compressCnt := metrics.GetOrRegisterCounter("cd."+msgCodeToString(code), metrics.DefaultRegistry)
+		compressCnt.Inc(int64(size))
+		compressTotal.Inc(int64(size))
 	}
 
 	if !h.cfg.DisableMetrics {
+		h.registerTrafficMetrics()
+		h.registerCacheMetrics()
+		h.registerPeerMetrics()
+		metrics.GetOrRegister("p2p.connections", metrics.NewFunctionalGauge(func() int64 {
+			return int64(len(h.host.Network().Conns()))
+		}))
 		go loopLog()
 		go rate.loopLog(h.log)
 	}
@@ -0,0 +1,177 @@
+package protocol
+
+import (
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/config"
+	"github.com/rcrowley/go-metrics"
+	"github.com/stretchr/testify/require"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestProtoPeer_Traffic(t *testing.T) {
+	p, remote := newTestPeer("peer")
+	go p.broadcast()
+
+	for i := 0; i < 3; i++ {
+		p.sendMsg(Vote, &types.Vote{Header: &types.VoteHeader{Round: uint64(i)}}, 0, false)
+	}
+	for i := 0; i < 2; i++ {
+		p.sendMsg(NewTx, &types.Transaction{AccountNonce: uint32(i), Amount: big.NewInt(1)}, 0, false)
+	}
+
+	require.NoError(t, remote.stream.SetReadDeadline(time.Now().Add(time.Second)))
+	for i := 0; i < 5; i++ {
+		_, err := remote.ReadMsg()
+		require.NoError(t, err)
+	}
+
+	received := remote.Traffic()
+	require.Len(t, received, 2)
+	require.Equal(t, uint64(3), received[Vote].MessagesReceived)
+	require.Equal(t, uint64(2), received[NewTx].MessagesReceived)
+	require.NotZero(t, received[Vote].BytesReceived)
+	require.NotZero(t, received[NewTx].BytesReceived)
+	require.Zero(t, received[Vote].MessagesSent)
+
+	require.Eventually(t, func() bool {
+		sent := p.Traffic()
+		return sent[Vote].MessagesSent == 3 && sent[NewTx].MessagesSent == 2
+	}, time.Second, time.Millisecond*10)
+	sent := p.Traffic()
+	require.Equal(t, received[Vote].BytesReceived, sent[Vote].BytesSent)
+	require.Equal(t, received[NewTx].BytesReceived, sent[NewTx].BytesSent)
+}
+
+func TestIdenaGossipHandler_Metrics(t *testing.T) {
+	h := &IdenaGossipHandler{
+		peers:   newPeerSet(),
+		traffic: newPeerTraffic(),
+	}
+	p1, _ := newTestPeer("peer1")
+	p2, _ := newTestPeer("peer2")
+	p1.traffic.total = h.traffic
+	p2.traffic.total = h.traffic
+	p1.traffic.addReceived(Vote, 100)
+	p1.traffic.addSent(NewTx, 50)
+	p2.traffic.addReceived(Vote, 20)
+	require.NoError(t, h.peers.Register(p1))
+	require.NoError(t, h.peers.Register(p2))
+	// traffic of disconnected peers is kept
+	h.peers.Unregister(p2.id)
+
+	result := h.Metrics()
+	require.Equal(t, TrafficStats{MessagesReceived: 2, BytesReceived: 120}, result[msgCodeToString(Vote)])
+	require.Equal(t, TrafficStats{MessagesSent: 1, BytesSent: 50}, result[msgCodeToString(NewTx)])
+	require.Equal(t, TrafficStats{MessagesSent: 1, BytesSent: 50, MessagesReceived: 2, BytesReceived: 120}, h.totalTraffic())
+}
+
+func TestIdenaGossipHandler_PeerSnapshots(t *testing.T) {
+	h := &IdenaGossipHandler{
+		peers: newPeerSet(),
+	}
+	require.Empty(t, h.PeerSnapshots())
+
+	p, _ := newTestPeer("peer")
+	p.knownHeight.Store(15)
+	p.protocolVersion = CurrentProtocolVersion
+	p.latency = int64(time.Millisecond * 40)
+	SetSupportedFeatures(p)
+	require.NoError(t, h.peers.Register(p))
+	p.sendMsg(Vote, &types.Vote{Header: &types.VoteHeader{Round: 1}}, 0, false)
+	for i := 0; i < voteQueueSize; i++ {
+		p.sendMsg(Vote, &types.Vote{Header: &types.VoteHeader{Round: uint64(i + 2)}}, 0, false)
+	}
+	p.sendMsg(NewTx, &types.Transaction{Amount: big.NewInt(1)}, 0, true)
+	p.sendMsg(NewTx, &types.Transaction{AccountNonce: 1, Amount: big.NewInt(1)}, 0, false)
+
+	snapshots := h.PeerSnapshots()
+	require.Len(t, snapshots, 1)
+	snapshot := snapshots[0]
+	require.Equal(t, p.ID(), snapshot.ID)
+	require.Equal(t, uint64(15), snapshot.KnownHeight)
+	require.Equal(t, CurrentProtocolVersion, snapshot.ProtocolVersion)
+	require.Equal(t, int64(40), snapshot.LatencyMs)
+	require.Equal(t, voteQueueSize, snapshot.QueuedVotes)
+	require.Equal(t, 1, snapshot.QueuedPriority)
+	require.Equal(t, 1, snapshot.QueuedRequests)
+	require.Equal(t, uint64(1), snapshot.DroppedMessages)
+	require.Equal(t, p.createdAt, snapshot.ConnectedSince)
+}
+
+func TestIdenaGossipHandler_PeerSnapshots_heights(t *testing.T) {
+	h := &IdenaGossipHandler{
+		peers: newPeerSet(),
+	}
+	low, _ := newTestPeer("low")
+	low.knownHeight.Store(10)
+	high, _ := newTestPeer("high")
+	high.knownHeight.Store(20)
+	high.Penalize(5, "test")
+	require.NoError(t, h.peers.Register(low))
+	require.NoError(t, h.peers.Register(high))
+
+	// snapshots are taken while the peer is being updated
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			low.knownHeight.Store(10)
+			low.sendMsg(NewTx, &types.Transaction{AccountNonce: uint32(i), Amount: big.NewInt(1)}, 0, false)
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		h.PeerSnapshots()
+	}
+	<-done
+
+	snapshots := make(map[string]PeerSnapshot)
+	for _, snapshot := range h.PeerSnapshots() {
+		snapshots[snapshot.ID] = snapshot
+	}
+	require.Len(t, snapshots, 2)
+	require.Equal(t, uint64(10), snapshots[low.ID()].KnownHeight)
+	require.Equal(t, 100, snapshots[low.ID()].QueuedRequests)
+	require.Equal(t, uint64(20), snapshots[high.ID()].KnownHeight)
+	require.Equal(t, int32(-5), snapshots[high.ID()].Score)
+}
+
+func TestProtoPeer_messageDropped(t *testing.T) {
+	dropped := make(map[uint64]int)
+	collector := newTestMetrics()
+	collector.dropMessage = func(code uint64) {
+		dropped[code]++
+	}
+	stream, _ := newTestStreams("local", "peer")
+	p := newPeer(stream, 0, collector, nil, config.PeerQueueConfig{Requests: 1, Votes: 1})
+
+	for i := 0; i < 3; i++ {
+		p.sendMsg(NewTx, &types.Transaction{AccountNonce: uint32(i), Amount: big.NewInt(1)}, 0, false)
+	}
+	p.addVoteToBatch(&types.Vote{Header: &types.VoteHeader{Round: 1}}, 0)
+	p.addVoteToBatch(&types.Vote{Header: &types.VoteHeader{Round: 2}}, 0)
+
+	require.Equal(t, map[uint64]int{NewTx: 2, Vote: 1}, dropped)
+	require.Equal(t, uint64(3), p.Snapshot().DroppedMessages)
+}
+
+func TestIdenaGossipHandler_registerPeerMetrics(t *testing.T) {
+	h := &IdenaGossipHandler{
+		peers: newPeerSet(),
+	}
+	h.registerPeerMetrics()
+	p, _ := newTestPeer("peer")
+	require.NoError(t, h.peers.Register(p))
+	for i := 0; i < 3; i++ {
+		p.sendMsg(NewTx, &types.Transaction{AccountNonce: uint32(i), Amount: big.NewInt(1)}, 0, false)
+	}
+	p.markKey("key")
+
+	gauge := func(name string) int64 {
+		return metrics.Get(name).(metrics.Gauge).Value()
+	}
+	require.Equal(t, int64(1), gauge("pc.count"))
+	require.Equal(t, int64(3), gauge("pq.requests"))
+	require.Equal(t, int64(1), gauge("pk.msgCache"))
+}
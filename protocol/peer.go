@@ -6,6 +6,8 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/idena-network/idena-go/blockchain/types"
 	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/knowncache"
+	"github.com/idena-network/idena-go/config"
 	"github.com/idena-network/idena-go/core/state/snapshot"
 	"github.com/idena-network/idena-go/crypto"
 	"github.com/idena-network/idena-go/log"
@@ -14,7 +16,7 @@ import (
 	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/libp2p/go-msgio"
-	"github.com/patrickmn/go-cache"
+	"github.com/multiformats/go-multiaddr"
 	"github.com/pkg/errors"
 	"math"
 	"math/rand"
@@ -28,7 +30,8 @@ const (
 	handshakeTimeout         = 20 * time.Second
 	msgCacheAliveTime        = 3 * time.Minute
 	flipKeyMsgCacheAliveTime = 10 * time.Minute
-	msgCacheGcTime           = 5 * time.Minute
+	// defaultMsgCacheSize bounds the number of message keys remembered per peer
+	defaultMsgCacheSize = 20000
 
 	maxTimeoutsBeforeBan = 7
 
@@ -36,9 +39,24 @@ const (
 
 	pushQueueSize    = 30000
 	flipKeyQueueSize = 30000
+	voteQueueSize    = 10000
+
+	blockRangeQueueSize = 10
+
+	voteBatchSize          = 100
+	voteBatchFlushInterval = 50 * time.Millisecond
 
 	queuedRequestsSize             = 15000
 	queuedHighPriorityRequestsSize = 4000
+	queuedConsensusRequestsSize    = 4000
+
+	disconnectWriteTimeout = time.Second
+
+	// defaultMaxPeerHeightAhead is far beyond the height the chain may reach in decades, a peer reporting more is lying
+	defaultMaxPeerHeightAhead = 100000000
+
+	// defaultDrainTimeout bounds the time spent on sending queued messages when the peer is closed gracefully
+	defaultDrainTimeout = 5 * time.Second
 )
 
 type compression = byte
@@ -50,7 +68,6 @@ const (
 
 type syncHeight struct {
 	value uint64
-	lock  sync.RWMutex
 }
 
 type queueItem struct {
@@ -58,18 +75,21 @@ type queueItem struct {
 	shardId common.ShardId
 }
 
-func (s *syncHeight) Store(value uint64) {
-	s.lock.Lock()
-	if value > s.value {
-		s.value = value
+// Store updates the height if the value is greater than the current one and reports whether it was updated, it is safe for concurrent use
+func (s *syncHeight) Store(value uint64) bool {
+	for {
+		current := atomic.LoadUint64(&s.value)
+		if value <= current {
+			return false
+		}
+		if atomic.CompareAndSwapUint64(&s.value, current, value) {
+			return true
+		}
 	}
-	s.lock.Unlock()
 }
 
 func (s *syncHeight) Read() uint64 {
-	s.lock.RLock()
-	defer s.lock.RUnlock()
-	return s.value
+	return atomic.LoadUint64(&s.value)
 }
 
 type protoPeer struct {
@@ -80,17 +100,25 @@ type protoPeer struct {
 	maxDelayMs           int
 	knownHeight          *syncHeight
 	potentialHeight      *syncHeight
+	headMutex            sync.RWMutex
+	headHash             common.Hash
 	manifestLock         sync.Mutex
 	manifest             *snapshot.Manifest
 	queuedRequests       chan *request
 	highPriorityRequests chan *request
+	consensusRequests    chan *request
 	pushQueue            chan *queueItem
 	flipKeyQueue         chan *queueItem
+	voteQueue            chan *queueItem
+	blockRanges          *blockRangeQueue
 	term                 chan struct{}
+	drain                chan struct{}
+	drainTimeout         time.Duration
+	closing              uint32
 	finished             chan struct{}
-	msgCache             *cache.Cache
+	msgCache             *knowncache.Cache
 	appVersion           string
-	timeouts             int
+	timeouts             uint32
 	log                  log.Logger
 	throttlingLogger     log.ThrottlingLogger
 	createdAt            time.Time
@@ -98,22 +126,68 @@ type protoPeer struct {
 	peers                uint32
 	metrics              *metricCollector
 	skippedRequestsCount uint32
+	droppedMessages      uint64
 	shardId              common.ShardId
 	version              *semver.Version
-	closed               bool
+	closed               uint32
 	supportedFeatures    map[PeerFeature]struct{}
-	disconnectReason     string
+	protocolVersion      uint32
+	observer             bool
+	light                bool
+	servesLight          bool
+	// listenAddrs are advertised by the peer in the handshake
+	listenAddrs      []multiaddr.Multiaddr
+	disconnectReason string
+	localDiscReason  DiscReason
+	localDiscErr     error
+	discRecorded     bool
+	discLock         sync.Mutex
+	termOnce         sync.Once
+	score            int32
+	rateLimiter      *rateLimiter
+	sendLimiter      *byteLimiter
+	// serveLimiter accounts responses with chain data, requests of the peer are dropped while it's exhausted
+	serveLimiter    *byteLimiter
+	knownTxs        *rollingBloom
+	knownFlips      *rollingBloom
+	pendingRequests *pendingRequests
+	traffic         *peerTraffic
+	latency         int64
+	pongs           chan uint64
+	// heightIncreased is called when the peer reports a height greater than the known one
+	heightIncreased func(id peer.ID, height uint64)
 }
 
-func newPeer(stream network.Stream, maxDelayMs int, metrics *metricCollector) *protoPeer {
+// peerQueueSizes replaces unset or invalid queue capacities with defaults
+func peerQueueSizes(cfg config.PeerQueueConfig) config.PeerQueueConfig {
+	orDefault := func(value int, defaultValue int) int {
+		if value <= 0 {
+			return defaultValue
+		}
+		return value
+	}
+	return config.PeerQueueConfig{
+		Requests:             orDefault(cfg.Requests, queuedRequestsSize),
+		HighPriorityRequests: orDefault(cfg.HighPriorityRequests, queuedHighPriorityRequestsSize),
+		ConsensusRequests:    orDefault(cfg.ConsensusRequests, queuedConsensusRequestsSize),
+		Pushes:               orDefault(cfg.Pushes, pushQueueSize),
+		FlipKeys:             orDefault(cfg.FlipKeys, flipKeyQueueSize),
+		Votes:                orDefault(cfg.Votes, voteQueueSize),
+		BlockRanges:          orDefault(cfg.BlockRanges, blockRangeQueueSize),
+	}
+}
+
+func newPeer(stream network.Stream, maxDelayMs int, metrics *metricCollector, rateLimits map[uint64]rateLimit, queues config.PeerQueueConfig) *protoPeer {
 	stream.Conn().RemotePeer()
 	rw := msgio.NewReadWriter(stream)
 
 	id := stream.Conn().RemotePeer()
 	prettyId := id.Pretty()
-	logger := log.New("id", prettyId)
+	logger := log.New(log.ModuleKey, "p2p", "id", prettyId)
 	throttlingLogger := log.NewThrottlingLogger(logger)
 
+	queues = peerQueueSizes(queues)
+
 	parts := strings.Split(string(stream.Protocol()), "/")
 	vers, err := semver.NewVersion(parts[len(parts)-1])
 	if err != nil {
@@ -125,14 +199,18 @@ func newPeer(stream network.Stream, maxDelayMs int, metrics *metricCollector) *p
 		prettyId:             prettyId,
 		stream:               stream,
 		rw:                   rw,
-		queuedRequests:       make(chan *request, queuedRequestsSize),
-		highPriorityRequests: make(chan *request, queuedHighPriorityRequestsSize),
-		pushQueue:            make(chan *queueItem, pushQueueSize),
-		flipKeyQueue:         make(chan *queueItem, flipKeyQueueSize),
+		queuedRequests:       make(chan *request, queues.Requests),
+		highPriorityRequests: make(chan *request, queues.HighPriorityRequests),
+		consensusRequests:    make(chan *request, queues.ConsensusRequests),
+		pushQueue:            make(chan *queueItem, queues.Pushes),
+		flipKeyQueue:         make(chan *queueItem, queues.FlipKeys),
+		voteQueue:            make(chan *queueItem, queues.Votes),
+		blockRanges:          newBlockRangeQueue(queues.BlockRanges),
 		term:                 make(chan struct{}),
+		drain:                make(chan struct{}),
 		finished:             make(chan struct{}),
 		maxDelayMs:           maxDelayMs,
-		msgCache:             cache.New(msgCacheAliveTime, msgCacheGcTime),
+		msgCache:             knowncache.New(defaultMsgCacheSize, msgCacheAliveTime),
 		log:                  logger,
 		throttlingLogger:     throttlingLogger,
 		createdAt:            time.Now().UTC(),
@@ -142,6 +220,10 @@ func newPeer(stream network.Stream, maxDelayMs int, metrics *metricCollector) *p
 		potentialHeight:      &syncHeight{},
 		version:              vers,
 		supportedFeatures:    map[PeerFeature]struct{}{},
+		rateLimiter:          newRateLimiter(rateLimits),
+		pendingRequests:      newPendingRequests(),
+		traffic:              newPeerTraffic(),
+		pongs:                make(chan uint64, 1),
 	}
 	SetSupportedFeatures(p)
 	return p
@@ -154,9 +236,10 @@ func (p *protoPeer) addPushToBatch(payload interface{}, shardId common.ShardId)
 	case <-p.finished:
 	default:
 		atomic.AddUint32(&p.skippedRequestsCount, 1)
-		if p.skippedRequestsCount > queuedRequestsSize {
+		p.messageDropped(Push)
+		if p.skippedRequestsCount > uint32(cap(p.queuedRequests)) {
 			p.throttlingLogger.Warn("Skipped requests limit reached for pushes", "addr", p.stream.Conn().RemoteMultiaddr().String())
-			p.disconnect("too many skipped pushes")
+			p.disconnect(DiscTooManySkippedRequests, errors.New("too many skipped pushes"))
 		}
 	}
 }
@@ -168,27 +251,67 @@ func (p *protoPeer) addFlipKeyToBatch(payload interface{}, shardId common.ShardI
 	case <-p.finished:
 	default:
 		atomic.AddUint32(&p.skippedRequestsCount, 1)
-		if p.skippedRequestsCount > queuedRequestsSize {
+		p.messageDropped(FlipKey)
+		if p.skippedRequestsCount > uint32(cap(p.queuedRequests)) {
 			p.throttlingLogger.Warn("Skipped requests limit reached for flip keys", "addr", p.stream.Conn().RemoteMultiaddr().String())
-			p.disconnect("too many skipped flip keys")
+			p.disconnect(DiscTooManySkippedRequests, errors.New("too many skipped flip keys"))
 		}
 	}
 }
 
+// addVoteToBatch queues the vote to be sent within a batch, votes which are known by the peer are skipped
+func (p *protoPeer) addVoteToBatch(vote *types.Vote, shardId common.ShardId) {
+	data, _ := vote.ToBytes()
+	key := msgKey(data)
+	if p.knowsKey(key) {
+		return
+	}
+	select {
+	case p.voteQueue <- &queueItem{payload: data, shardId: shardId}:
+		p.markKey(key)
+	case <-p.finished:
+	default:
+		p.messageDropped(Vote)
+		p.throttlingLogger.Warn("Vote queue is full, vote skipped", "addr", p.stream.Conn().RemoteMultiaddr().String())
+	}
+}
+
+func (p *protoPeer) messageDropped(code uint64) {
+	atomic.AddUint64(&p.droppedMessages, 1)
+	p.metrics.dropMessage(code)
+}
+
 func (p *protoPeer) sendMsg(msgcode uint64, payload interface{}, shardId common.ShardId, highPriority bool) {
-	if highPriority {
+	if atomic.LoadUint32(&p.closing) == 1 {
+		return
+	}
+	if msgcode == Vote && p.Supports(VoteBatches) {
+		p.addVoteToBatch(payload.(*types.Vote), shardId)
+		return
+	}
+	if isConsensusRequest(msgcode, payload) {
+		// consensus messages are gossiped by every peer, so a message which doesn't fit the queue is dropped
+		// instead of blocking the caller
+		select {
+		case p.consensusRequests <- &request{msgcode: msgcode, data: payload, shardId: shardId}:
+		case <-p.finished:
+		default:
+			p.messageDropped(msgcode)
+			p.throttlingLogger.Warn("Consensus queue is full, message skipped", "addr", p.stream.Conn().RemoteMultiaddr().String(), "code", msgcode)
+		}
+	} else if highPriority {
 		timer := time.NewTimer(time.Second * 5)
 		defer timer.Stop()
 		select {
 		case p.highPriorityRequests <- &request{msgcode: msgcode, data: payload, shardId: shardId}:
 		case <-timer.C:
 			p.log.Error("TIMEOUT while sending message (high priority)", "addr", p.stream.Conn().RemoteMultiaddr().String(), "len", len(p.highPriorityRequests))
-			p.disconnect("timeout while sending message (high priority)")
+			p.disconnect(DiscHighPriorityTimeout, nil)
 		case <-p.finished:
 		}
 	} else {
 		if msgcode == Push || msgcode == FlipKey {
-			if _, ok := p.supportedFeatures[Batches]; ok {
+			if p.Supports(Batches) {
 				switch msgcode {
 				case Push:
 					p.addPushToBatch(payload, shardId)
@@ -198,6 +321,10 @@ func (p *protoPeer) sendMsg(msgcode uint64, payload interface{}, shardId common.
 				return
 			}
 		}
+		if msgcode == BlocksRange {
+			p.sendBlockRange(payload.(*blockRange))
+			return
+		}
 
 		select {
 		case p.queuedRequests <- &request{msgcode: msgcode, data: payload, shardId: shardId}:
@@ -205,14 +332,30 @@ func (p *protoPeer) sendMsg(msgcode uint64, payload interface{}, shardId common.
 		case <-p.finished:
 		default:
 			atomic.AddUint32(&p.skippedRequestsCount, 1)
-			if p.skippedRequestsCount > queuedRequestsSize/2 {
+			p.messageDropped(msgcode)
+			if p.skippedRequestsCount > uint32(cap(p.queuedRequests)/2) {
 				p.throttlingLogger.Warn("Skipped requests limit reached", "addr", p.stream.Conn().RemoteMultiaddr().String())
-				p.disconnect("too many skipped requests")
+				p.disconnect(DiscTooManySkippedRequests, nil)
 			}
 		}
 	}
 }
 
+func (p *protoPeer) sendBlockRange(r *blockRange) {
+	evicted, ok := p.blockRanges.TryEnqueue(r)
+	for i := 0; i < evicted; i++ {
+		p.messageDropped(BlocksRange)
+	}
+	if !ok {
+		p.messageDropped(BlocksRange)
+		p.log.Debug("Block range queue is full", "batchId", r.BatchId)
+	}
+}
+
+func blockRangeRequest(r *blockRange) *request {
+	return &request{msgcode: BlocksRange, data: r, shardId: common.MultiShard}
+}
+
 func (p *protoPeer) makeBatches() {
 	const batchSize = 100
 
@@ -265,12 +408,61 @@ func (p *protoPeer) makeBatches() {
 	}
 }
 
+// makeVoteBatches coalesces votes queued within voteBatchFlushInterval into a single message,
+// a lone vote and a full batch are sent without waiting
+func (p *protoPeer) makeVoteBatches() {
+	for {
+		select {
+		case vote := <-p.voteQueue:
+			batch := new(msgBatch)
+			batch.Data = make([]*batchItem, 0, voteBatchSize)
+			batch.Data = append(batch.Data, &batchItem{Payload: vote.payload.([]byte), ShardId: vote.shardId})
+			if len(p.voteQueue) == 0 {
+				p.sendMsg(BatchVote, batch, common.MultiShard, true)
+				continue
+			}
+			timer := time.NewTimer(voteBatchFlushInterval)
+		voteLoop:
+			for len(batch.Data) < voteBatchSize {
+				select {
+				case vote = <-p.voteQueue:
+					batch.Data = append(batch.Data, &batchItem{Payload: vote.payload.([]byte), ShardId: vote.shardId})
+				case <-timer.C:
+					break voteLoop
+				case <-p.term:
+					timer.Stop()
+					return
+				}
+			}
+			timer.Stop()
+			p.sendMsg(BatchVote, batch, common.MultiShard, true)
+		case <-p.term:
+			return
+		}
+	}
+}
+
 func (p *protoPeer) broadcast() {
 	go p.makeBatches()
+	go p.makeVoteBatches()
 	defer close(p.finished)
-	defer p.disconnect("")
+	defer p.disconnect(DiscQuitting, nil)
 	send := func(request *request) error {
 		msg := makeMsg(request.msgcode, request.data, request.shardId)
+		if isServedMsg(request.msgcode) {
+			p.serveLimiter.reserve(len(msg))
+		}
+
+		// consensus messages take bytes from the limiter but are never delayed by it
+		if !isControlMsg(request.msgcode) {
+			if delay := p.sendLimiter.reserve(len(msg)); delay > 0 && !isConsensusRequest(request.msgcode, request.data) {
+				select {
+				case <-time.After(delay):
+				case <-p.term:
+					return errors.New("peer is closed")
+				}
+			}
+		}
 
 		ch := make(chan error, 1)
 		timer := time.NewTimer(time.Minute)
@@ -287,15 +479,18 @@ func (p *protoPeer) broadcast() {
 				case p.transportErr <- err:
 				default:
 				}
+				p.disconnect(DiscWriteError, err)
 				return err
 			}
 		case <-timer.C:
 			err := errors.New("TIMEOUT while writing to stream")
 			p.log.Error(err.Error(), "addr", p.stream.Conn().RemoteMultiaddr().String())
+			p.disconnect(DiscWriteTimeout, err)
 			return err
 		}
 		duration := time.Since(startTime)
 		p.metrics.outcomeMessage(request.msgcode, len(msg), duration, p.prettyId)
+		p.traffic.addSent(request.msgcode, len(msg))
 		return nil
 	}
 	logIfNeeded := func(r *request) {
@@ -308,6 +503,16 @@ func (p *protoPeer) broadcast() {
 			delay := time.Duration(rand.Int31n(int32(p.maxDelayMs)))
 			time.Sleep(delay * time.Millisecond)
 		}
+		// consensus messages are sent first, then high priority ones and the rest only when both queues are empty
+		select {
+		case request := <-p.consensusRequests:
+			if send(request) != nil {
+				return
+			}
+			continue
+		default:
+		}
+
 		select {
 		case request := <-p.highPriorityRequests:
 			if send(request) != nil {
@@ -319,6 +524,10 @@ func (p *protoPeer) broadcast() {
 		}
 
 		select {
+		case request := <-p.consensusRequests:
+			if send(request) != nil {
+				return
+			}
 		case request := <-p.highPriorityRequests:
 			if send(request) != nil {
 				return
@@ -328,12 +537,70 @@ func (p *protoPeer) broadcast() {
 			if send(request) != nil {
 				return
 			}
+		case <-p.blockRanges.ready:
+			if r := p.blockRanges.Dequeue(); r != nil && send(blockRangeRequest(r)) != nil {
+				return
+			}
+		case <-p.drain:
+			p.flushQueues(send, time.Now().Add(p.drainTimeout))
+			return
 		case <-p.term:
 			return
 		}
 	}
 }
 
+// flushQueues sends queued messages until the queues are empty or the deadline is reached, consensus messages go first
+func (p *protoPeer) flushQueues(send func(*request) error, deadline time.Time) {
+	for time.Now().Before(deadline) {
+		var request *request
+		select {
+		case request = <-p.consensusRequests:
+			if send(request) != nil {
+				return
+			}
+			continue
+		default:
+		}
+		select {
+		case request = <-p.highPriorityRequests:
+		case request = <-p.queuedRequests:
+		case <-p.blockRanges.ready:
+			r := p.blockRanges.Dequeue()
+			if r == nil {
+				continue
+			}
+			request = blockRangeRequest(r)
+		default:
+			return
+		}
+		if send(request) != nil {
+			return
+		}
+	}
+}
+
+// Close stops accepting new messages and disconnects the peer, if drainTimeout is positive messages which are already queued
+// are sent first, it takes not longer than drainTimeout
+func (p *protoPeer) Close(drainTimeout time.Duration) {
+	if !atomic.CompareAndSwapUint32(&p.closing, 0, 1) {
+		return
+	}
+	if drainTimeout <= 0 {
+		p.disconnect(DiscQuitting, nil)
+		return
+	}
+	p.drainTimeout = drainTimeout
+	close(p.drain)
+	timer := time.NewTimer(drainTimeout + time.Second)
+	defer timer.Stop()
+	select {
+	case <-p.finished:
+	case <-timer.C:
+		p.disconnect(DiscQuitting, nil)
+	}
+}
+
 func makeMsg(msgcode uint64, payload interface{}, shardId common.ShardId) []byte {
 	data, err := toBytes(msgcode, payload)
 	if err != nil {
@@ -381,27 +648,96 @@ func toBytes(msgcode uint64, payload interface{}) ([]byte, error) {
 		return payload.(*types.Block).ToBytes()
 	case UpdateShardId:
 		return payload.(*updateShardId).ToBytes()
-	case BatchPush, BatchFlipKey:
+	case BatchPush, BatchFlipKey, BatchVote:
 		return payload.(*msgBatch).ToBytes()
 	case Disconnect:
 		return payload.(*disconnect).ToBytes()
+	case NewBlockHash:
+		return payload.(*newBlockHash).ToBytes()
+	case Ping, Pong:
+		return proto.Marshal(payload.(*models.ProtoPing))
+	case GetTransactions:
+		return proto.Marshal(payload.(*models.ProtoGetTransactionsRequest))
+	case Transactions:
+		return proto.Marshal(payload.(*models.ProtoTransactions))
+	case GetPooledTransactions:
+		return proto.Marshal(payload.(*models.ProtoGetPooledTransactionsRequest))
+	case PooledTransactions:
+		return proto.Marshal(payload.(*models.ProtoPooledTransactions))
+	case GetStateProof:
+		return proto.Marshal(payload.(*models.ProtoGetStateProofRequest))
+	case StateProof:
+		return proto.Marshal(payload.(*models.ProtoStateProof))
+	case GetBlockHeaders:
+		return proto.Marshal(payload.(*models.ProtoGetBlockHeadersRequest))
+	case BlockHeaders:
+		return proto.Marshal(payload.(*models.ProtoBlockHeaders))
+	case GetFlip:
+		return proto.Marshal(payload.(*models.ProtoGetFlipRequest))
+	case Flip:
+		return proto.Marshal(payload.(*models.ProtoFlipResponse))
+	case Status:
+		return proto.Marshal(payload.(*models.ProtoStatus))
 	}
 	return nil, errors.Errorf("type %T is not serializable", payload)
 }
 
-func (p *protoPeer) Handshake(network types.Network, height uint64, genesis *types.GenesisInfo, appVersion string, peersCount uint32, shardId common.ShardId) error {
+func (p *protoPeer) Supports(feature PeerFeature) bool {
+	_, ok := p.supportedFeatures[feature]
+	return ok
+}
+
+// handshakeRules defines which remote peers are accepted during handshake
+type handshakeRules struct {
+	minProtocolVersion uint32
+	// peers of these networks with the same genesis are accepted in observer mode
+	observeNetworks map[types.Network]struct{}
+	// peers which report a height ahead of the local head by more than maxHeightAhead blocks are rejected, zero disables the check
+	maxHeightAhead uint64
+	// light is sent to remote peers by light clients
+	light bool
+	// serveLight accepts light clients and is advertised to remote peers
+	serveLight bool
+	// listenAddrs are advertised to remote peers, so they can dial the node at any of them
+	listenAddrs []multiaddr.Multiaddr
+}
+
+func newHandshakeRules(cfg config.P2P) handshakeRules {
+	rules := handshakeRules{
+		minProtocolVersion: cfg.MinProtocolVersion,
+		observeNetworks:    make(map[types.Network]struct{}),
+		maxHeightAhead:     cfg.MaxPeerHeightAhead,
+		serveLight:         !cfg.DisableLightServing,
+	}
+	if rules.maxHeightAhead == 0 {
+		rules.maxHeightAhead = defaultMaxPeerHeightAhead
+	}
+	for _, network := range cfg.ObserveNetworks {
+		rules.observeNetworks[network] = struct{}{}
+	}
+	return rules
+}
+
+func (p *protoPeer) Handshake(network types.Network, height uint64, headHash common.Hash, genesis *types.GenesisInfo, appVersion string, peersCount uint32, shardId common.ShardId, rules handshakeRules) error {
 	errc := make(chan error, 2)
 	handShake := new(handshakeData)
 	p.log.Trace("start handshake")
 	go func() {
 		data := &handshakeData{
-			NetworkId:    network,
-			Height:       height,
-			GenesisBlock: genesis.Genesis.Hash(),
-			Timestamp:    time.Now().UTC().Unix(),
-			AppVersion:   appVersion,
-			Peers:        peersCount,
-			ShardId:      shardId,
+			NetworkId:       network,
+			Height:          height,
+			HeadHash:        headHash,
+			GenesisBlock:    genesis.Genesis.Hash(),
+			Timestamp:       time.Now().UTC().Unix(),
+			AppVersion:      appVersion,
+			Peers:           peersCount,
+			ShardId:         shardId,
+			ProtocolVersion: CurrentProtocolVersion,
+			Light:           rules.light,
+			ServesLight:     rules.serveLight,
+		}
+		for _, addr := range rules.listenAddrs {
+			data.ListenAddrs = append(data.ListenAddrs, addr.Bytes())
 		}
 		if genesis.OldGenesis != nil {
 			hash := genesis.OldGenesis.Hash()
@@ -413,23 +749,34 @@ func (p *protoPeer) Handshake(network types.Network, height uint64, genesis *typ
 		p.log.Trace("handshake message sent", "shardId", shardId)
 	}()
 	go func() {
-		errc <- p.readStatus(handShake, network, genesis)
+		errc <- p.readStatus(handShake, network, genesis, height, rules)
 	}()
+	received := 0
+	// fail resets the stream to unblock the pending read or write and waits for both goroutines to finish
+	fail := func(err error) error {
+		p.stream.Reset()
+		for ; received < 2; received++ {
+			<-errc
+		}
+		return err
+	}
 	timeout := time.NewTimer(handshakeTimeout)
 	defer timeout.Stop()
-	for i := 0; i < 2; i++ {
+	for received < 2 {
 		select {
 		case err := <-errc:
+			received++
 			if err != nil {
-				return err
+				return fail(err)
 			}
 		case <-timeout.C:
-			return errors.New("handshake timeout")
+			return fail(errors.New("handshake timeout"))
 		}
 	}
-	p.knownHeight.Store(handShake.Height)
+	p.setHead(handShake.Height, handShake.HeadHash)
 	p.peers = handShake.Peers
 	p.shardId = handShake.ShardId
+	SetSupportedFeatures(p)
 	return nil
 }
 
@@ -443,6 +790,13 @@ func Decode(src []byte) ([]byte, error) {
 	case noCompression:
 		return src[1:], nil
 	case s2Compression:
+		size, err := s2.DecodedLen(src[1:])
+		if err != nil {
+			return nil, err
+		}
+		if size > maxDecodedMsgSize {
+			return nil, errors.Errorf("decoded msg is too big: %v", size)
+		}
 		return s2.Decode(nil, src[1:])
 	default:
 		return nil, errors.New("unknown compression")
@@ -476,12 +830,16 @@ func (p *protoPeer) ReadMsg() (*Msg, error) {
 	if err := result.FromBytes(data); err != nil {
 		return nil, err
 	}
+	if err := checkPayloadSize(result.Code, len(result.Payload)); err != nil {
+		return nil, err
+	}
 	p.metrics.incomeMessage(result.Code, len(compressedMsg), duration, p.prettyId)
 	p.metrics.compress(result.Code, len(data)-len(compressedMsg))
+	p.traffic.addReceived(result.Code, len(compressedMsg))
 	return result, nil
 }
 
-func (p *protoPeer) readStatus(handShake *handshakeData, network types.Network, genesis *types.GenesisInfo) (err error) {
+func (p *protoPeer) readStatus(handShake *handshakeData, network types.Network, genesis *types.GenesisInfo, height uint64, rules handshakeRules) (err error) {
 	p.log.Trace("read handshake data")
 	msg, err := p.ReadMsg()
 	if err != nil {
@@ -494,30 +852,104 @@ func (p *protoPeer) readStatus(handShake *handshakeData, network types.Network,
 		return errors.New(fmt.Sprintf("can't decode handshake %v: %v", msg, err))
 	}
 	p.appVersion = handShake.AppVersion
+	p.protocolVersion = handShake.ProtocolVersion
+	if p.protocolVersion == 0 {
+		p.protocolVersion = legacyProtocolVersion
+	}
+	if p.protocolVersion < rules.minProtocolVersion {
+		return errors.Errorf("protocol version %d (app version %v) is not supported, min supported version is %d", p.protocolVersion, p.appVersion, rules.minProtocolVersion)
+	}
 	if !genesis.EqualAny(handShake.GenesisBlock, handShake.OldGenesis) {
 		return errors.New(fmt.Sprintf("bad genesis block %x (!= %x)", handShake.GenesisBlock[:8], genesis.Genesis.Hash().Bytes()[:8]))
 	}
 
 	if handShake.NetworkId != network {
-		return errors.New(fmt.Sprintf("network mismatch: %d (!= %d)", handShake.NetworkId, network))
+		if _, ok := rules.observeNetworks[handShake.NetworkId]; !ok {
+			return errors.New(fmt.Sprintf("network mismatch: %d (!= %d)", handShake.NetworkId, network))
+		}
+		p.observer = true
+	}
+	if handShake.Light {
+		if !rules.serveLight {
+			return errors.New("light clients are not served")
+		}
+		p.light = true
+		p.observer = true
 	}
+	p.servesLight = handShake.ServesLight
+	p.listenAddrs = parseAdvertisedAddrs(handShake.ListenAddrs)
 	diff := math.Abs(float64(time.Now().UTC().Unix() - int64(handShake.Timestamp)))
 	if diff > MaxTimestampLagSeconds {
 		return errors.New(fmt.Sprintf("time difference is too big (%v sec)", diff))
 	}
+	if rules.maxHeightAhead > 0 && handShake.Height > height && handShake.Height-height > rules.maxHeightAhead {
+		return errors.Wrapf(errImplausibleHeight, "height %d, local height %d", handShake.Height, height)
+	}
 	return nil
 }
 
 func (p *protoPeer) markKey(key string) {
-	p.markKeyWithExpiration(key, cache.DefaultExpiration)
+	p.msgCache.Add(key)
+}
+
+// knowsKey reports whether the message was received from the peer or already sent to it
+func (p *protoPeer) knowsKey(key string) bool {
+	return p.msgCache.Has(key)
+}
+
+// markTxKey records that the peer knows the transaction push, the known txs filter is used instead of the message cache if it's enabled
+func (p *protoPeer) markTxKey(key string) {
+	if p.knownTxs != nil {
+		p.knownTxs.Add(key)
+		return
+	}
+	p.markKey(key)
+}
+
+// markFlipKey records that the peer knows the flip push, the known flips filter is used like the known txs one
+func (p *protoPeer) markFlipKey(key string) {
+	if p.knownFlips != nil {
+		p.knownFlips.Add(key)
+		return
+	}
+	p.markKey(key)
+}
+
+// knowsMsg works like knowsKey but consults the known txs and flips filters for transaction and flip pushes,
+// a false positive of a filter may suppress sending the push which is recovered by pulls from other peers
+func (p *protoPeer) knowsMsg(msgcode uint64, payload interface{}, key string) bool {
+	if p.knownTxs != nil && isPushOf(msgcode, payload, pushTx) {
+		return p.knownTxs.Has(key)
+	}
+	if p.knownFlips != nil && isPushOf(msgcode, payload, pushFlip) {
+		return p.knownFlips.Has(key)
+	}
+	return p.knowsKey(key)
+}
+
+func (p *protoPeer) markMsg(msgcode uint64, payload interface{}, key string, expiration time.Duration) {
+	if isPushOf(msgcode, payload, pushTx) {
+		p.markTxKey(key)
+		return
+	}
+	if p.knownFlips != nil && isPushOf(msgcode, payload, pushFlip) {
+		p.knownFlips.Add(key)
+		return
+	}
+	p.markKeyWithExpiration(key, expiration)
+}
+
+func isPushOf(msgcode uint64, payload interface{}, pushType pushType) bool {
+	hash, ok := payload.(pushPullHash)
+	return ok && msgcode == Push && hash.Type == pushType
 }
 
 func (p *protoPeer) unmarkKey(key string) {
-	p.msgCache.Delete(key)
+	p.msgCache.Remove(key)
 }
 
 func (p *protoPeer) markKeyWithExpiration(key string, expiration time.Duration) {
-	p.msgCache.Add(key, struct{}{}, expiration)
+	p.msgCache.AddWithTTL(key, expiration)
 }
 
 func msgKey(data []byte) string {
@@ -526,47 +958,150 @@ func msgKey(data []byte) string {
 }
 
 func (p *protoPeer) setHeight(newHeight uint64) {
-	if newHeight > p.knownHeight.Read() {
-		p.knownHeight.Store(newHeight)
+	if p.knownHeight.Store(newHeight) && p.heightIncreased != nil {
+		p.heightIncreased(p.id, newHeight)
 	}
 	p.setPotentialHeight(newHeight)
 }
 
 func (p *protoPeer) setPotentialHeight(newHeight uint64) {
-	if newHeight > p.potentialHeight.Read() {
-		p.potentialHeight.Store(newHeight)
-	}
+	p.potentialHeight.Store(newHeight)
 }
 
 func (p *protoPeer) addTimeout() (shouldBeBanned bool) {
-	p.timeouts++
-	return p.timeouts > maxTimeoutsBeforeBan
+	timeouts := atomic.AddUint32(&p.timeouts, 1)
+	p.addScore(-timeoutScorePenalty)
+	return timeouts > maxTimeoutsBeforeBan
 }
 
 func (p *protoPeer) resetTimeouts() {
-	p.timeouts = 0
+	atomic.StoreUint32(&p.timeouts, 0)
+}
+
+func (p *protoPeer) isClosed() bool {
+	return atomic.LoadUint32(&p.closed) == 1
 }
 
-func (p *protoPeer) disconnect(reason string) {
-	if reason != "" {
-		var dc = &disconnect{reason}
-		msg := makeMsg(Disconnect, dc, common.MultiShard)
-		p.rw.WriteMsg(msg)
-		time.Sleep(time.Second)
+// disconnect records the first reason of disconnection, notifies remote peer about it if needed and tears down the connection
+func (p *protoPeer) disconnect(reason DiscReason, err error) {
+	p.discLock.Lock()
+	first := !p.discRecorded
+	if first {
+		p.discRecorded = true
+		p.localDiscReason = reason
+		p.localDiscErr = err
+	}
+	p.discLock.Unlock()
+
+	notified := false
+	if first {
+		p.log.Debug("Disconnecting peer", "reason", reason, "err", err, "height", p.knownHeight.Read())
+		if reason.notifyRemote() {
+			notified = p.notifyDisconnect(reason)
+		}
+	}
+	p.termOnce.Do(func() {
+		close(p.term)
+	})
+	// closing the stream lets remote peer read the reason while reset would drop it
+	if notified {
+		if err := p.stream.Close(); err == nil {
+			return
+		}
 	}
 	if err := p.stream.Reset(); err != nil {
 		p.log.Error("error while resetting peer stream", "err", err)
 	}
 }
 
+// notifyDisconnect makes a best effort to send the reason to remote peer, the stream may be stuck so writing is bounded by disconnectWriteTimeout
+func (p *protoPeer) notifyDisconnect(reason DiscReason) bool {
+	msg := makeMsg(Disconnect, &disconnect{reason.String()}, common.MultiShard)
+	written := make(chan error, 1)
+	go func() {
+		written <- p.rw.WriteMsg(msg)
+	}()
+	timer := time.NewTimer(disconnectWriteTimeout)
+	defer timer.Stop()
+	select {
+	case err := <-written:
+		return err == nil
+	case <-timer.C:
+		p.log.Debug("Timeout while sending disconnect reason")
+		return false
+	}
+}
+
+// LastDisconnectReason returns the reason the peer was disconnected by the local node for
+func (p *protoPeer) LastDisconnectReason() (DiscReason, error) {
+	p.discLock.Lock()
+	defer p.discLock.Unlock()
+	return p.localDiscReason, p.localDiscErr
+}
+
 func (p *protoPeer) ID() string {
 	return p.id.Pretty()
 }
 
+// Log returns the logger of the peer, its records carry the p2p module and the peer id
+func (p *protoPeer) Log() log.Logger {
+	return p.log
+}
+
 func (p *protoPeer) RemoteAddr() string {
 	return p.stream.Conn().RemoteMultiaddr().String()
 }
 
+// PeerSnapshot describes the state of a connected peer for API consumers, QueuedTxs is the number of pushes waiting
+// to be batched (most of them announce transactions), QueuedPriority is the number of consensus and high priority messages
+// waiting to be sent (senders are blocked when these queues are full, so a growing value means a congested peer)
+// and QueuedRequests is the number of other messages waiting to be sent
+type PeerSnapshot struct {
+	ID              string    `json:"id"`
+	RemoteAddr      string    `json:"addr"`
+	KnownHeight     uint64    `json:"knownHeight"`
+	ProtocolVersion uint32    `json:"protocolVersion"`
+	LatencyMs       int64     `json:"latency"`
+	ResponseTimeMs  int64     `json:"responseTime"`
+	QueuedTxs       int       `json:"queuedTxs"`
+	QueuedVotes     int       `json:"queuedVotes"`
+	QueuedPriority  int       `json:"queuedPriority"`
+	QueuedRequests  int       `json:"queuedRequests"`
+	DroppedMessages uint64    `json:"droppedMessages"`
+	ConnectedSince  time.Time `json:"connectedSince"`
+	Score           int32     `json:"score"`
+	Light           bool      `json:"light"`
+	ServesLight     bool      `json:"servesLight"`
+	HeadHash        string    `json:"headHash"`
+	ListenAddrs     []string  `json:"listenAddrs"`
+}
+
+func (p *protoPeer) Snapshot() PeerSnapshot {
+	listenAddrs := make([]string, 0, len(p.listenAddrs))
+	for _, addr := range p.listenAddrs {
+		listenAddrs = append(listenAddrs, addr.String())
+	}
+	return PeerSnapshot{
+		ID:              p.ID(),
+		RemoteAddr:      p.RemoteAddr(),
+		KnownHeight:     p.knownHeight.Read(),
+		ProtocolVersion: p.protocolVersion,
+		LatencyMs:       p.LatencyMs(),
+		ResponseTimeMs:  p.pendingRequests.ResponseTime().Milliseconds(),
+		QueuedTxs:       len(p.pushQueue),
+		QueuedVotes:     len(p.voteQueue),
+		QueuedPriority:  len(p.consensusRequests) + len(p.highPriorityRequests),
+		QueuedRequests:  len(p.queuedRequests) + p.blockRanges.Len(),
+		DroppedMessages: atomic.LoadUint64(&p.droppedMessages),
+		ConnectedSince:  p.createdAt,
+		Score:           p.Score(),
+		Light:           p.light,
+		ServesLight:     p.servesLight,
+		HeadHash:        p.HeadHash().Hex(),
+		ListenAddrs:     listenAddrs,
+	}
+}
+
 func (p *protoPeer) Manifest() *snapshot.Manifest {
 	p.manifestLock.Lock()
 	defer p.manifestLock.Unlock()
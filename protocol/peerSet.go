@@ -93,10 +93,13 @@ func (ps *peerSet) Peers() []*protoPeer {
 }
 
 func (ps *peerSet) SendWithFilter(msgcode uint64, key string, payload interface{}, shardId common.ShardId, highPriority bool) {
-	ps.SendWithFilterAndExpiration(msgcode, key, payload, shardId, highPriority, msgCacheAliveTime)
+	ps.SendWithFilterAndExpiration(msgcode, key, payload, shardId, highPriority, 0)
 }
 
 func (ps *peerSet) shouldSendToPeer(p *protoPeer, msgShardId common.ShardId, peersCnt int, highPriority bool) bool {
+	if p.observer {
+		return false
+	}
 	if msgShardId == common.MultiShard || p.shardId == msgShardId || p.shardId == common.MultiShard || peersCnt < 4 || highPriority {
 		return true
 	}
@@ -104,15 +107,28 @@ func (ps *peerSet) shouldSendToPeer(p *protoPeer, msgShardId common.ShardId, pee
 	return rnd > 1-1.8/float32(peersCnt)
 }
 
+// peersOrder sorts peers in place to define the order in which they receive a message
+type peersOrder func(peers []*protoPeer)
+
+// SendWithFilterAndExpiration sends the message to peers which don't know it yet and marks it as known for expiration,
+// zero expiration means the default lifetime of the peer message cache
 func (ps *peerSet) SendWithFilterAndExpiration(msgcode uint64, key string, payload interface{}, msgShardId common.ShardId, highPriority bool, expiration time.Duration) {
+	ps.SendWithOrder(msgcode, key, payload, msgShardId, highPriority, expiration, nil)
+}
+
+// SendWithOrder works like SendWithFilterAndExpiration but enqueues the message to peers in the given order
+func (ps *peerSet) SendWithOrder(msgcode uint64, key string, payload interface{}, msgShardId common.ShardId, highPriority bool, expiration time.Duration, order peersOrder) {
 	peers := ps.Peers()
+	if order != nil {
+		order(peers)
+	}
 
 	sentToExactShard := 0
 	if msgShardId != common.MultiShard && msgShardId != ps.ownShardId {
 		for _, p := range peers {
-			if p.shardId == msgShardId || p.shardId == common.MultiShard {
-				if _, ok := p.msgCache.Get(key); !ok {
-					p.markKeyWithExpiration(key, expiration)
+			if !p.observer && (p.shardId == msgShardId || p.shardId == common.MultiShard) {
+				if !p.knowsMsg(msgcode, payload, key) {
+					p.markMsg(msgcode, payload, key, expiration)
 					p.sendMsg(msgcode, payload, msgShardId, highPriority)
 				}
 				if p.shardId != common.MultiShard {
@@ -128,8 +144,8 @@ func (ps *peerSet) SendWithFilterAndExpiration(msgcode uint64, key string, paylo
 
 	for _, p := range peers {
 		if ps.shouldSendToPeer(p, msgShardId, len(peers), highPriority) {
-			if _, ok := p.msgCache.Get(key); !ok {
-				p.markKeyWithExpiration(key, expiration)
+			if !p.knowsMsg(msgcode, payload, key) {
+				p.markMsg(msgcode, payload, key, expiration)
 				p.sendMsg(msgcode, payload, msgShardId, highPriority)
 			}
 		}
@@ -149,7 +165,7 @@ func (ps *peerSet) hasKey(key string) bool {
 	ps.lock.RLock()
 	defer ps.lock.RUnlock()
 	for _, p := range ps.peers {
-		if _, ok := p.msgCache.Get(key); ok {
+		if p.knowsKey(key) {
 			return true
 		}
 	}
@@ -0,0 +1,498 @@
+package protocol
+
+import (
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/config"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/protocol"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"math"
+	"math/big"
+	"net"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type testConn struct {
+	network.Conn
+	remote peer.ID
+}
+
+func (c *testConn) RemotePeer() peer.ID {
+	return c.remote
+}
+
+func (c *testConn) RemoteMultiaddr() multiaddr.Multiaddr {
+	addr, _ := multiaddr.NewMultiaddr("/ip4/127.0.0.1/tcp/40404")
+	return addr
+}
+
+type testStream struct {
+	network.Stream
+	conn net.Conn
+	c    *testConn
+}
+
+func (s *testStream) Read(p []byte) (int, error) {
+	return s.conn.Read(p)
+}
+
+func (s *testStream) Write(p []byte) (int, error) {
+	return s.conn.Write(p)
+}
+
+func (s *testStream) Close() error {
+	return s.conn.Close()
+}
+
+func (s *testStream) Reset() error {
+	return s.conn.Close()
+}
+
+func (s *testStream) SetReadDeadline(t time.Time) error {
+	return s.conn.SetReadDeadline(t)
+}
+
+func (s *testStream) Protocol() protocol.ID {
+	return IdenaProtocol
+}
+
+func (s *testStream) Conn() network.Conn {
+	return s.c
+}
+
+// newTestStreams creates a pair of connected in-memory streams, the first one is seen as a stream to remote peer and the second one as a stream from local peer
+func newTestStreams(local, remote peer.ID) (network.Stream, network.Stream) {
+	c1, c2 := net.Pipe()
+	return &testStream{conn: c1, c: &testConn{remote: remote}}, &testStream{conn: c2, c: &testConn{remote: local}}
+}
+
+func newTestMetrics() *metricCollector {
+	return &metricCollector{
+		incomeMessage:  func(code uint64, size int, duration time.Duration, peerId string) {},
+		outcomeMessage: func(code uint64, size int, duration time.Duration, peerId string) {},
+		compress:       func(code uint64, size int) {},
+		dropMessage:    func(code uint64) {},
+		rateLimit:      func(code uint64) {},
+	}
+}
+
+// newTestPeer creates a peer connected to in-memory remote peer and returns both of them
+func newTestPeer(id peer.ID) (*protoPeer, *protoPeer) {
+	local, remote := newTestStreams("local", id)
+	return newPeer(local, 0, newTestMetrics(), nil, config.PeerQueueConfig{}), newPeer(remote, 0, newTestMetrics(), nil, config.PeerQueueConfig{})
+}
+
+func TestProtoPeer_Handshake_protocolVersion(t *testing.T) {
+	genesis := &types.GenesisInfo{
+		Genesis: &types.Header{ProposedHeader: &types.ProposedHeader{Height: 1}},
+	}
+	handshake := func(p *protoPeer, minProtocolVersion uint32) chan error {
+		errc := make(chan error, 1)
+		go func() {
+			errc <- p.Handshake(types.Network(1), 10, common.Hash{}, genesis, "0.1.0", 0, 1, handshakeRules{minProtocolVersion: minProtocolVersion})
+		}()
+		return errc
+	}
+
+	local, remote := newTestPeer("peer")
+	localErr, remoteErr := handshake(local, 0), handshake(remote, CurrentProtocolVersion)
+	require.NoError(t, <-localErr)
+	require.NoError(t, <-remoteErr)
+	require.Equal(t, CurrentProtocolVersion, local.protocolVersion)
+	require.True(t, local.Supports(BlockAnnounce))
+	require.True(t, remote.Supports(BlockAnnounce))
+
+	local, remote = newTestPeer("peer")
+	localErr, remoteErr = handshake(local, 0), handshake(remote, CurrentProtocolVersion+1)
+	// the stream is reset by the rejecting side, so the local handshake may fail as well
+	<-localErr
+	err := <-remoteErr
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "protocol version")
+}
+
+func TestSetSupportedFeatures(t *testing.T) {
+	features := []PeerFeature{BlockAnnounce, VoteBatches, PingPong, TxPull, SplitRanges, PoolSync, StateProofs, LightClients, StatusUpdates, FlipRequests, HeaderRequests}
+	for version := legacyProtocolVersion; version <= CurrentProtocolVersion; version++ {
+		p, _ := newTestPeer("peer")
+		p.protocolVersion = version
+		SetSupportedFeatures(p)
+		// features of the versions above the peer's one are disabled, so the lower version is used
+		for i, feature := range features {
+			require.Equal(t, int(version) >= i+2, p.Supports(feature), "version %v, feature %v", version, feature)
+		}
+	}
+}
+
+func TestProtoPeer_Handshake_height(t *testing.T) {
+	genesis := &types.GenesisInfo{
+		Genesis: &types.Header{ProposedHeader: &types.ProposedHeader{Height: 1}},
+	}
+	rules := handshakeRules{maxHeightAhead: 100}
+	handshake := func(remoteHeight uint64) (*protoPeer, error) {
+		local, remote := newTestPeer("peer")
+		localErr, remoteErr := make(chan error, 1), make(chan error, 1)
+		go func() {
+			localErr <- local.Handshake(types.Network(1), 10, common.Hash{}, genesis, "0.1.0", 0, 1, rules)
+		}()
+		go func() {
+			remoteErr <- remote.Handshake(types.Network(1), remoteHeight, common.Hash{0x1}, genesis, "0.1.0", 0, 1, handshakeRules{})
+		}()
+		<-remoteErr
+		return local, <-localErr
+	}
+
+	for _, height := range []uint64{5, 50, 110} {
+		p, err := handshake(height)
+		require.NoError(t, err)
+		require.Equal(t, height, p.knownHeight.Read())
+		require.Equal(t, common.Hash{0x1}, p.HeadHash())
+	}
+	for _, height := range []uint64{111, math.MaxUint64} {
+		p, err := handshake(height)
+		require.Error(t, err)
+		require.Equal(t, errImplausibleHeight, errors.Cause(err))
+		require.Zero(t, p.knownHeight.Read())
+	}
+
+	require.Equal(t, uint64(defaultMaxPeerHeightAhead), newHandshakeRules(config.P2P{}).maxHeightAhead)
+	require.Equal(t, uint64(1000), newHandshakeRules(config.P2P{MaxPeerHeightAhead: 1000}).maxHeightAhead)
+}
+
+func TestProtoPeer_disconnect_writeError(t *testing.T) {
+	p, remote := newTestPeer("peer")
+	go p.broadcast()
+	require.NoError(t, remote.stream.Close())
+
+	p.sendMsg(Vote, &types.Vote{Header: &types.VoteHeader{Round: 1}}, 0, false)
+
+	select {
+	case <-p.term:
+	case <-time.After(time.Second):
+		t.Fatal("term is not signaled")
+	}
+	reason, err := p.LastDisconnectReason()
+	require.Equal(t, DiscWriteError, reason)
+	require.Error(t, err)
+
+	// the first reason is kept
+	p.disconnect(DiscLowScore, nil)
+	reason, _ = p.LastDisconnectReason()
+	require.Equal(t, DiscWriteError, reason)
+}
+
+func TestSyncHeight_Store(t *testing.T) {
+	height := &syncHeight{}
+	wg := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := uint64(0); j < 1000; j++ {
+				height.Store(j*10 + uint64(i))
+				height.Read()
+			}
+		}(i)
+	}
+	wg.Wait()
+	require.Equal(t, uint64(9999), height.Read())
+
+	require.False(t, height.Store(5))
+	require.Equal(t, uint64(9999), height.Read())
+	require.True(t, height.Store(10000))
+}
+
+func TestProtoPeer_setHeight_heightIncreased(t *testing.T) {
+	p, _ := newTestPeer("peer")
+	var updates []uint64
+	p.heightIncreased = func(id peer.ID, height uint64) {
+		require.Equal(t, p.id, id)
+		updates = append(updates, height)
+	}
+
+	p.setHeight(10)
+	p.setHeight(10)
+	p.setHeight(5)
+	p.setHeight(11)
+
+	require.Equal(t, []uint64{10, 11}, updates)
+	require.Equal(t, uint64(11), p.knownHeight.Read())
+}
+
+func TestProtoPeer_Handshake_observer(t *testing.T) {
+	genesis := &types.GenesisInfo{
+		Genesis: &types.Header{ProposedHeader: &types.ProposedHeader{Height: 1}},
+	}
+	handshake := func(p *protoPeer, network types.Network, rules handshakeRules) chan error {
+		errc := make(chan error, 1)
+		go func() {
+			errc <- p.Handshake(network, 10, common.Hash{}, genesis, "0.1.0", 0, 1, rules)
+		}()
+		return errc
+	}
+
+	local, remote := newTestPeer("peer")
+	localErr := handshake(local, 1, handshakeRules{observeNetworks: map[types.Network]struct{}{2: {}}})
+	remoteErr := handshake(remote, 2, handshakeRules{observeNetworks: map[types.Network]struct{}{1: {}}})
+	require.NoError(t, <-localErr)
+	require.NoError(t, <-remoteErr)
+	require.True(t, local.observer)
+	require.True(t, remote.observer)
+
+	h := &IdenaGossipHandler{
+		peers: newPeerSet(),
+	}
+	require.NoError(t, h.peers.Register(local))
+	require.False(t, h.peers.shouldSendToPeer(local, 0, 1, true))
+	require.Empty(t, h.GetKnownHeights())
+
+	local, remote = newTestPeer("peer")
+	localErr = handshake(local, 1, handshakeRules{})
+	remoteErr = handshake(remote, 2, handshakeRules{observeNetworks: map[types.Network]struct{}{1: {}}})
+	err := <-localErr
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "network mismatch")
+	require.False(t, local.observer)
+	<-remoteErr
+}
+
+func TestProtoPeer_Handshake_light(t *testing.T) {
+	genesis := &types.GenesisInfo{
+		Genesis: &types.Header{ProposedHeader: &types.ProposedHeader{Height: 1}},
+	}
+	handshake := func(p *protoPeer, rules handshakeRules) chan error {
+		errc := make(chan error, 1)
+		go func() {
+			errc <- p.Handshake(types.Network(1), 10, common.Hash{}, genesis, "0.1.0", 0, 1, rules)
+		}()
+		return errc
+	}
+
+	local, remote := newTestPeer("peer")
+	localErr := handshake(local, handshakeRules{serveLight: true})
+	remoteErr := handshake(remote, handshakeRules{light: true})
+	require.NoError(t, <-localErr)
+	require.NoError(t, <-remoteErr)
+	require.True(t, local.light)
+	require.True(t, local.observer)
+	require.True(t, remote.servesLight)
+	require.False(t, remote.light)
+	require.False(t, remote.observer)
+
+	local, remote = newTestPeer("peer")
+	localErr = handshake(local, handshakeRules{})
+	remoteErr = handshake(remote, handshakeRules{light: true})
+	err := <-localErr
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "light clients are not served")
+	<-remoteErr
+}
+
+func TestProtoPeer_Handshake_failures(t *testing.T) {
+	genesis := &types.GenesisInfo{
+		Genesis: &types.Header{ProposedHeader: &types.ProposedHeader{Height: 1}},
+	}
+	// background goroutines of libraries may still be starting, so only handshake goroutines are counted
+	handshakeGoroutines := func() int {
+		buf := make([]byte, 1<<20)
+		return strings.Count(string(buf[:runtime.Stack(buf, true)]), "(*protoPeer).Handshake")
+	}
+
+	// both the write and the read fail since the remote side is closed
+	local, remote := newTestPeer("peer")
+	require.NoError(t, remote.stream.Close())
+	require.Error(t, local.Handshake(types.Network(1), 10, common.Hash{}, genesis, "0.1.0", 0, 1, handshakeRules{}))
+
+	// the read fails while the write is blocked because the remote side never reads
+	local, remote = newTestPeer("peer")
+	go remote.rw.WriteMsg(makeMsg(Handshake, &handshakeData{
+		NetworkId:       types.Network(2),
+		GenesisBlock:    genesis.Genesis.Hash(),
+		Timestamp:       time.Now().UTC().Unix(),
+		ProtocolVersion: CurrentProtocolVersion,
+	}, 0))
+	err := local.Handshake(types.Network(1), 10, common.Hash{}, genesis, "0.1.0", 0, 1, handshakeRules{})
+	require.Error(t, err)
+	require.NoError(t, remote.stream.Close())
+
+	require.Eventually(t, func() bool {
+		return handshakeGoroutines() == 0
+	}, time.Second, time.Millisecond*10)
+}
+
+func TestProtoPeer_broadcast_consensusFirst(t *testing.T) {
+	p, remote := newTestPeer("peer")
+	for i := 0; i < queuedRequestsSize; i++ {
+		p.sendMsg(NewTx, &types.Transaction{AccountNonce: uint32(i), Amount: big.NewInt(1)}, 0, false)
+	}
+	require.Len(t, p.queuedRequests, queuedRequestsSize)
+	vote := &types.Vote{Header: &types.VoteHeader{Round: 10}}
+	p.sendMsg(Vote, vote, 0, false)
+	go p.broadcast()
+
+	require.NoError(t, remote.stream.SetReadDeadline(time.Now().Add(time.Second)))
+	msg, err := remote.ReadMsg()
+	require.NoError(t, err)
+	require.Equal(t, uint64(Vote), msg.Code)
+	msg, err = remote.ReadMsg()
+	require.NoError(t, err)
+	require.Equal(t, uint64(NewTx), msg.Code)
+}
+
+func TestProtoPeer_broadcast_sendLimiter(t *testing.T) {
+	p, remote := newTestPeer("peer")
+	p.sendLimiter = newByteLimiter(100)
+	// the bucket is drained for the next 100 seconds
+	p.sendLimiter.reserve(10000)
+	p.sendMsg(NewTx, &types.Transaction{Amount: big.NewInt(1)}, 0, false)
+	p.sendMsg(Vote, &types.Vote{Header: &types.VoteHeader{Round: 10}}, 0, false)
+	go p.broadcast()
+
+	require.NoError(t, remote.stream.SetReadDeadline(time.Now().Add(time.Second)))
+	msg, err := remote.ReadMsg()
+	require.NoError(t, err)
+	require.Equal(t, uint64(Vote), msg.Code)
+
+	require.NoError(t, remote.stream.SetReadDeadline(time.Now().Add(time.Millisecond*200)))
+	_, err = remote.ReadMsg()
+	require.Error(t, err)
+}
+
+func TestProtoPeer_sendMsg_consensusPushes(t *testing.T) {
+	p, _ := newTestPeer("peer")
+	p.protocolVersion = CurrentProtocolVersion
+	SetSupportedFeatures(p)
+
+	p.sendMsg(Push, pushPullHash{Type: pushTx}, 0, false)
+	p.sendMsg(Push, pushPullHash{Type: pushFlip}, 0, false)
+	require.Len(t, p.pushQueue, 2)
+	require.Empty(t, p.consensusRequests)
+
+	for _, typ := range []pushType{pushVote, pushProof, pushBlock} {
+		p.sendMsg(Push, pushPullHash{Type: typ}, 0, false)
+	}
+	p.sendMsg(ProposeBlock, &types.BlockProposal{}, 0, false)
+	require.Len(t, p.pushQueue, 2)
+	require.Len(t, p.consensusRequests, 4)
+	require.Empty(t, p.queuedRequests)
+}
+
+func TestProtoPeer_sendMsg_consensusQueueFull(t *testing.T) {
+	p, _ := newTestPeer("peer")
+	for i := 0; i < cap(p.consensusRequests); i++ {
+		p.sendMsg(ProposeProof, &types.ProofProposal{Round: uint64(i)}, 0, false)
+	}
+	start := time.Now()
+	p.sendMsg(ProposeProof, &types.ProofProposal{Round: 1000}, 0, false)
+	require.Less(t, int64(time.Since(start)), int64(time.Second))
+	require.Equal(t, uint64(1), p.Snapshot().DroppedMessages)
+	require.False(t, p.discRecorded)
+}
+
+func TestProtoPeer_Close_drain(t *testing.T) {
+	p, remote := newTestPeer("peer")
+	const count = 5
+	for i := 0; i < count; i++ {
+		p.sendMsg(NewTx, &types.Transaction{AccountNonce: uint32(i), Amount: big.NewInt(1)}, 0, false)
+	}
+	received := make(chan int)
+	go func() {
+		cnt := 0
+		for {
+			msg, err := remote.ReadMsg()
+			if err != nil {
+				break
+			}
+			if msg.Code == NewTx {
+				cnt++
+			}
+		}
+		received <- cnt
+	}()
+	go p.broadcast()
+
+	p.Close(defaultDrainTimeout)
+	p.sendMsg(NewTx, &types.Transaction{AccountNonce: count, Amount: big.NewInt(1)}, 0, false)
+
+	select {
+	case <-p.finished:
+	default:
+		t.Fatal("broadcast loop is not finished")
+	}
+	require.Equal(t, count, <-received)
+	require.Empty(t, p.queuedRequests)
+}
+
+func TestProtoPeer_Close(t *testing.T) {
+	p, _ := newTestPeer("peer")
+	for i := 0; i < 100; i++ {
+		p.sendMsg(NewTx, &types.Transaction{AccountNonce: uint32(i), Amount: big.NewInt(1)}, 0, false)
+	}
+	go p.broadcast()
+
+	start := time.Now()
+	p.Close(0)
+	select {
+	case <-p.finished:
+	case <-time.After(time.Second):
+		t.Fatal("broadcast loop is not finished")
+	}
+	require.Less(t, time.Since(start), time.Second)
+	require.NotEmpty(t, p.queuedRequests)
+}
+
+func TestProtoPeer_disconnect_reason(t *testing.T) {
+	p, remote := newTestPeer("peer")
+	go p.disconnect(DiscLowScore, nil)
+
+	require.NoError(t, remote.stream.SetReadDeadline(time.Now().Add(time.Second)))
+	msg, err := remote.ReadMsg()
+	require.NoError(t, err)
+	require.Equal(t, uint64(Disconnect), msg.Code)
+	dc := new(disconnect)
+	require.NoError(t, dc.FromBytes(msg.Payload))
+	require.Equal(t, DiscLowScore.String(), dc.Reason)
+
+	// nobody reads the stream
+	stuck, _ := newTestPeer("stuck")
+	start := time.Now()
+	stuck.disconnect(DiscLowScore, nil)
+	require.Less(t, time.Since(start), disconnectWriteTimeout*2)
+}
+
+func TestNewPeer_queueSizes(t *testing.T) {
+	p, _ := newTestPeer("peer")
+	require.Equal(t, queuedRequestsSize, cap(p.queuedRequests))
+	require.Equal(t, queuedHighPriorityRequestsSize, cap(p.highPriorityRequests))
+	require.Equal(t, queuedConsensusRequestsSize, cap(p.consensusRequests))
+	require.Equal(t, pushQueueSize, cap(p.pushQueue))
+	require.Equal(t, flipKeyQueueSize, cap(p.flipKeyQueue))
+	require.Equal(t, voteQueueSize, cap(p.voteQueue))
+	require.Equal(t, blockRangeQueueSize, p.blockRanges.maxDepth)
+
+	stream, _ := newTestStreams("local", "peer")
+	p = newPeer(stream, 0, newTestMetrics(), nil, config.PeerQueueConfig{
+		Requests:             10,
+		HighPriorityRequests: 20,
+		ConsensusRequests:    30,
+		Pushes:               40,
+		FlipKeys:             -1,
+		Votes:                60,
+		BlockRanges:          70,
+	})
+	require.Equal(t, 10, cap(p.queuedRequests))
+	require.Equal(t, 20, cap(p.highPriorityRequests))
+	require.Equal(t, 30, cap(p.consensusRequests))
+	require.Equal(t, 40, cap(p.pushQueue))
+	require.Equal(t, flipKeyQueueSize, cap(p.flipKeyQueue))
+	require.Equal(t, 60, cap(p.voteQueue))
+	require.Equal(t, 70, p.blockRanges.maxDepth)
+}
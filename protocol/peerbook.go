@@ -0,0 +1,305 @@
+package protocol
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/idena-network/idena-go/log"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/peerstore"
+	"github.com/multiformats/go-multiaddr"
+	"io/ioutil"
+	"math"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	maxKnownPeers = 200
+	// knownPeerHalfLife is the time after which the score of a saved peer counts half when the best peers are selected
+	knownPeerHalfLife = time.Hour * 24
+	// knownPeerMaxAge is the time after which a peer which wasn't seen is forgotten
+	knownPeerMaxAge          = time.Hour * 24 * 7
+	knownPeersDialCount      = 10
+	knownPeersSaveInterval   = time.Minute * 5
+	knownPeerConnectTimeout  = time.Second * 15
+	knownPeerIdentifyTimeout = time.Second
+	// knownPeerPreferredTimeout limits the dial of the fastest address before other addresses of the peer are dialed
+	knownPeerPreferredTimeout = time.Second * 5
+)
+
+type knownPeer struct {
+	Id       string    `json:"id"`
+	Addrs    []string  `json:"addrs"`
+	LastSeen time.Time `json:"lastSeen"`
+	Score    int32     `json:"score"`
+	// Latencies are measured over connections made to the listed addresses
+	Latencies map[string]time.Duration `json:"latencies,omitempty"`
+}
+
+// peerBook keeps addresses and scores of peers which were connected, if path is set the book is saved to the file,
+// so the node dials the best of them right after the restart instead of waiting for the discovery
+type peerBook struct {
+	entries map[peer.ID]*knownPeer
+	now     func() time.Time
+	path    string
+	mutex   sync.Mutex
+}
+
+func newPeerBook() *peerBook {
+	return &peerBook{
+		entries: make(map[peer.ID]*knownPeer),
+		now:     time.Now,
+	}
+}
+
+// rank decreases the score of the peer with the time since it was seen, a positive score halves every knownPeerHalfLife
+func (b *peerBook) rank(p *knownPeer) float64 {
+	score := float64(p.Score)
+	if score <= 0 {
+		return score
+	}
+	age := b.now().Sub(p.LastSeen)
+	if age <= 0 {
+		return score
+	}
+	return score * math.Pow(0.5, float64(age)/float64(knownPeerHalfLife))
+}
+
+func (b *peerBook) stale(p *knownPeer) bool {
+	return b.now().Sub(p.LastSeen) >= knownPeerMaxAge
+}
+
+// Seen records the peer with its current score, addresses are kept from the previous record if none are passed
+func (b *peerBook) Seen(id peer.ID, addrs []multiaddr.Multiaddr, score int32) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	entry, ok := b.entries[id]
+	if !ok {
+		entry = &knownPeer{Id: id.Pretty()}
+	}
+	if len(addrs) > 0 {
+		entry.Addrs = entry.Addrs[:0]
+		listed := make(map[string]time.Duration)
+		for _, addr := range addrs {
+			s := addr.String()
+			entry.Addrs = append(entry.Addrs, s)
+			if latency, ok := entry.Latencies[s]; ok {
+				listed[s] = latency
+			}
+		}
+		entry.Latencies = nil
+		if len(listed) > 0 {
+			entry.Latencies = listed
+		}
+	}
+	if len(entry.Addrs) == 0 {
+		return
+	}
+	entry.LastSeen = b.now()
+	entry.Score = score
+	b.entries[id] = entry
+	b.trim()
+}
+
+// Measured records the latency of the connection made to the address, it's kept only if the address is listed for the peer
+func (b *peerBook) Measured(id peer.ID, addr multiaddr.Multiaddr, latency time.Duration) {
+	if addr == nil || latency <= 0 {
+		return
+	}
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	entry, ok := b.entries[id]
+	if !ok {
+		return
+	}
+	s := addr.String()
+	for _, listed := range entry.Addrs {
+		if listed == s {
+			if entry.Latencies == nil {
+				entry.Latencies = make(map[string]time.Duration)
+			}
+			entry.Latencies[s] = latency
+			return
+		}
+	}
+}
+
+func (b *peerBook) Remove(id peer.ID) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	delete(b.entries, id)
+}
+
+// trim drops stale peers and the lowest ranked ones above maxKnownPeers, the caller must hold the mutex
+func (b *peerBook) trim() {
+	for id, entry := range b.entries {
+		if b.stale(entry) {
+			delete(b.entries, id)
+		}
+	}
+	if len(b.entries) <= maxKnownPeers {
+		return
+	}
+	for _, id := range b.sorted()[maxKnownPeers:] {
+		delete(b.entries, id)
+	}
+}
+
+// sorted returns ids of peers from the best ranked to the worst one, the caller must hold the mutex
+func (b *peerBook) sorted() []peer.ID {
+	ids := make([]peer.ID, 0, len(b.entries))
+	for id := range b.entries {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		ri, rj := b.rank(b.entries[ids[i]]), b.rank(b.entries[ids[j]])
+		if ri != rj {
+			return ri > rj
+		}
+		return b.entries[ids[i]].LastSeen.After(b.entries[ids[j]].LastSeen)
+	})
+	return ids
+}
+
+// Best returns up to count peers which are not stale with their addresses, the best ranked first. Addresses with
+// the lowest measured latency go first
+func (b *peerBook) Best(count int) []peer.AddrInfo {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	var result []peer.AddrInfo
+	for _, id := range b.sorted() {
+		if len(result) == count {
+			break
+		}
+		entry := b.entries[id]
+		if b.stale(entry) {
+			continue
+		}
+		info := peer.AddrInfo{ID: id}
+		for _, s := range entry.Addrs {
+			if addr, err := multiaddr.NewMultiaddr(s); err == nil {
+				info.Addrs = append(info.Addrs, addr)
+			}
+		}
+		if len(info.Addrs) > 0 {
+			sortAddrsByLatency(info.Addrs, entry.Latencies)
+			result = append(result, info)
+		}
+	}
+	return result
+}
+
+// restore loads peers which are not stale from the file, further saves go to it
+func (b *peerBook) restore(path string) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.path = path
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return
+	}
+	var peers []*knownPeer
+	if err := json.Unmarshal(data, &peers); err != nil {
+		log.Warn("cannot parse known peers", "path", path, "err", err)
+		return
+	}
+	for _, p := range peers {
+		id, err := peer.Decode(p.Id)
+		if err != nil || b.stale(p) {
+			continue
+		}
+		b.entries[id] = p
+	}
+	b.trim()
+}
+
+// Save writes the book to the file if the path is set
+func (b *peerBook) Save() error {
+	b.mutex.Lock()
+	if b.path == "" {
+		b.mutex.Unlock()
+		return nil
+	}
+	b.trim()
+	peers := make([]*knownPeer, 0, len(b.entries))
+	for _, id := range b.sorted() {
+		peers = append(peers, b.entries[id])
+	}
+	data, err := json.Marshal(peers)
+	path := b.path
+	b.mutex.Unlock()
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0600)
+}
+
+// recordPeer saves the listen addresses reported by the peer and its score, light clients and peers which are banned
+// or have a score below the minimum are removed from the book
+func (h *IdenaGossipHandler) recordPeer(p *protoPeer) {
+	if p.light {
+		return
+	}
+	if h.connManager.IsBanned(p.id) || p.Score() < int32(h.cfg.MinPeerScore) {
+		h.knownPeers.Remove(p.id)
+		return
+	}
+	h.knownPeers.Seen(p.id, h.host.Peerstore().Addrs(p.id), p.Score())
+	h.knownPeers.Measured(p.id, p.stream.Conn().RemoteMultiaddr(), p.Latency())
+}
+
+func (h *IdenaGossipHandler) saveKnownPeers() {
+	for _, p := range h.peers.Peers() {
+		h.recordPeer(p)
+	}
+	if err := h.knownPeers.Save(); err != nil {
+		h.log.Warn("Failed to save known peers", "err", err)
+	}
+}
+
+// dialKnownPeers connects to the best peers saved before the restart, the discovery keeps filling free slots later
+func (h *IdenaGossipHandler) dialKnownPeers() {
+	count := knownPeersDialCount
+	if h.cfg.MaxOutboundPeers > 0 && h.cfg.MaxOutboundPeers < count {
+		count = h.cfg.MaxOutboundPeers
+	}
+	for _, info := range h.knownPeers.Best(count) {
+		if atomic.LoadUint32(&h.stopped) == 1 || !h.connManager.CanDial() {
+			return
+		}
+		if info.ID == h.host.ID() || h.connManager.IsBanned(info.ID) || h.peers.Peer(info.ID) != nil {
+			continue
+		}
+		go func(info peer.AddrInfo) {
+			err := h.connectKnownPeer(info)
+			if err == nil {
+				// the protocol list of the peer is filled by the identify service shortly after the connection
+				time.Sleep(knownPeerIdentifyTimeout)
+				err = h.dialPeer(info.ID)
+			}
+			if err != nil {
+				h.log.Debug("Failed to dial known peer", "id", info.ID.Pretty(), "err", err)
+			}
+		}(info)
+	}
+}
+
+// connectKnownPeer dials the first address of the peer alone, it's the fastest one if latencies are measured, so the
+// connection isn't made over a slower address which answers first. Other addresses are dialed if it fails
+func (h *IdenaGossipHandler) connectKnownPeer(info peer.AddrInfo) error {
+	connect := func(addrs []multiaddr.Multiaddr, timeout time.Duration) error {
+		h.host.Peerstore().AddAddrs(info.ID, addrs, peerstore.AddressTTL)
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		return h.host.Connect(ctx, h.host.Peerstore().PeerInfo(info.ID))
+	}
+	if len(info.Addrs) == 1 {
+		return connect(info.Addrs, knownPeerConnectTimeout)
+	}
+	if err := connect(info.Addrs[:1], knownPeerPreferredTimeout); err == nil {
+		return nil
+	}
+	return connect(info.Addrs[1:], knownPeerConnectTimeout)
+}
@@ -0,0 +1,104 @@
+package protocol
+
+import (
+	"fmt"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/require"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func testAddrs(port int) []multiaddr.Multiaddr {
+	return []multiaddr.Multiaddr{multiaddr.StringCast(fmt.Sprintf("/ip4/10.0.0.1/tcp/%d", port))}
+}
+
+func TestPeerBook_Best(t *testing.T) {
+	book := newPeerBook()
+	now := time.Unix(0, 0)
+	book.now = func() time.Time {
+		return now
+	}
+
+	book.Seen("old", testAddrs(1), 40)
+	now = now.Add(knownPeerHalfLife * 2)
+	book.Seen("fresh", testAddrs(2), 20)
+	book.Seen("penalized", testAddrs(3), -5)
+	book.Seen("noaddrs", nil, 100)
+
+	// the score of the old peer counts a quarter, so the fresh one goes first
+	best := book.Best(10)
+	require.Len(t, best, 3)
+	require.Equal(t, []peer.ID{"fresh", "old", "penalized"}, []peer.ID{best[0].ID, best[1].ID, best[2].ID})
+	require.Equal(t, testAddrs(2), best[0].Addrs)
+	require.Len(t, book.Best(1), 1)
+
+	// addresses are kept if the peer reports none
+	book.Seen("fresh", nil, 30)
+	require.Equal(t, testAddrs(2), book.Best(1)[0].Addrs)
+
+	book.Remove("fresh")
+	now = now.Add(knownPeerMaxAge - knownPeerHalfLife*2)
+	best = book.Best(10)
+	require.Len(t, best, 1)
+	require.Equal(t, peer.ID("penalized"), best[0].ID)
+
+	for i := 0; i < maxKnownPeers+10; i++ {
+		book.Seen(peer.ID(fmt.Sprint(i)), testAddrs(i), int32(i))
+	}
+	require.Len(t, book.entries, maxKnownPeers)
+	require.Equal(t, peer.ID(fmt.Sprint(maxKnownPeers+9)), book.Best(1)[0].ID)
+}
+
+func TestPeerBook_Measured(t *testing.T) {
+	book := newPeerBook()
+	ip4 := multiaddr.StringCast("/ip4/10.0.0.1/tcp/40404")
+	ip6 := multiaddr.StringCast("/ip6/2001:db8::1/tcp/40404")
+	book.Seen("peer", []multiaddr.Multiaddr{ip4, ip6}, 10)
+
+	// latencies of unknown peers and not listed addresses are not recorded
+	book.Measured("unknown", ip6, time.Millisecond)
+	book.Measured("peer", multiaddr.StringCast("/ip4/10.0.0.2/tcp/40404"), time.Millisecond)
+	require.Empty(t, book.entries["peer"].Latencies)
+	require.Equal(t, []multiaddr.Multiaddr{ip4, ip6}, book.Best(1)[0].Addrs)
+
+	// the fastest address goes first
+	book.Measured("peer", ip4, time.Millisecond*50)
+	book.Measured("peer", ip6, time.Millisecond*10)
+	require.Equal(t, []multiaddr.Multiaddr{ip6, ip4}, book.Best(1)[0].Addrs)
+
+	// latencies of removed addresses are dropped
+	book.Seen("peer", []multiaddr.Multiaddr{ip4}, 10)
+	require.Equal(t, map[string]time.Duration{ip4.String(): time.Millisecond * 50}, book.entries["peer"].Latencies)
+}
+
+func TestPeerBook_restore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "peers.json")
+	stale, _ := peer.Decode("QmNYWtiwM1UfeCmHfWSdefrMuQdg6nycY5yS64HYqWCUhD")
+	good, _ := peer.Decode("QmQHYY49pWWFeXXdR9rKd31bHRqRi2E4tk4CXDgYJZq5ry")
+	now := time.Unix(0, 0)
+
+	book := newPeerBook()
+	book.now = func() time.Time {
+		return now
+	}
+	book.restore(path)
+	require.Empty(t, book.entries)
+	book.Seen(stale, testAddrs(1), 50)
+	now = now.Add(time.Hour)
+	book.Seen(good, testAddrs(2), 10)
+	require.NoError(t, book.Save())
+
+	now = now.Add(knownPeerMaxAge - time.Minute)
+	restored := newPeerBook()
+	restored.now = book.now
+	restored.restore(path)
+	best := restored.Best(10)
+	require.Len(t, best, 1)
+	require.Equal(t, good, best[0].ID)
+	require.Equal(t, testAddrs(2), best[0].Addrs)
+
+	// the book is saved only if the file is set
+	require.NoError(t, newPeerBook().Save())
+}
@@ -0,0 +1,122 @@
+package protocol
+
+import (
+	"github.com/idena-network/idena-go/common"
+	models "github.com/idena-network/idena-go/protobuf"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"math/rand"
+	"sort"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	defaultPingInterval      = 15 * time.Second
+	defaultPingMissThreshold = 3
+	// weight of the latest round trip time in the latency estimate
+	latencySmoothing = 0.2
+)
+
+// Latency returns exponentially weighted round trip time, zero means that it hasn't been measured yet
+func (p *protoPeer) Latency() time.Duration {
+	return time.Duration(atomic.LoadInt64(&p.latency))
+}
+
+func (p *protoPeer) LatencyMs() int64 {
+	return p.Latency().Milliseconds()
+}
+
+func (p *protoPeer) updateLatency(rtt time.Duration) {
+	if rtt <= 0 {
+		rtt = 1
+	}
+	for {
+		prev := atomic.LoadInt64(&p.latency)
+		next := int64(rtt)
+		if prev > 0 {
+			next = int64(latencySmoothing*float64(rtt) + (1-latencySmoothing)*float64(prev))
+		}
+		if atomic.CompareAndSwapInt64(&p.latency, prev, next) {
+			return
+		}
+	}
+}
+
+func (h *IdenaGossipHandler) pingLoop(p *protoPeer) {
+	interval := h.cfg.PingInterval
+	if interval <= 0 {
+		interval = defaultPingInterval
+	}
+	missThreshold := h.cfg.PingMissThreshold
+	if missThreshold <= 0 {
+		missThreshold = defaultPingMissThreshold
+	}
+	timeout := handshakeTimeout
+	if timeout > interval {
+		timeout = interval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	missed := 0
+	for {
+		select {
+		case <-ticker.C:
+			if p.ping(timeout) {
+				missed = 0
+				continue
+			}
+			missed++
+			if missed >= missThreshold {
+				p.disconnect(DiscPingTimeout, nil)
+				return
+			}
+		case <-p.term:
+			return
+		}
+	}
+}
+
+// ping sends a ping to the peer and waits for the pong, measured round trip time updates the peer latency estimate
+func (p *protoPeer) ping(timeout time.Duration) bool {
+	nonce := rand.Uint64()
+	startTime := time.Now()
+	p.sendMsg(Ping, &models.ProtoPing{Nonce: nonce}, common.MultiShard, true)
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for {
+		select {
+		case pong := <-p.pongs:
+			if pong != nonce {
+				continue
+			}
+			p.updateLatency(time.Since(startTime))
+			return true
+		case <-timer.C:
+			p.log.Debug("Ping timeout", "height", p.knownHeight.Read())
+			return false
+		case <-p.term:
+			return true
+		}
+	}
+}
+
+// sortPeersByLatency orders peers by measured latency, peers without measured latency go last
+func (h *IdenaGossipHandler) sortPeersByLatency(ids []peer.ID) {
+	latencies := make(map[peer.ID]time.Duration, len(ids))
+	for _, id := range ids {
+		if p := h.peers.Peer(id); p != nil {
+			latencies[id] = p.Latency()
+		}
+	}
+	sort.SliceStable(ids, func(i, j int) bool {
+		return lowerLatency(latencies[ids[i]], latencies[ids[j]])
+	})
+}
+
+// lowerLatency reports whether latency a is better than b, unmeasured (zero) latency is the worst one
+func lowerLatency(a, b time.Duration) bool {
+	if a == 0 || b == 0 {
+		return b == 0 && a > 0
+	}
+	return a < b
+}
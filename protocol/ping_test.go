@@ -0,0 +1,143 @@
+package protocol
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/config"
+	models "github.com/idena-network/idena-go/protobuf"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestProtoPeer_ping(t *testing.T) {
+	h := &IdenaGossipHandler{
+		peers: newPeerSet(),
+	}
+	p, remote := newTestPeer("peer")
+	go p.broadcast()
+	go remote.broadcast()
+	for _, peer := range []*protoPeer{p, remote} {
+		go func(peer *protoPeer) {
+			for h.handle(peer) == nil {
+			}
+		}(peer)
+	}
+
+	require.True(t, p.ping(time.Second))
+	require.True(t, p.Latency() > 0)
+}
+
+func TestProtoPeer_ping_timeout(t *testing.T) {
+	p, remote := newTestPeer("peer")
+	go p.broadcast()
+	go func() {
+		// the remote peer reads messages but never answers
+		for {
+			if _, err := remote.ReadMsg(); err != nil {
+				return
+			}
+		}
+	}()
+
+	require.False(t, p.ping(time.Millisecond*100))
+	require.Zero(t, p.Latency())
+}
+
+// echoPongs answers pings of the remote side and returns after the given number of pings
+func echoPongs(remote *protoPeer, pings int) {
+	for i := 0; i < pings; i++ {
+		msg, err := remote.ReadMsg()
+		if err != nil {
+			return
+		}
+		ping := new(models.ProtoPing)
+		if msg.Code != Ping || proto.Unmarshal(msg.Payload, ping) != nil {
+			continue
+		}
+		remote.sendMsg(Pong, ping, common.MultiShard, true)
+	}
+}
+
+func TestProtoPeer_Latency(t *testing.T) {
+	p, remote := newTestPeer("peer")
+	go p.broadcast()
+	go remote.broadcast()
+	go echoPongs(remote, 1)
+	go func() {
+		for {
+			msg, err := p.ReadMsg()
+			if err != nil {
+				return
+			}
+			if msg.Code == Pong {
+				pong := new(models.ProtoPing)
+				if proto.Unmarshal(msg.Payload, pong) == nil {
+					p.pongs <- pong.Nonce
+				}
+			}
+		}
+	}()
+
+	require.Zero(t, p.Latency())
+	require.True(t, p.ping(time.Second))
+	require.NotZero(t, p.Latency())
+
+	p.latency = int64(100 * time.Millisecond)
+	p.updateLatency(200 * time.Millisecond)
+	require.InDelta(t, float64(120*time.Millisecond), float64(p.Latency()), float64(time.Microsecond))
+}
+
+func TestIdenaGossipHandler_pingLoop_missedPongs(t *testing.T) {
+	h := &IdenaGossipHandler{
+		cfg: config.P2P{PingInterval: time.Millisecond * 50, PingMissThreshold: 2},
+	}
+	p, remote := newTestPeer("peer")
+	go p.broadcast()
+	go func() {
+		// the remote peer reads messages but never answers
+		for {
+			if _, err := remote.ReadMsg(); err != nil {
+				return
+			}
+		}
+	}()
+
+	done := make(chan struct{})
+	go func() {
+		h.pingLoop(p)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second * 2):
+		t.Fatal("peer with missed pongs is not disconnected")
+	}
+	reason, _ := p.LastDisconnectReason()
+	require.Equal(t, DiscPingTimeout, reason)
+}
+
+func TestIdenaGossipHandler_sortPeersByLatency(t *testing.T) {
+	h := &IdenaGossipHandler{
+		peers: newPeerSet(),
+	}
+	latencies := map[peer.ID]time.Duration{
+		"slow":       300 * time.Millisecond,
+		"fast":       10 * time.Millisecond,
+		"unmeasured": 0,
+		"medium":     50 * time.Millisecond,
+	}
+	var ids []peer.ID
+	for id, latency := range latencies {
+		p, _ := newTestPeer(id)
+		p.latency = int64(latency)
+		require.NoError(t, h.peers.Register(p))
+		ids = append(ids, id)
+	}
+
+	h.sortPeersByLatency(ids)
+
+	require.Equal(t, []peer.ID{"fast", "medium", "slow", "unmeasured"}, ids)
+}
@@ -0,0 +1,148 @@
+package protocol
+
+import (
+	"context"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/peerstore"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/pkg/errors"
+	"time"
+)
+
+// Pinned peers are trusted and static ones, they bypass peer limits and are redialed once disconnected.
+// Trusted peers are additionally never penalized or banned.
+
+func (h *IdenaGossipHandler) isStatic(id peer.ID) bool {
+	h.pinnedMutex.RLock()
+	defer h.pinnedMutex.RUnlock()
+	_, ok := h.staticPeers[id]
+	return ok
+}
+
+func (h *IdenaGossipHandler) isPinned(id peer.ID) bool {
+	return h.isTrusted(id) || h.isStatic(id)
+}
+
+// parsePeerUrl splits a multiaddr with a peer id, the address part is optional
+func parsePeerUrl(url string) (peer.ID, multiaddr.Multiaddr, error) {
+	if id, err := peer.Decode(url); err == nil {
+		return id, nil, nil
+	}
+	ma, err := multiaddr.NewMultiaddr(url)
+	if err != nil {
+		return "", nil, err
+	}
+	transportAddr, peerId := peer.SplitAddr(ma)
+	if peerId == "" {
+		return "", nil, errors.New("invalid url")
+	}
+	return peerId, transportAddr, nil
+}
+
+func (h *IdenaGossipHandler) connectStaticPeers(urls []string) {
+	for _, url := range urls {
+		if err := h.AddStaticPeer(url); err != nil {
+			h.log.Warn("Failed to add static peer", "url", url, "err", err)
+		}
+	}
+}
+
+// AddStaticPeer makes the peer static and connects to it, the connection is restored each time it's dropped
+func (h *IdenaGossipHandler) AddStaticPeer(url string) error {
+	id, addr, err := parsePeerUrl(url)
+	if err != nil {
+		return err
+	}
+	if addr == nil {
+		return errors.New("invalid url")
+	}
+	h.host.Peerstore().AddAddr(id, addr, peerstore.PermanentAddrTTL)
+
+	h.pinnedMutex.Lock()
+	if h.staticPeers == nil {
+		h.staticPeers = make(map[peer.ID]struct{})
+	}
+	h.staticPeers[id] = struct{}{}
+	h.pinnedMutex.Unlock()
+
+	return h.connectPinnedPeer(id)
+}
+
+// AddTrustedPeer marks the peer as trusted, the url may be a bare peer id if the peer address is known
+func (h *IdenaGossipHandler) AddTrustedPeer(url string) error {
+	id, addr, err := parsePeerUrl(url)
+	if err != nil {
+		return err
+	}
+	if addr != nil {
+		h.host.Peerstore().AddAddr(id, addr, peerstore.PermanentAddrTTL)
+	}
+
+	h.pinnedMutex.Lock()
+	if h.trustedPeers == nil {
+		h.trustedPeers = make(map[peer.ID]struct{})
+	}
+	h.trustedPeers[id] = struct{}{}
+	h.pinnedMutex.Unlock()
+
+	if addr == nil && len(h.host.Peerstore().Addrs(id)) == 0 {
+		return nil
+	}
+	return h.connectPinnedPeer(id)
+}
+
+// RemovePeer drops the peer from static and trusted ones and disconnects it
+func (h *IdenaGossipHandler) RemovePeer(url string) error {
+	id, _, err := parsePeerUrl(url)
+	if err != nil {
+		return err
+	}
+	h.pinnedMutex.Lock()
+	delete(h.staticPeers, id)
+	delete(h.trustedPeers, id)
+	h.pinnedMutex.Unlock()
+
+	if h.reconnects != nil {
+		h.reconnects.Reset(id)
+	}
+	if p := h.peers.Peer(id); p != nil {
+		go p.disconnect(DiscRemoved, nil)
+	}
+	return nil
+}
+
+// DisconnectPeer drops the connection with the peer, unlike RemovePeer it keeps static and trusted peers pinned,
+// so they are reconnected later
+func (h *IdenaGossipHandler) DisconnectPeer(url string) error {
+	id, _, err := parsePeerUrl(url)
+	if err != nil {
+		return err
+	}
+	p := h.peers.Peer(id)
+	if p == nil {
+		return errors.New("peer is not connected")
+	}
+	go p.disconnect(DiscRequested, nil)
+	return nil
+}
+
+// connectPinnedPeer dials the peer in place, further attempts are scheduled if it fails
+func (h *IdenaGossipHandler) connectPinnedPeer(id peer.ID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), pinnedPeerConnectTimeout)
+	err := h.host.Connect(ctx, h.host.Peerstore().PeerInfo(id))
+	cancel()
+	if err != nil {
+		if h.reconnects != nil {
+			h.reconnects.Schedule(id)
+		}
+		return err
+	}
+	go func() {
+		// the protocol list of the peer is filled by the identify service shortly after the connection
+		time.Sleep(time.Second)
+		if err := h.dialPeer(id); err != nil && h.reconnects != nil {
+			h.reconnects.Schedule(id)
+		}
+	}()
+	return nil
+}
@@ -0,0 +1,52 @@
+package protocol
+
+import (
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestParsePeerUrl(t *testing.T) {
+	const id = "QmcgpsyWgH8Y8ajJz1Cu72KnS5uo2Aa2LpzU7kinSupNKC"
+	expected, err := peer.Decode(id)
+	require.NoError(t, err)
+
+	parsed, addr, err := parsePeerUrl(id)
+	require.NoError(t, err)
+	require.Equal(t, expected, parsed)
+	require.Nil(t, addr)
+
+	parsed, addr, err = parsePeerUrl("/ip4/127.0.0.1/tcp/40405/ipfs/" + id)
+	require.NoError(t, err)
+	require.Equal(t, expected, parsed)
+	require.Equal(t, "/ip4/127.0.0.1/tcp/40405", addr.String())
+
+	_, _, err = parsePeerUrl("/ip4/127.0.0.1/tcp/40405")
+	require.Error(t, err)
+}
+
+func TestIdenaGossipHandler_RemovePeer(t *testing.T) {
+	const id = "QmcgpsyWgH8Y8ajJz1Cu72KnS5uo2Aa2LpzU7kinSupNKC"
+	peerId, _ := peer.Decode(id)
+	h := &IdenaGossipHandler{
+		peers:        newPeerSet(),
+		trustedPeers: map[peer.ID]struct{}{peerId: {}},
+		staticPeers:  map[peer.ID]struct{}{peerId: {}, "other": {}},
+	}
+	p, remote := newTestPeer(peerId)
+	require.NoError(t, h.peers.Register(p))
+	require.True(t, h.isPinned(peerId))
+
+	require.NoError(t, h.RemovePeer(id))
+	require.False(t, h.isTrusted(peerId))
+	require.False(t, h.isPinned(peerId))
+	require.True(t, h.isStatic("other"))
+
+	require.NoError(t, remote.stream.SetReadDeadline(time.Now().Add(time.Second)))
+	msg, err := remote.ReadMsg()
+	require.NoError(t, err)
+	require.Equal(t, uint64(Disconnect), msg.Code)
+	reason, _ := p.LastDisconnectReason()
+	require.Equal(t, DiscRemoved, reason)
+}
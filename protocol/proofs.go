@@ -0,0 +1,42 @@
+package protocol
+
+import (
+	"github.com/idena-network/idena-go/blockchain/types"
+)
+
+const (
+	proofWorkersCount = 4
+	proofQueueSize    = 1000
+)
+
+// startProofWorkers validates incoming proposer proofs on a pool of workers, so a peer's messages are not held up by
+// the VRF checks of proofs and a winning proof is validated before the block of its proposer arrives
+func (h *IdenaGossipHandler) startProofWorkers() {
+	h.proofs = make(chan *types.ProofProposal, proofQueueSize)
+	for i := 0; i < proofWorkersCount; i++ {
+		go func() {
+			for proposal := range h.proofs {
+				h.processProof(proposal)
+			}
+		}()
+	}
+}
+
+// enqueueProof passes the proof to the workers, false means that the proof should be processed by the caller
+func (h *IdenaGossipHandler) enqueueProof(proposal *types.ProofProposal) bool {
+	if h.proofs == nil {
+		return false
+	}
+	select {
+	case h.proofs <- proposal:
+		return true
+	default:
+		return false
+	}
+}
+
+func (h *IdenaGossipHandler) processProof(proposal *types.ProofProposal) {
+	if ok, _ := h.proposals.AddProposeProof(proposal); ok {
+		h.ProposeProof(proposal)
+	}
+}
@@ -0,0 +1,19 @@
+package protocol
+
+import (
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestIdenaGossipHandler_enqueueProof(t *testing.T) {
+	h := &IdenaGossipHandler{}
+	proposal := &types.ProofProposal{Round: 1}
+	require.False(t, h.enqueueProof(proposal))
+
+	h.proofs = make(chan *types.ProofProposal, 1)
+	require.True(t, h.enqueueProof(proposal))
+	// the caller processes the proof when the workers are overloaded
+	require.False(t, h.enqueueProof(proposal))
+	require.Equal(t, proposal, <-h.proofs)
+}
@@ -3,6 +3,7 @@ package protocol
 import (
 	"github.com/idena-network/idena-go/common"
 	"github.com/idena-network/idena-go/common/pushpull"
+	"github.com/idena-network/idena-go/config"
 	"github.com/idena-network/idena-go/log"
 	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/patrickmn/go-cache"
@@ -11,6 +12,46 @@ import (
 	"time"
 )
 
+var defaultSeenCaches = map[pushType]config.SeenCache{
+	pushVote:  {Size: 100000, TTL: pushpull.DefaultHolderTTL},
+	pushBlock: {Size: 1000, TTL: pushpull.DefaultHolderTTL},
+	pushProof: {Size: 5000, TTL: pushpull.DefaultHolderTTL},
+	pushFlip:  {Size: 20000, TTL: pushpull.DefaultHolderTTL},
+}
+
+func pushTypeToString(t pushType) string {
+	switch t {
+	case pushVote:
+		return "vote"
+	case pushBlock:
+		return "block"
+	case pushProof:
+		return "proof"
+	case pushFlip:
+		return "flip"
+	case pushKeyPackage:
+		return "keyPackage"
+	case pushTx:
+		return "tx"
+	default:
+		return "unknown"
+	}
+}
+
+// newSeenCache creates a bounded holder for entries of the given type, configured size and ttl override the defaults
+func newSeenCache(cfg map[string]config.SeenCache, t pushType, maxPulls int, pushTracker pushpull.PendingPushTracker) *pushpull.DefaultHolder {
+	seenCache := defaultSeenCaches[t]
+	if c, ok := cfg[pushTypeToString(t)]; ok {
+		if c.Size > 0 {
+			seenCache.Size = c.Size
+		}
+		if c.TTL > 0 {
+			seenCache.TTL = c.TTL
+		}
+	}
+	return pushpull.NewBoundedHolder(maxPulls, pushTracker, seenCache.Size, seenCache.TTL)
+}
+
 type pendingPush struct {
 	cnt  uint32
 	hash pushPullHash
@@ -34,7 +75,7 @@ func NewPushPullManager() *PushPullManager {
 		pendingPushes:    cache.New(time.Minute*3, time.Minute*5),
 		requests:         make(chan pullRequest, 5000),
 		entryHolders:     make(map[pushType]pushpull.Holder),
-		throttlingLogger: log.NewThrottlingLogger(log.New("component", "pushPullManager")),
+		throttlingLogger: log.NewThrottlingLogger(log.New(log.ModuleKey, "p2p", "component", "pushPullManager")),
 	}
 }
 
@@ -104,6 +145,30 @@ func (m *PushPullManager) GetEntry(hash pushPullHash) (interface{}, common.Shard
 	return m.entryHolders[hash.Type].Get(hash.Hash)
 }
 
+type seenCacheStats struct {
+	hits   uint64
+	misses uint64
+}
+
+func (s seenCacheStats) hitRate() float64 {
+	if total := s.hits + s.misses; total > 0 {
+		return float64(s.hits) / float64(total)
+	}
+	return 0
+}
+
+// CacheStats returns lookup stats of entry holders which collect them
+func (m *PushPullManager) CacheStats() map[pushType]seenCacheStats {
+	result := make(map[pushType]seenCacheStats)
+	for pushId, holder := range m.entryHolders {
+		if h, ok := holder.(interface{ Stats() (uint64, uint64) }); ok {
+			hits, misses := h.Stats()
+			result[pushId] = seenCacheStats{hits: hits, misses: misses}
+		}
+	}
+	return result
+}
+
 func (m *PushPullManager) Requests() chan pullRequest {
 	return m.requests
 }
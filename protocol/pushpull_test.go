@@ -0,0 +1,79 @@
+package protocol
+
+import (
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/config"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestNewSeenCache(t *testing.T) {
+	cfg := map[string]config.SeenCache{
+		"vote":  {Size: 2},
+		"proof": {TTL: time.Nanosecond},
+	}
+
+	votes := newSeenCache(cfg, pushVote, 1, nil)
+	for i := byte(0); i < 5; i++ {
+		votes.Add(common.Hash128{i}, i, common.MultiShard, false)
+	}
+	require.Equal(t, 2, votes.Len())
+
+	proofs := newSeenCache(cfg, pushProof, 1, nil)
+	proofs.Add(common.Hash128{1}, 1, common.MultiShard, false)
+	time.Sleep(time.Millisecond)
+	require.False(t, proofs.Has(common.Hash128{1}))
+
+	blocks := newSeenCache(cfg, pushBlock, 1, nil)
+	for i := byte(0); i < 5; i++ {
+		blocks.Add(common.Hash128{i}, i, common.MultiShard, false)
+	}
+	require.Equal(t, 5, blocks.Len())
+	require.True(t, blocks.Has(common.Hash128{0}))
+}
+
+func TestPushPullManager_CacheStats(t *testing.T) {
+	m := NewPushPullManager()
+	m.AddEntryHolder(pushVote, newSeenCache(nil, pushVote, 1, nil))
+	hash := pushPullHash{Type: pushVote, Hash: common.Hash128{1}}
+	m.AddEntry(hash, 1, common.MultiShard, false)
+	m.GetEntry(hash)
+	m.GetEntry(pushPullHash{Type: pushVote, Hash: common.Hash128{2}})
+
+	stats := m.CacheStats()[pushVote]
+	require.Equal(t, uint64(1), stats.hits)
+	require.Equal(t, uint64(1), stats.misses)
+	require.Equal(t, 0.5, stats.hitRate())
+}
+
+func TestPushPullManager_addPush(t *testing.T) {
+	m := NewPushPullManager()
+	m.AddEntryHolder(pushTx, newSeenCache(nil, pushTx, 2, nil))
+	hash := pushPullHash{Type: pushTx, Hash: common.Hash128{1}}
+
+	nextRequest := func() *pullRequest {
+		select {
+		case req := <-m.Requests():
+			return &req
+		default:
+			return nil
+		}
+	}
+
+	m.addPush("a", hash)
+	require.Equal(t, &pullRequest{peer: "a", hash: hash}, nextRequest())
+
+	m.addPush("b", hash)
+	require.Equal(t, &pullRequest{peer: "b", hash: hash}, nextRequest())
+
+	// no more parallel pulls than the holder allows
+	m.addPush("c", hash)
+	require.Nil(t, nextRequest())
+
+	// bodies which are already known are not pulled
+	known := pushPullHash{Type: pushTx, Hash: common.Hash128{2}}
+	m.AddEntry(known, 1, common.MultiShard, false)
+	m.addPush("a", known)
+	require.Nil(t, nextRequest())
+}
@@ -0,0 +1,282 @@
+package protocol
+
+import (
+	"github.com/idena-network/idena-go/config"
+	"github.com/idena-network/idena-go/log"
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	// maxRateLimitViolations is the number of recently dropped messages after which the peer is disconnected
+	maxRateLimitViolations = 500
+	// rateLimitViolationsDecay is the number of violations forgiven per second
+	rateLimitViolationsDecay = 10
+	// rateLimitScorePenalty is charged when the peer is disconnected for exceeding rate limits, it bans a peer
+	// unless the peer has earned score by useful responses
+	rateLimitScorePenalty = 150
+)
+
+type rateLimit struct {
+	rate  float64
+	burst int
+	// bytes limits the payload size of messages per second, zero means no limit
+	bytes int
+}
+
+var defaultRateLimits = map[uint64]rateLimit{
+	ProposeBlock:          {rate: 50, burst: 200},
+	ProposeProof:          {rate: 100, burst: 500},
+	Vote:                  {rate: 2000, burst: 10000},
+	NewTx:                 {rate: 300, burst: 3000, bytes: 1 << 20},
+	GetBlockByHash:        {rate: 20, burst: 100},
+	GetBlocksRange:        {rate: 2, burst: 10},
+	GetForkBlockRange:     {rate: 1, burst: 5},
+	NewBlockHash:          {rate: 1, burst: 20},
+	FlipBody:              {rate: 100, burst: 1000},
+	FlipKey:               {rate: 500, burst: 5000},
+	FlipKeysPackage:       {rate: 100, burst: 1000},
+	Push:                  {rate: 3000, burst: 15000},
+	Pull:                  {rate: 3000, burst: 15000},
+	BatchPush:             {rate: 100, burst: 1000},
+	BatchFlipKey:          {rate: 100, burst: 1000},
+	BatchVote:             {rate: 100, burst: 1000},
+	Ping:                  {rate: 1, burst: 10},
+	Pong:                  {rate: 1, burst: 10},
+	GetTransactions:       {rate: 5, burst: 20},
+	Transactions:          {rate: 5, burst: 20},
+	GetPooledTransactions: {rate: 1, burst: 5},
+	PooledTransactions:    {rate: 1, burst: 5},
+	GetStateProof:         {rate: 10, burst: 50},
+	StateProof:            {rate: 10, burst: 50},
+	GetBlockHeaders:       {rate: 5, burst: 20},
+	BlockHeaders:          {rate: 5, burst: 20},
+	Status:                {rate: 1, burst: 5},
+	GetFlip:               {rate: 20, burst: 100},
+	Flip:                  {rate: 20, burst: 100, bytes: 1 << 20},
+	SnapshotManifest:      {rate: 1, burst: 5},
+}
+
+// lightRateLimits throttle requests of light clients, they fetch headers, blocks and proofs on demand
+// and don't need the throughput of syncing nodes
+var lightRateLimits = map[uint64]rateLimit{
+	GetBlockByHash:    {rate: 2, burst: 10},
+	GetBlocksRange:    {rate: 0.1, burst: 1},
+	GetForkBlockRange: {rate: 0.1, burst: 1},
+	GetStateProof:     {rate: 2, burst: 10},
+	GetBlockHeaders:   {rate: 1, burst: 5},
+}
+
+// withLightRateLimits lowers the given limits to the light client ones
+func withLightRateLimits(limits map[uint64]rateLimit) map[uint64]rateLimit {
+	result := make(map[uint64]rateLimit, len(limits))
+	for code, limit := range limits {
+		result[code] = limit
+	}
+	for code, light := range lightRateLimits {
+		if limit, ok := result[code]; ok {
+			light.rate = math.Min(light.rate, limit.rate)
+			if limit.burst < light.burst {
+				light.burst = limit.burst
+			}
+			light.bytes = limit.bytes
+		}
+		result[code] = light
+	}
+	return result
+}
+
+// buildRateLimits merges configured per-message limits with the default ones, config keys are message names as in msgCodeToString,
+// a configured limit replaces the default one entirely
+func buildRateLimits(cfg map[string]config.MsgRateLimit) map[uint64]rateLimit {
+	result := make(map[uint64]rateLimit, len(defaultRateLimits))
+	for code, limit := range defaultRateLimits {
+		result[code] = limit
+	}
+	for name, limit := range cfg {
+		code, ok := msgCodeFromString(name)
+		if !ok {
+			log.Warn("Unknown message in rate limits config", "name", name)
+			continue
+		}
+		result[code] = rateLimit{rate: limit.Rate, burst: limit.Burst, bytes: limit.BytesPerSecond}
+	}
+	return result
+}
+
+type tokenBucket struct {
+	limit  rateLimit
+	tokens float64
+	last   time.Time
+}
+
+func (b *tokenBucket) refill(now time.Time) {
+	b.tokens += now.Sub(b.last).Seconds() * b.limit.rate
+	if max := float64(b.limit.burst); b.tokens > max {
+		b.tokens = max
+	}
+	b.last = now
+}
+
+func (b *tokenBucket) take(now time.Time) bool {
+	b.refill(now)
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// takeBytes charges the payload size while the bucket isn't exhausted, a message larger than the rest of the bucket
+// is allowed and makes it go negative, so large messages are not dropped forever
+func (b *tokenBucket) takeBytes(now time.Time, size int) bool {
+	b.refill(now)
+	if b.tokens <= 0 {
+		return false
+	}
+	b.tokens -= float64(size)
+	return true
+}
+
+type rateLimiter struct {
+	limits      map[uint64]rateLimit
+	buckets     map[uint64]*tokenBucket
+	byteBuckets map[uint64]*tokenBucket
+	dropped     map[uint64]uint64
+	// violations decay over time, so allowed messages between dropped ones don't hide abuse
+	violations     float64
+	violationsTime time.Time
+	now            func() time.Time
+	mutex          sync.Mutex
+}
+
+func newRateLimiter(limits map[uint64]rateLimit) *rateLimiter {
+	return &rateLimiter{
+		limits:      limits,
+		buckets:     make(map[uint64]*tokenBucket),
+		byteBuckets: make(map[uint64]*tokenBucket),
+		dropped:     make(map[uint64]uint64),
+		now:         time.Now,
+	}
+}
+
+// Allow reports whether a message with the given code and payload size may be processed, messages without configured limit
+// are always allowed
+func (l *rateLimiter) Allow(code uint64, size int) bool {
+	limit, ok := l.limits[code]
+	if !ok {
+		return true
+	}
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	now := l.now()
+	bucket, ok := l.buckets[code]
+	if !ok {
+		bucket = &tokenBucket{limit: limit, tokens: float64(limit.burst), last: now}
+		l.buckets[code] = bucket
+	}
+	if bucket.take(now) && l.takeBytes(code, limit, size, now) {
+		return true
+	}
+	l.drop(code, now)
+	return false
+}
+
+func (l *rateLimiter) takeBytes(code uint64, limit rateLimit, size int, now time.Time) bool {
+	if limit.bytes <= 0 {
+		return true
+	}
+	bucket, ok := l.byteBuckets[code]
+	if !ok {
+		bytesLimit := rateLimit{rate: float64(limit.bytes), burst: limit.bytes}
+		bucket = &tokenBucket{limit: bytesLimit, tokens: float64(bytesLimit.burst), last: now}
+		l.byteBuckets[code] = bucket
+	}
+	return bucket.takeBytes(now, size)
+}
+
+// Reject counts a message dropped by a limit outside of the limiter as a violation
+func (l *rateLimiter) Reject(code uint64) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.drop(code, l.now())
+}
+
+func (l *rateLimiter) drop(code uint64, now time.Time) {
+	l.dropped[code]++
+	l.decayViolations(now)
+	l.violations++
+}
+
+func (l *rateLimiter) decayViolations(now time.Time) {
+	l.violations -= now.Sub(l.violationsTime).Seconds() * rateLimitViolationsDecay
+	if l.violations < 0 {
+		l.violations = 0
+	}
+	l.violationsTime = now
+}
+
+func (l *rateLimiter) Violations() int {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.decayViolations(l.now())
+	return int(math.Ceil(l.violations))
+}
+
+// Stats returns the number of dropped messages by message code
+func (l *rateLimiter) Stats() map[uint64]uint64 {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	result := make(map[uint64]uint64, len(l.dropped))
+	for code, cnt := range l.dropped {
+		result[code] = cnt
+	}
+	return result
+}
+
+// byteLimiter throttles outbound traffic of a single peer or accounts the data served to it
+type byteLimiter struct {
+	bucket tokenBucket
+	now    func() time.Time
+	mutex  sync.Mutex
+}
+
+// newByteLimiter returns nil if bytesPerSecond is not positive, nil limiter doesn't throttle anything
+func newByteLimiter(bytesPerSecond int) *byteLimiter {
+	if bytesPerSecond <= 0 {
+		return nil
+	}
+	limit := rateLimit{rate: float64(bytesPerSecond), burst: bytesPerSecond}
+	return &byteLimiter{
+		bucket: tokenBucket{limit: limit, tokens: float64(limit.burst), last: time.Now()},
+		now:    time.Now,
+	}
+}
+
+// reserve takes n bytes from the bucket and returns how long the caller should wait before sending them,
+// messages larger than the burst are allowed and make the bucket go negative
+func (l *byteLimiter) reserve(n int) time.Duration {
+	if l == nil {
+		return 0
+	}
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.bucket.refill(l.now())
+	l.bucket.tokens -= float64(n)
+	if l.bucket.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.bucket.tokens / l.bucket.limit.rate * float64(time.Second))
+}
+
+// exhausted reports whether the bytes reserved earlier exceed the budget refilled so far
+func (l *byteLimiter) exhausted() bool {
+	if l == nil {
+		return false
+	}
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.bucket.refill(l.now())
+	return l.bucket.tokens < 0
+}
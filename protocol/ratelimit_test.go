@@ -0,0 +1,212 @@
+package protocol
+
+import (
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/config"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestRateLimiter_Allow(t *testing.T) {
+	limiter := newRateLimiter(map[uint64]rateLimit{
+		Vote: {rate: 10, burst: 3},
+	})
+	now := time.Unix(0, 0)
+	limiter.now = func() time.Time {
+		return now
+	}
+
+	for i := 0; i < 3; i++ {
+		require.True(t, limiter.Allow(Vote, 0))
+	}
+	require.False(t, limiter.Allow(Vote, 0))
+	require.False(t, limiter.Allow(Vote, 0))
+	require.Equal(t, map[uint64]uint64{Vote: 2}, limiter.Stats())
+	require.Equal(t, 2, limiter.Violations())
+
+	// messages without limit are not affected
+	require.True(t, limiter.Allow(NewTx, 0))
+
+	now = now.Add(time.Millisecond * 200)
+	require.True(t, limiter.Allow(Vote, 0))
+	require.Equal(t, 0, limiter.Violations())
+	require.True(t, limiter.Allow(Vote, 0))
+	require.False(t, limiter.Allow(Vote, 0))
+
+	// bucket is never refilled over burst
+	now = now.Add(time.Hour)
+	for i := 0; i < 3; i++ {
+		require.True(t, limiter.Allow(Vote, 0))
+	}
+	require.False(t, limiter.Allow(Vote, 0))
+	require.Equal(t, map[uint64]uint64{Vote: 4}, limiter.Stats())
+}
+
+func TestRateLimiter_violationsDecay(t *testing.T) {
+	limiter := newRateLimiter(map[uint64]rateLimit{
+		Vote: {rate: 1, burst: 1},
+	})
+	now := time.Unix(0, 0)
+	limiter.now = func() time.Time {
+		return now
+	}
+
+	// allowed messages between dropped ones don't reset violations
+	for i := 0; i < 10; i++ {
+		now = now.Add(time.Second)
+		require.True(t, limiter.Allow(Vote, 0))
+		for j := 0; j < 100; j++ {
+			require.False(t, limiter.Allow(Vote, 0))
+		}
+	}
+	require.Greater(t, limiter.Violations(), maxRateLimitViolations)
+
+	now = now.Add(time.Hour)
+	require.Equal(t, 0, limiter.Violations())
+}
+
+func TestBuildRateLimits(t *testing.T) {
+	limits := buildRateLimits(map[string]config.MsgRateLimit{
+		"getBlocksRange": {Rate: 5, Burst: 7},
+		"unknown":        {Rate: 1, Burst: 1},
+	})
+	require.Equal(t, rateLimit{rate: 5, burst: 7}, limits[GetBlocksRange])
+	require.Equal(t, defaultRateLimits[Vote], limits[Vote])
+	require.Equal(t, defaultRateLimits[NewBlockHash], limits[NewBlockHash])
+	require.Len(t, limits, len(defaultRateLimits))
+}
+
+func TestWithLightRateLimits(t *testing.T) {
+	limits := withLightRateLimits(map[uint64]rateLimit{
+		GetBlockByHash: {rate: 20, burst: 100},
+		GetStateProof:  {rate: 1, burst: 50},
+		Vote:           {rate: 2000, burst: 10000},
+	})
+	require.Equal(t, lightRateLimits[GetBlockByHash], limits[GetBlockByHash])
+	require.Equal(t, rateLimit{rate: 1, burst: lightRateLimits[GetStateProof].burst}, limits[GetStateProof])
+	require.Equal(t, lightRateLimits[GetBlockHeaders], limits[GetBlockHeaders])
+	require.Equal(t, rateLimit{rate: 2000, burst: 10000}, limits[Vote])
+}
+
+func TestIdenaGossipHandler_handle_rateLimit(t *testing.T) {
+	h := &IdenaGossipHandler{
+		peers:       newPeerSet(),
+		connManager: NewConnManager(nil, config.P2P{}),
+	}
+	p, remote := newTestPeer("peer")
+	p.rateLimiter = newRateLimiter(map[uint64]rateLimit{
+		UpdateShardId: {rate: 0.001, burst: 1},
+	})
+
+	go func() {
+		for i := 0; i < maxRateLimitViolations+2; i++ {
+			if err := remote.rw.WriteMsg(makeMsg(UpdateShardId, &updateShardId{ShardId: common.ShardId(i + 1)}, 0)); err != nil {
+				return
+			}
+		}
+	}()
+
+	require.NoError(t, h.handle(p))
+	require.Equal(t, common.ShardId(1), p.shardId)
+
+	for i := 0; i < maxRateLimitViolations; i++ {
+		require.NoError(t, h.handle(p))
+	}
+	require.Equal(t, common.ShardId(1), p.shardId)
+	require.Equal(t, map[uint64]uint64{UpdateShardId: maxRateLimitViolations}, p.rateLimiter.Stats())
+
+	go func() {
+		remote.ReadMsg()
+	}()
+	require.Error(t, h.handle(p))
+	require.Equal(t, int32(-rateLimitScorePenalty), p.Score())
+	require.True(t, h.connManager.IsBanned(p.id))
+}
+
+func TestRateLimiter_bytes(t *testing.T) {
+	limiter := newRateLimiter(map[uint64]rateLimit{
+		NewTx: {rate: 100, burst: 100, bytes: 1000},
+	})
+	now := time.Unix(0, 0)
+	limiter.now = func() time.Time {
+		return now
+	}
+
+	require.True(t, limiter.Allow(NewTx, 600))
+	// the message larger than the rest of the bucket is allowed, the next ones wait for the debt to be paid
+	require.True(t, limiter.Allow(NewTx, 1000))
+	require.False(t, limiter.Allow(NewTx, 1))
+	require.Equal(t, 1, limiter.Violations())
+
+	now = now.Add(time.Millisecond * 500)
+	require.False(t, limiter.Allow(NewTx, 1))
+	now = now.Add(time.Millisecond * 200)
+	require.True(t, limiter.Allow(NewTx, 1))
+	require.Equal(t, map[uint64]uint64{NewTx: 2}, limiter.Stats())
+}
+
+func TestBuildRateLimits_bytes(t *testing.T) {
+	limits := buildRateLimits(map[string]config.MsgRateLimit{
+		"blockRange": {Rate: 1, Burst: 2, BytesPerSecond: 1000},
+		"newTx":      {Rate: 1, Burst: 2},
+	})
+	require.Equal(t, rateLimit{rate: 1, burst: 2, bytes: 1000}, limits[BlocksRange])
+	require.Equal(t, rateLimit{rate: 1, burst: 2}, limits[NewTx])
+	require.Equal(t, 1<<20, defaultRateLimits[NewTx].bytes)
+}
+
+func TestIdenaGossipHandler_allowMsg_served(t *testing.T) {
+	h := &IdenaGossipHandler{
+		peers:       newPeerSet(),
+		connManager: NewConnManager(nil, config.P2P{}),
+	}
+	p, _ := newTestPeer("peer")
+	p.rateLimiter = newRateLimiter(nil)
+	p.serveLimiter = newByteLimiter(1000)
+	now := time.Unix(0, 0)
+	p.serveLimiter.now = func() time.Time {
+		return now
+	}
+	p.serveLimiter.bucket.last = now
+
+	allowed, err := h.allowMsg(p, GetBlocksRange, 10)
+	require.NoError(t, err)
+	require.True(t, allowed)
+	p.serveLimiter.reserve(1500)
+
+	// requests for chain data are dropped until the served bytes are paid off, other messages are not affected
+	allowed, err = h.allowMsg(p, GetBlocksRange, 10)
+	require.NoError(t, err)
+	require.False(t, allowed)
+	allowed, _ = h.allowMsg(p, NewTx, 10)
+	require.True(t, allowed)
+	require.Equal(t, map[uint64]uint64{GetBlocksRange: 1}, p.rateLimiter.Stats())
+
+	now = now.Add(time.Second)
+	allowed, _ = h.allowMsg(p, GetBlockHeaders, 10)
+	require.True(t, allowed)
+}
+
+func TestByteLimiter_reserve(t *testing.T) {
+	require.Nil(t, newByteLimiter(0))
+	require.Zero(t, (*byteLimiter)(nil).reserve(1000))
+
+	limiter := newByteLimiter(1000)
+	now := time.Unix(0, 0)
+	limiter.now = func() time.Time {
+		return now
+	}
+	limiter.bucket.last = now
+
+	require.Zero(t, limiter.reserve(600))
+	require.Zero(t, limiter.reserve(400))
+	require.Equal(t, time.Millisecond*500, limiter.reserve(500))
+
+	// large messages are allowed but have to wait until the debt is paid
+	now = now.Add(time.Millisecond * 500)
+	require.Equal(t, time.Second*2, limiter.reserve(2000))
+
+	now = now.Add(time.Hour)
+	require.Zero(t, limiter.reserve(1000))
+}
@@ -0,0 +1,130 @@
+package protocol
+
+import (
+	"context"
+	"github.com/idena-network/idena-go/log"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	defaultReconnectInitialDelay = time.Second * 5
+	defaultReconnectMaxDelay     = time.Minute * 5
+	reconnectMultiplier          = 2
+	pinnedPeerConnectTimeout     = time.Second * 30
+	// reconnectJitter is the max share of a delay which is added randomly, so peers don't retry in lockstep
+	reconnectJitter = 0.2
+)
+
+// reconnector restores dropped connections to trusted and static peers with growing delays between attempts,
+// it stops once the peer is connected again or the handler is stopped
+type reconnector struct {
+	dial       func(id peer.ID) error
+	newBackoff func() *SyncBackoff
+	stopped    func() bool
+	after      func(d time.Duration) <-chan time.Time
+	log        log.Logger
+
+	backoffs map[peer.ID]*SyncBackoff
+	mutex    sync.Mutex
+}
+
+func (h *IdenaGossipHandler) newReconnector() *reconnector {
+	return &reconnector{
+		dial: h.dialPeer,
+		newBackoff: func() *SyncBackoff {
+			b := &SyncBackoff{
+				InitialDelay: h.cfg.ReconnectInitialDelay,
+				MaxDelay:     h.cfg.ReconnectMaxDelay,
+				Multiplier:   reconnectMultiplier,
+			}
+			if b.InitialDelay <= 0 {
+				b.InitialDelay = defaultReconnectInitialDelay
+			}
+			if b.MaxDelay <= 0 {
+				b.MaxDelay = defaultReconnectMaxDelay
+			}
+			return b
+		},
+		stopped: func() bool {
+			return atomic.LoadUint32(&h.stopped) == 1
+		},
+		after:    time.After,
+		log:      h.log,
+		backoffs: make(map[peer.ID]*SyncBackoff),
+	}
+}
+
+// dialPeer opens a stream to the peer and runs the protocol over it, the connection is restored first if needed
+func (h *IdenaGossipHandler) dialPeer(id peer.ID) error {
+	if h.peers.Peer(id) != nil {
+		return nil
+	}
+	if h.host.Network().Connectedness(id) != network.Connected {
+		ctx, cancel := context.WithTimeout(context.Background(), pinnedPeerConnectTimeout)
+		err := h.host.Connect(ctx, h.host.Peerstore().PeerInfo(id))
+		cancel()
+		if err != nil {
+			return err
+		}
+	}
+	stream, err := h.connManager.newStream(id)
+	if err != nil {
+		return err
+	}
+	_, err = h.runPeer(stream, false)
+	return err
+}
+
+// Schedule starts reconnection attempts to the peer if they are not started yet
+func (r *reconnector) Schedule(id peer.ID) {
+	if r.stopped() {
+		return
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if _, ok := r.backoffs[id]; ok {
+		return
+	}
+	b := r.newBackoff()
+	r.backoffs[id] = b
+	go r.run(id, b)
+}
+
+// Reset is called when the peer is connected, pending attempts are cancelled
+func (r *reconnector) Reset(id peer.ID) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	delete(r.backoffs, id)
+}
+
+func (r *reconnector) active(id peer.ID, b *SyncBackoff) bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.backoffs[id] == b
+}
+
+func (r *reconnector) run(id peer.ID, b *SyncBackoff) {
+	for {
+		delay := b.Next()
+		delay += time.Duration(rand.Float64() * reconnectJitter * float64(delay))
+		<-r.after(delay)
+		if r.stopped() {
+			r.Reset(id)
+			return
+		}
+		if !r.active(id, b) {
+			return
+		}
+		err := r.dial(id)
+		if err == nil {
+			r.Reset(id)
+			return
+		}
+		r.log.Debug("Failed to reconnect to pinned peer", "id", id.Pretty(), "attempt", b.retries, "err", err)
+	}
+}
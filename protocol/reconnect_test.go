@@ -0,0 +1,71 @@
+package protocol
+
+import (
+	"errors"
+	"github.com/idena-network/idena-go/log"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestReconnector(t *testing.T) {
+	var stopped bool
+	dials := 0
+	delays := make(chan time.Duration, 10)
+	connected := make(chan peer.ID, 1)
+	r := &reconnector{
+		dial: func(id peer.ID) error {
+			dials++
+			if dials <= 2 {
+				return errors.New("dial failed")
+			}
+			connected <- id
+			return nil
+		},
+		newBackoff: func() *SyncBackoff {
+			return &SyncBackoff{InitialDelay: time.Second, MaxDelay: time.Minute, Multiplier: reconnectMultiplier}
+		},
+		stopped: func() bool {
+			return stopped
+		},
+		after: func(d time.Duration) <-chan time.Time {
+			delays <- d
+			ch := make(chan time.Time, 1)
+			ch <- time.Time{}
+			return ch
+		},
+		log:      log.New(),
+		backoffs: make(map[peer.ID]*SyncBackoff),
+	}
+
+	r.Schedule("peer")
+	// attempts are already scheduled
+	r.Schedule("peer")
+
+	select {
+	case id := <-connected:
+		require.Equal(t, peer.ID("peer"), id)
+	case <-time.After(time.Second):
+		t.Fatal("peer is not reconnected")
+	}
+	require.Eventually(t, func() bool {
+		r.mutex.Lock()
+		defer r.mutex.Unlock()
+		return len(r.backoffs) == 0
+	}, time.Second, time.Millisecond*10)
+	require.Equal(t, 3, dials)
+
+	close(delays)
+	var prev time.Duration
+	for delay := range delays {
+		require.Greater(t, delay, prev)
+		require.LessOrEqual(t, delay, time.Duration(float64(prev*2+time.Second)*(1+reconnectJitter)))
+		prev = delay
+	}
+	require.GreaterOrEqual(t, prev, time.Second*4)
+
+	stopped = true
+	r.Schedule("peer")
+	require.Empty(t, r.backoffs)
+}
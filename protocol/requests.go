@@ -0,0 +1,299 @@
+package protocol
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	blocksRangeRequestTimeout = time.Minute
+	blockByHashRequestTimeout = time.Second * 20
+	txsRequestTimeout         = time.Second * 20
+	pooledTxsRequestTimeout   = time.Second * 20
+	pendingRequestsCheckTime  = time.Second * 5
+	// requestsStallTimeout is the time without any response from any peer while requests are awaited after which the requests are reset
+	requestsStallTimeout = time.Second * 90
+	// peers are rewarded for responses only while their average response time doesn't exceed slowResponseTime
+	slowResponseTime = time.Second * 10
+	// weight of the latest response time in the response time estimate
+	responseTimeSmoothing = 0.2
+)
+
+var (
+	errRequestTimeout   = errors.New("request timed out")
+	errRequestReset     = errors.New("request reset")
+	errRequestCancelled = errors.New("request cancelled")
+)
+
+type pendingRequest struct {
+	seqNo    uint64
+	code     uint64
+	key      interface{}
+	sent     time.Time
+	deadline time.Time
+	// optional requests may stay unanswered since the peer may lack the data, so their expiry isn't counted as a peer timeout
+	optional bool
+	// done is closed once the request is resolved, expired or reset, err is nil only if the response has arrived
+	done chan struct{}
+	err  error
+	// onTimeout is called by the sweeper if no response arrives before the deadline
+	onTimeout func()
+}
+
+// pendingRequestKey identifies a request by the code it was sent with, so sequence numbers of different requests may overlap
+type pendingRequestKey struct {
+	code  uint64
+	seqNo uint64
+}
+
+// pendingRequests tracks requests sent to a peer which are waiting for a response
+type pendingRequests struct {
+	entries map[pendingRequestKey]*pendingRequest
+	seqNo   uint64
+	tracker *progressTracker
+	// exponentially weighted time between sending requests and receiving responses, zero if nothing is answered yet
+	responseTime time.Duration
+	mutex        sync.Mutex
+}
+
+// progressTracker is shared by all peers and detects that requests are sent but no peer responds
+type progressTracker struct {
+	// time of the first request sent after the last response, zero if nothing is awaited
+	waitingSince time.Time
+	resets       uint32
+	mutex        sync.Mutex
+}
+
+func (t *progressTracker) requested(now time.Time) {
+	if t == nil {
+		return
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if t.waitingSince.IsZero() {
+		t.waitingSince = now
+	}
+}
+
+func (t *progressTracker) responded() {
+	if t == nil {
+		return
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.waitingSince = time.Time{}
+}
+
+func (t *progressTracker) stalled(now time.Time, timeout time.Duration) bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return !t.waitingSince.IsZero() && now.Sub(t.waitingSince) >= timeout
+}
+
+func newPendingRequests() *pendingRequests {
+	return &pendingRequests{
+		entries: make(map[pendingRequestKey]*pendingRequest),
+	}
+}
+
+// Add registers a request identified by code and seqNo, a zero seqNo means that a new sequence number should be generated
+func (r *pendingRequests) Add(seqNo uint64, code uint64, key interface{}, timeout time.Duration) *pendingRequest {
+	return r.AddWithCallback(seqNo, code, key, timeout, nil)
+}
+
+// AddWithCallback registers a request like Add, onTimeout is called if the request expires before it is resolved
+func (r *pendingRequests) AddWithCallback(seqNo uint64, code uint64, key interface{}, timeout time.Duration, onTimeout func()) *pendingRequest {
+	return r.add(seqNo, code, key, timeout, onTimeout, false)
+}
+
+// AddOptional registers a request the peer may leave unanswered, its expiry calls onTimeout but isn't counted as a peer timeout
+// and it doesn't take part in the detection of stalled requests
+func (r *pendingRequests) AddOptional(code uint64, key interface{}, timeout time.Duration, onTimeout func()) *pendingRequest {
+	return r.add(0, code, key, timeout, onTimeout, true)
+}
+
+func (r *pendingRequests) add(seqNo uint64, code uint64, key interface{}, timeout time.Duration, onTimeout func(), optional bool) *pendingRequest {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if seqNo == 0 {
+		r.seqNo++
+		seqNo = r.seqNo
+	}
+	now := time.Now()
+	req := &pendingRequest{
+		seqNo:     seqNo,
+		code:      code,
+		key:       key,
+		sent:      now,
+		deadline:  now.Add(timeout),
+		optional:  optional,
+		done:      make(chan struct{}),
+		onTimeout: onTimeout,
+	}
+	r.entries[pendingRequestKey{code, seqNo}] = req
+	if !optional {
+		r.tracker.requested(now)
+	}
+	return req
+}
+
+// observe updates the response time estimate, it's called under the mutex
+func (r *pendingRequests) observe(req *pendingRequest) {
+	elapsed := time.Since(req.sent)
+	if elapsed <= 0 {
+		elapsed = 1
+	}
+	if r.responseTime == 0 {
+		r.responseTime = elapsed
+		return
+	}
+	r.responseTime = time.Duration(responseTimeSmoothing*float64(elapsed) + (1-responseTimeSmoothing)*float64(r.responseTime))
+}
+
+// ResponseTime returns exponentially weighted time the peer takes to answer requests, zero means that it hasn't answered yet
+func (r *pendingRequests) ResponseTime() time.Duration {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.responseTime
+}
+
+// Resolve removes the request sent with the given code and seqNo and reports whether it was pending
+func (r *pendingRequests) Resolve(code uint64, seqNo uint64) bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	key := pendingRequestKey{code, seqNo}
+	req, ok := r.entries[key]
+	if !ok {
+		return false
+	}
+	delete(r.entries, key)
+	close(req.done)
+	r.observe(req)
+	r.tracker.responded()
+	return true
+}
+
+// ResolveByKey removes all pending requests with the given code and key, it is used for responses which don't echo seqNo
+func (r *pendingRequests) ResolveByKey(code uint64, key interface{}) bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	resolved := false
+	for entryKey, req := range r.entries {
+		if req.code == code && req.key == key {
+			delete(r.entries, entryKey)
+			close(req.done)
+			r.observe(req)
+			resolved = true
+		}
+	}
+	if resolved {
+		r.tracker.responded()
+	}
+	return resolved
+}
+
+// CancelByKey removes pending requests with the given code and key which are no longer needed, they don't count as responses
+func (r *pendingRequests) CancelByKey(code uint64, key interface{}) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	for entryKey, req := range r.entries {
+		if req.code == code && req.key == key {
+			delete(r.entries, entryKey)
+			req.err = errRequestCancelled
+			close(req.done)
+		}
+	}
+}
+
+// Expire removes and returns requests whose deadline has passed
+func (r *pendingRequests) Expire(now time.Time) []*pendingRequest {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	var result []*pendingRequest
+	for key, req := range r.entries {
+		if now.After(req.deadline) {
+			delete(r.entries, key)
+			req.err = errRequestTimeout
+			close(req.done)
+			result = append(result, req)
+		}
+	}
+	return result
+}
+
+// Clear removes all requests without resolving them
+func (r *pendingRequests) Clear() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	for _, req := range r.entries {
+		req.err = errRequestReset
+		close(req.done)
+	}
+	r.entries = make(map[pendingRequestKey]*pendingRequest)
+}
+
+func (r *pendingRequests) Len() int {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return len(r.entries)
+}
+
+// onResponse is called when the peer answers a tracked request, peers are rewarded while they respond fast enough
+func (h *IdenaGossipHandler) onResponse(p *protoPeer) {
+	p.resetTimeouts()
+	if p.pendingRequests.ResponseTime() <= slowResponseTime {
+		p.Reward(responseScoreReward)
+	}
+}
+
+func (h *IdenaGossipHandler) checkPendingRequests() {
+	for {
+		time.Sleep(pendingRequestsCheckTime)
+		now := time.Now()
+		for _, p := range h.peers.Peers() {
+			h.expirePendingRequests(p, now)
+		}
+		h.checkStalledRequests(now)
+	}
+}
+
+func (h *IdenaGossipHandler) expirePendingRequests(p *protoPeer, now time.Time) {
+	expired := p.pendingRequests.Expire(now)
+	if len(expired) == 0 {
+		return
+	}
+	shouldBeDisconnected := false
+	for _, req := range expired {
+		p.log.Debug("Request timed out", "code", msgCodeToString(req.code), "seqNo", req.seqNo)
+		if !req.optional && p.addTimeout() {
+			shouldBeDisconnected = true
+		}
+		if req.onTimeout != nil {
+			req.onTimeout()
+		}
+	}
+	if shouldBeDisconnected {
+		go p.disconnect(DiscRequestTimeouts, nil)
+	}
+}
+
+// checkStalledRequests drops requests awaited from all peers if no peer has responded for requestsStallTimeout,
+// peers with outstanding requests are disconnected so the downloader retries with other peers
+func (h *IdenaGossipHandler) checkStalledRequests(now time.Time) bool {
+	if !h.progress.stalled(now, requestsStallTimeout) {
+		return false
+	}
+	h.progress.responded()
+	atomic.AddUint32(&h.progress.resets, 1)
+	h.log.Warn("No responses to requests from any peer, resetting pending requests")
+	for _, p := range h.peers.Peers() {
+		if p.pendingRequests.Len() == 0 {
+			continue
+		}
+		p.pendingRequests.Clear()
+		go p.disconnect(DiscStalled, nil)
+	}
+	return true
+}
@@ -0,0 +1,159 @@
+package protocol
+
+import (
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/log"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/require"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestPendingRequests(t *testing.T) {
+	requests := newPendingRequests()
+
+	first := requests.Add(0, GetBlockByHash, common.Hash{0x1}, time.Minute)
+	second := requests.Add(0, GetBlockByHash, common.Hash{0x2}, time.Minute)
+	batch := requests.Add(100, GetBlocksRange, nil, time.Second)
+	require.NotEqual(t, first.seqNo, second.seqNo)
+	require.Equal(t, uint64(100), batch.seqNo)
+	require.Equal(t, 3, requests.Len())
+
+	// sequence numbers are scoped by request code
+	require.False(t, requests.Resolve(GetForkBlockRange, 100))
+	require.True(t, requests.Resolve(GetBlocksRange, 100))
+	require.False(t, requests.Resolve(GetBlocksRange, 100))
+	<-batch.done
+	require.NoError(t, batch.err)
+
+	require.True(t, requests.ResolveByKey(GetBlockByHash, common.Hash{0x2}))
+	require.False(t, requests.ResolveByKey(GetBlockByHash, common.Hash{0x3}))
+	<-second.done
+
+	require.Empty(t, requests.Expire(time.Now()))
+	expired := requests.Expire(time.Now().Add(time.Hour))
+	require.Len(t, expired, 1)
+	require.Equal(t, first.seqNo, expired[0].seqNo)
+	<-first.done
+	require.Equal(t, errRequestTimeout, first.err)
+	require.Equal(t, 0, requests.Len())
+
+	reset := requests.Add(0, GetTransactions, nil, time.Minute)
+	requests.Clear()
+	<-reset.done
+	require.Equal(t, errRequestReset, reset.err)
+}
+
+func TestPendingRequests_optional(t *testing.T) {
+	tracker := &progressTracker{}
+	requests := newPendingRequests()
+	requests.tracker = tracker
+
+	timedOut := false
+	optional := requests.AddOptional(GetBlockByHash, common.Hash{0x1}, time.Minute, func() {
+		timedOut = true
+	})
+	require.False(t, tracker.stalled(time.Now().Add(requestsStallTimeout*2), requestsStallTimeout))
+	require.True(t, optional.optional)
+	require.NotNil(t, optional.onTimeout)
+
+	requests.CancelByKey(GetBlockByHash, common.Hash{0x1})
+	<-optional.done
+	require.Equal(t, errRequestCancelled, optional.err)
+	require.Zero(t, requests.ResponseTime())
+	require.False(t, timedOut)
+
+	first := requests.Add(0, GetTransactions, nil, time.Minute)
+	first.sent = time.Now().Add(-time.Second)
+	require.True(t, requests.Resolve(GetTransactions, first.seqNo))
+	require.InDelta(t, time.Second, requests.ResponseTime(), float64(time.Millisecond*100))
+
+	second := requests.Add(0, GetTransactions, nil, time.Minute)
+	second.sent = time.Now().Add(-time.Second * 11)
+	require.True(t, requests.Resolve(GetTransactions, second.seqNo))
+	require.InDelta(t, time.Second*3, requests.ResponseTime(), float64(time.Millisecond*100))
+}
+
+func TestIdenaGossipHandler_onResponse(t *testing.T) {
+	h := &IdenaGossipHandler{}
+	p, _ := newTestPeer("peer")
+
+	req := p.pendingRequests.Add(0, GetTransactions, nil, time.Minute)
+	p.pendingRequests.Resolve(GetTransactions, req.seqNo)
+	h.onResponse(p)
+	require.Equal(t, int32(responseScoreReward), p.Score())
+
+	// slow peers aren't rewarded
+	req = p.pendingRequests.Add(0, GetTransactions, nil, time.Minute)
+	req.sent = time.Now().Add(-slowResponseTime * 10)
+	p.pendingRequests.Resolve(GetTransactions, req.seqNo)
+	h.onResponse(p)
+	require.Equal(t, int32(responseScoreReward), p.Score())
+}
+
+func TestIdenaGossipHandler_expirePendingRequests(t *testing.T) {
+	h := &IdenaGossipHandler{}
+	p, remote := newTestPeer("peer")
+
+	for i := 0; i < maxTimeoutsBeforeBan; i++ {
+		p.pendingRequests.Add(0, GetBlockByHash, common.Hash{byte(i)}, 0)
+	}
+	h.expirePendingRequests(p, time.Now().Add(time.Second))
+	require.Equal(t, uint32(maxTimeoutsBeforeBan), atomic.LoadUint32(&p.timeouts))
+	require.Equal(t, int32(-maxTimeoutsBeforeBan*timeoutScorePenalty), p.Score())
+
+	p.pendingRequests.Add(0, GetBlockByHash, common.Hash{0xff}, 0)
+	h.expirePendingRequests(p, time.Now().Add(time.Second))
+
+	msg, err := remote.ReadMsg()
+	require.NoError(t, err)
+	require.Equal(t, uint64(Disconnect), msg.Code)
+}
+
+func TestIdenaGossipHandler_checkStalledRequests(t *testing.T) {
+	h := &IdenaGossipHandler{
+		peers:         newPeerSet(),
+		incomeBatches: &sync.Map{},
+		progress:      &progressTracker{},
+		log:           log.New(),
+	}
+	var peers []*protoPeer
+	for _, id := range []peer.ID{"peer1", "peer2"} {
+		p, _ := newTestPeer(id)
+		p.pendingRequests.tracker = h.progress
+		p.knownHeight.Store(100)
+		require.NoError(t, h.peers.Register(p))
+		peers = append(peers, p)
+	}
+	idle, _ := newTestPeer("idle")
+	idle.pendingRequests.tracker = h.progress
+	require.NoError(t, h.peers.Register(idle))
+
+	start := time.Now()
+	for _, p := range peers {
+		p.pendingRequests.Add(0, GetBlockByHash, common.Hash{0x1}, time.Hour)
+	}
+	require.False(t, h.checkStalledRequests(start.Add(requestsStallTimeout/2)))
+
+	// nobody responds
+	require.True(t, h.checkStalledRequests(start.Add(requestsStallTimeout*2)))
+	require.Equal(t, uint32(1), h.progress.resets)
+	for _, p := range peers {
+		require.Zero(t, p.pendingRequests.Len())
+		require.Eventually(t, func() bool {
+			reason, _ := p.LastDisconnectReason()
+			return reason == DiscStalled
+		}, time.Second, time.Millisecond*10)
+	}
+	reason, _ := idle.LastDisconnectReason()
+	require.NotEqual(t, DiscStalled, reason)
+	require.False(t, h.checkStalledRequests(start.Add(requestsStallTimeout*3)))
+
+	// sync retries with another peer and gets a response
+	retry := idle.pendingRequests.Add(0, GetBlockByHash, common.Hash{0x2}, time.Hour)
+	require.True(t, h.progress.stalled(time.Now().Add(requestsStallTimeout), requestsStallTimeout))
+	require.True(t, idle.pendingRequests.Resolve(GetBlockByHash, retry.seqNo))
+	require.False(t, h.checkStalledRequests(time.Now().Add(requestsStallTimeout*2)))
+}
@@ -0,0 +1,91 @@
+package protocol
+
+import (
+	"github.com/libp2p/go-libp2p-core/peer"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	peerScoreCheckInterval = time.Second * 30
+
+	timeoutScorePenalty     = 10
+	invalidMsgScorePenalty  = 20
+	unsolicitedScorePenalty = 2
+	responseScoreReward     = 1
+	maxPeerScore            = 100
+
+	lowScoreBanDuration = time.Hour
+)
+
+func (p *protoPeer) Score() int32 {
+	return atomic.LoadInt32(&p.score)
+}
+
+func (p *protoPeer) addScore(delta int32) int32 {
+	return atomic.AddInt32(&p.score, delta)
+}
+
+// Penalize decreases the peer score and returns the new one
+func (p *protoPeer) Penalize(points int, reason string) int32 {
+	score := p.addScore(-int32(points))
+	p.log.Debug("Peer penalized", "points", points, "reason", reason, "score", score)
+	return score
+}
+
+// Reward increases the peer score up to maxPeerScore and returns the new one
+func (p *protoPeer) Reward(points int) int32 {
+	for {
+		score := p.Score()
+		next := score + int32(points)
+		if next > maxPeerScore {
+			next = maxPeerScore
+		}
+		if score >= next || atomic.CompareAndSwapInt32(&p.score, score, next) {
+			return next
+		}
+	}
+}
+
+func parseTrustedPeers(ids []string) map[peer.ID]struct{} {
+	result := make(map[peer.ID]struct{}, len(ids))
+	for _, s := range ids {
+		id, err := peer.Decode(s)
+		if err != nil {
+			continue
+		}
+		result[id] = struct{}{}
+	}
+	return result
+}
+
+func (h *IdenaGossipHandler) isTrusted(id peer.ID) bool {
+	h.pinnedMutex.RLock()
+	defer h.pinnedMutex.RUnlock()
+	_, ok := h.trustedPeers[id]
+	return ok
+}
+
+func (h *IdenaGossipHandler) disconnectLowScorePeers() {
+	minScore := int32(h.cfg.MinPeerScore)
+	for _, p := range h.peers.Peers() {
+		if h.isTrusted(p.id) {
+			continue
+		}
+		if score := p.Score(); score < minScore {
+			p.log.Info("Peer score is below minimum", "score", score, "min", minScore)
+			go p.disconnect(DiscLowScore, nil)
+		}
+	}
+}
+
+// penalize decreases the peer score, untrusted peers with score below the minimum are disconnected and banned for lowScoreBanDuration
+func (h *IdenaGossipHandler) penalize(p *protoPeer, points int, reason string) {
+	score := p.Penalize(points, reason)
+	if h.isTrusted(p.id) || score >= int32(h.cfg.MinPeerScore) {
+		return
+	}
+	p.log.Info("Peer is banned due to low score", "score", score, "reason", reason)
+	h.connManager.BanPeerFor(p.id, lowScoreBanDuration)
+	go p.disconnect(DiscLowScore, nil)
+}
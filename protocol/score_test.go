@@ -0,0 +1,167 @@
+package protocol
+
+import (
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/config"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestIdenaGossipHandler_disconnectLowScorePeers(t *testing.T) {
+	h := &IdenaGossipHandler{
+		cfg:          config.P2P{MinPeerScore: -10},
+		peers:        newPeerSet(),
+		trustedPeers: map[peer.ID]struct{}{"trusted": {}},
+	}
+
+	lowScorePeer, lowScoreRemote := newTestPeer("low")
+	trustedPeer, trustedRemote := newTestPeer("trusted")
+	goodPeer, goodRemote := newTestPeer("good")
+	for _, p := range []*protoPeer{lowScorePeer, trustedPeer, goodPeer} {
+		require.NoError(t, h.peers.Register(p))
+	}
+
+	lowScorePeer.addScore(-20)
+	trustedPeer.addScore(-20)
+	goodPeer.addScore(-10)
+
+	h.disconnectLowScorePeers()
+
+	msg, err := lowScoreRemote.ReadMsg()
+	require.NoError(t, err)
+	require.Equal(t, uint64(Disconnect), msg.Code)
+	dc := new(disconnect)
+	require.NoError(t, dc.FromBytes(msg.Payload))
+	require.Equal(t, "low score", dc.Reason)
+
+	_, err = lowScoreRemote.ReadMsg()
+	require.Error(t, err)
+
+	for _, remote := range []*protoPeer{trustedRemote, goodRemote} {
+		require.NoError(t, remote.stream.SetReadDeadline(time.Now().Add(time.Millisecond*200)))
+		_, err := remote.ReadMsg()
+		require.Error(t, err)
+		require.True(t, errors.Is(err, os.ErrDeadlineExceeded))
+	}
+}
+
+func TestProtoPeer_addTimeout(t *testing.T) {
+	p, _ := newTestPeer("peer")
+	require.Equal(t, int32(0), p.Score())
+	p.addTimeout()
+	require.Equal(t, int32(-timeoutScorePenalty), p.Score())
+}
+
+func TestProtoPeer_Reward(t *testing.T) {
+	p, _ := newTestPeer("peer")
+	require.Equal(t, int32(-30), p.Penalize(30, "test"))
+	require.Equal(t, int32(-20), p.Reward(10))
+	require.Equal(t, int32(maxPeerScore), p.Reward(1000))
+}
+
+func TestIdenaGossipHandler_penalize(t *testing.T) {
+	cfg := config.P2P{MinPeerScore: -30}
+	h := &IdenaGossipHandler{
+		cfg:          cfg,
+		peers:        newPeerSet(),
+		connManager:  NewConnManager(nil, cfg),
+		trustedPeers: map[peer.ID]struct{}{},
+	}
+	now := time.Unix(0, 0)
+	h.connManager.bannedPeers.now = func() time.Time {
+		return now
+	}
+	p, remote := newTestPeer("peer")
+	require.NoError(t, h.peers.Register(p))
+
+	h.penalize(p, invalidMsgScorePenalty, "invalid block")
+	require.False(t, h.connManager.IsBanned(p.id))
+
+	h.penalize(p, invalidMsgScorePenalty, "invalid block")
+	require.True(t, h.connManager.IsBanned(p.id))
+	require.False(t, h.connManager.CanConnect(p.id))
+
+	msg, err := remote.ReadMsg()
+	require.NoError(t, err)
+	require.Equal(t, uint64(Disconnect), msg.Code)
+	reason, _ := p.LastDisconnectReason()
+	require.Equal(t, DiscLowScore, reason)
+
+	now = now.Add(lowScoreBanDuration)
+	require.False(t, h.connManager.IsBanned(p.id))
+}
+
+func TestBanList(t *testing.T) {
+	list := newBanList(2)
+	now := time.Unix(0, 0)
+	list.now = func() time.Time {
+		return now
+	}
+
+	list.Add("a", time.Minute)
+	list.Add("b", time.Hour)
+	require.True(t, list.Contains("a"))
+
+	// a shorter ban doesn't cut the existing one
+	list.Add("b", time.Second)
+	now = now.Add(time.Minute)
+	require.False(t, list.Contains("a"))
+	require.True(t, list.Contains("b"))
+
+	list.Add("c", time.Hour)
+	list.Add("d", time.Hour)
+	require.Len(t, list.entries, 2)
+	require.True(t, list.Contains("d"))
+}
+
+func TestBanList_restore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bans.json")
+	expired, _ := peer.Decode("QmNYWtiwM1UfeCmHfWSdefrMuQdg6nycY5yS64HYqWCUhD")
+	banned, _ := peer.Decode("QmQHYY49pWWFeXXdR9rKd31bHRqRi2E4tk4CXDgYJZq5ry")
+	now := time.Unix(0, 0)
+
+	list := newBanList(10)
+	list.now = func() time.Time {
+		return now
+	}
+	list.restore(path)
+	require.Empty(t, list.entries)
+	list.Add(expired, time.Minute)
+	list.Add(banned, time.Hour)
+	list.writes.Wait()
+
+	now = now.Add(time.Minute)
+	restored := newBanList(10)
+	restored.now = list.now
+	restored.restore(path)
+	require.False(t, restored.Contains(expired))
+	require.True(t, restored.Contains(banned))
+
+	// bans are saved only if the file is set
+	restored.Add("other", time.Hour)
+	restored.writes.Wait()
+	newBanList(10).Add("other", time.Hour)
+	restored = newBanList(10)
+	restored.now = list.now
+	restored.restore(path)
+	require.Len(t, restored.entries, 1)
+}
+
+func TestIdenaGossipHandler_unsolicitedBlocksRange(t *testing.T) {
+	h := &IdenaGossipHandler{
+		peers:         newPeerSet(),
+		incomeBatches: &sync.Map{},
+		cfg:           config.P2P{MinPeerScore: -10},
+	}
+	p, remote := newTestPeer("peer")
+	go remote.broadcast()
+	remote.sendMsg(BlocksRange, &blockRange{BatchId: 7}, common.MultiShard, false)
+	require.NoError(t, h.handle(p))
+	require.Equal(t, int32(-unsolicitedScorePenalty), p.Score())
+}
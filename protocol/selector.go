@@ -0,0 +1,66 @@
+package protocol
+
+const peerSelectorLatency = "latency"
+
+// SelectHint describes the range of blocks which is going to be requested from the selected peer
+type SelectHint struct {
+	From uint64
+	To   uint64
+}
+
+// PeerSelector chooses a peer to request a range of blocks from, nil is returned if none of the peers can serve the range
+type PeerSelector interface {
+	Select(peers []*protoPeer, hint SelectHint) *protoPeer
+}
+
+// HighestHeightSelector selects the peer with the highest known height, lower latency wins among peers with equal height
+type HighestHeightSelector struct{}
+
+func (HighestHeightSelector) Select(peers []*protoPeer, hint SelectHint) *protoPeer {
+	var best *protoPeer
+	var bestHeight uint64
+	for _, p := range peers {
+		height := p.knownHeight.Read()
+		if p.observer || height < hint.To {
+			continue
+		}
+		if best == nil || height > bestHeight || height == bestHeight && lowerLatency(p.Latency(), best.Latency()) {
+			best, bestHeight = p, height
+		}
+	}
+	return best
+}
+
+// LowestLatencySelector selects the peer with the lowest latency among peers which have the whole range
+type LowestLatencySelector struct{}
+
+func (LowestLatencySelector) Select(peers []*protoPeer, hint SelectHint) *protoPeer {
+	var best *protoPeer
+	for _, p := range peers {
+		if p.observer || p.knownHeight.Read() < hint.To {
+			continue
+		}
+		if best == nil || lowerLatency(p.Latency(), best.Latency()) {
+			best = p
+		}
+	}
+	return best
+}
+
+// newPeerSelector returns the selector configured by name, peers with the highest height are selected by default
+func newPeerSelector(name string) PeerSelector {
+	switch name {
+	case peerSelectorLatency:
+		return LowestLatencySelector{}
+	default:
+		return HighestHeightSelector{}
+	}
+}
+
+// selector returns the peer selector used for block range requests
+func (h *IdenaGossipHandler) selector() PeerSelector {
+	if h.peerSelector == nil {
+		return HighestHeightSelector{}
+	}
+	return h.peerSelector
+}
@@ -0,0 +1,64 @@
+package protocol
+
+import (
+	"fmt"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func testSelectorPeer(id peer.ID, height uint64, latency time.Duration) *protoPeer {
+	p, _ := newTestPeer(id)
+	p.knownHeight.Store(height)
+	p.latency = int64(latency)
+	return p
+}
+
+func TestPeerSelectors(t *testing.T) {
+	peers := []*protoPeer{
+		testSelectorPeer("behind", 90, time.Millisecond),
+		testSelectorPeer("high-slow", 120, time.Millisecond*300),
+		testSelectorPeer("high-fast", 120, time.Millisecond*50),
+		testSelectorPeer("ahead-fast", 110, time.Millisecond*20),
+		testSelectorPeer("ahead-unmeasured", 110, 0),
+	}
+	observer := testSelectorPeer("observer", 200, time.Millisecond)
+	observer.observer = true
+	peers = append(peers, observer)
+
+	hint := SelectHint{From: 100, To: 105}
+	require.Equal(t, peer.ID("high-fast"), HighestHeightSelector{}.Select(peers, hint).id)
+	require.Equal(t, peer.ID("ahead-fast"), LowestLatencySelector{}.Select(peers, hint).id)
+
+	hint = SelectHint{From: 100, To: 115}
+	require.Equal(t, peer.ID("high-fast"), LowestLatencySelector{}.Select(peers, hint).id)
+
+	hint = SelectHint{From: 130, To: 140}
+	require.Nil(t, HighestHeightSelector{}.Select(peers, hint))
+	require.Nil(t, LowestLatencySelector{}.Select(peers, hint))
+	require.Nil(t, HighestHeightSelector{}.Select(nil, hint))
+
+	require.Equal(t, LowestLatencySelector{}, newPeerSelector("latency"))
+	require.Equal(t, HighestHeightSelector{}, newPeerSelector(""))
+}
+
+func benchmarkPeerSelector(b *testing.B, selector PeerSelector) {
+	peers := make([]*protoPeer, 100)
+	for i := range peers {
+		peers[i] = testSelectorPeer(peer.ID(fmt.Sprintf("peer%v", i)), uint64(1000+i%10), time.Millisecond*time.Duration(i%37+1))
+	}
+	hint := SelectHint{From: 1001, To: 1005}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		selector.Select(peers, hint)
+	}
+}
+
+func BenchmarkHighestHeightSelector(b *testing.B) {
+	benchmarkPeerSelector(b, HighestHeightSelector{})
+}
+
+func BenchmarkLowestLatencySelector(b *testing.B) {
+	benchmarkPeerSelector(b, LowestLatencySelector{})
+}
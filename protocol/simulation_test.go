@@ -0,0 +1,408 @@
+package protocol
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"github.com/idena-network/idena-go/blockchain"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/config"
+	"github.com/idena-network/idena-go/core/appstate"
+	"github.com/idena-network/idena-go/core/flip"
+	"github.com/idena-network/idena-go/core/mempool"
+	"github.com/idena-network/idena-go/crypto"
+	"github.com/idena-network/idena-go/ipfs"
+	"github.com/idena-network/idena-go/pengings"
+	"github.com/idena-network/idena-go/stats/collector"
+	"github.com/libp2p/go-libp2p-core/connmgr"
+	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/peerstore"
+	"github.com/libp2p/go-libp2p-core/protocol"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"io"
+	"math/big"
+	"math/rand"
+	"net"
+	"sync"
+	"testing"
+	"time"
+)
+
+// simLink defines the quality of a simulated connection, both directions have the same latency and loss
+type simLink struct {
+	latency time.Duration
+	// loss is the probability of dropping a message, handshakes are never dropped, so lossy links still connect
+	loss float64
+}
+
+type simFrame struct {
+	data      []byte
+	deliverAt time.Time
+}
+
+// simConn is an end of a simulated connection, every write is a single message which is delivered to the other end
+// after the link latency or dropped, messages are delivered in order
+type simConn struct {
+	net.Conn
+	reader    *io.PipeReader
+	out       chan simFrame
+	link      simLink
+	rand      *rand.Rand
+	randMutex *sync.Mutex
+	written   int
+	dropped   int
+	closeOnce sync.Once
+	closed    chan struct{}
+}
+
+func newSimConns(link simLink, rnd *rand.Rand, randMutex *sync.Mutex) (*simConn, *simConn) {
+	newConn := func() (*simConn, *io.PipeWriter) {
+		reader, writer := io.Pipe()
+		return &simConn{
+			reader:    reader,
+			out:       make(chan simFrame, 10000),
+			link:      link,
+			rand:      rnd,
+			randMutex: randMutex,
+			closed:    make(chan struct{}),
+		}, writer
+	}
+	c1, w1 := newConn()
+	c2, w2 := newConn()
+	go c1.deliver(w2)
+	go c2.deliver(w1)
+	return c1, c2
+}
+
+func (c *simConn) deliver(writer *io.PipeWriter) {
+	defer writer.Close()
+	for {
+		select {
+		case frame := <-c.out:
+			if wait := time.Until(frame.deliverAt); wait > 0 {
+				select {
+				case <-time.After(wait):
+				case <-c.closed:
+					return
+				}
+			}
+			if _, err := writer.Write(frame.data); err != nil {
+				return
+			}
+		case <-c.closed:
+			return
+		}
+	}
+}
+
+func (c *simConn) Read(p []byte) (int, error) {
+	return c.reader.Read(p)
+}
+
+func (c *simConn) Write(p []byte) (int, error) {
+	select {
+	case <-c.closed:
+		return 0, io.ErrClosedPipe
+	default:
+	}
+	c.written++
+	if c.written > 1 && c.drop() {
+		c.dropped++
+		return len(p), nil
+	}
+	data := make([]byte, len(p))
+	copy(data, p)
+	select {
+	case c.out <- simFrame{data: data, deliverAt: time.Now().Add(c.link.latency)}:
+		return len(p), nil
+	case <-c.closed:
+		return 0, io.ErrClosedPipe
+	}
+}
+
+// drop decides whether the message is lost, the shared source makes losses reproducible for the same order of writes
+func (c *simConn) drop() bool {
+	if c.link.loss <= 0 {
+		return false
+	}
+	c.randMutex.Lock()
+	defer c.randMutex.Unlock()
+	return c.rand.Float64() < c.link.loss
+}
+
+func (c *simConn) Close() error {
+	c.closeOnce.Do(func() {
+		close(c.closed)
+		c.reader.Close()
+	})
+	return nil
+}
+
+func (c *simConn) SetReadDeadline(t time.Time) error {
+	return nil
+}
+
+// simHost provides the parts of the host used by the handler when peers are connected directly
+type simHost struct {
+	host.Host
+	id peer.ID
+}
+
+func (h *simHost) ID() peer.ID {
+	return h.id
+}
+
+func (h *simHost) ConnManager() connmgr.ConnManager {
+	return &connmgr.NullConnMgr{}
+}
+
+func (h *simHost) RemoveStreamHandler(protocol.ID) {
+}
+
+func (h *simHost) Addrs() []multiaddr.Multiaddr {
+	return nil
+}
+
+func (h *simHost) Peerstore() peerstore.Peerstore {
+	return simPeerstore{}
+}
+
+// simPeerstore has no addresses, so simulated peers aren't saved to the peer book
+type simPeerstore struct {
+	peerstore.Peerstore
+}
+
+func (simPeerstore) Addrs(peer.ID) []multiaddr.Multiaddr {
+	return nil
+}
+
+type simCeremonyChecker struct{}
+
+func (simCeremonyChecker) IsRunning() bool {
+	return false
+}
+
+// simPeersLimit is the max number of peers of a simulated node
+const simPeersLimit = 16
+
+type simNode struct {
+	id       peer.ID
+	handler  *IdenaGossipHandler
+	chain    *blockchain.TestBlockchain
+	appState *appstate.AppState
+	txpool   *mempool.TxPool
+}
+
+// simNetwork runs gossip handlers of several nodes in-process, the nodes share the genesis and are connected
+// by simulated links instead of libp2p streams
+type simNetwork struct {
+	t     *testing.T
+	nodes []*simNode
+	// key owns the balance allocated in the genesis, it's used to send transactions
+	key       *ecdsa.PrivateKey
+	rand      *rand.Rand
+	randMutex sync.Mutex
+}
+
+func newSimNetwork(t *testing.T, size int, seed int64) *simNetwork {
+	key, _ := crypto.GenerateKey()
+	alloc := map[common.Address]config.GenesisAllocation{
+		crypto.PubkeyToAddress(key.PublicKey): {Balance: new(big.Int).Mul(big.NewInt(1000), common.DnaBase)},
+	}
+	base, appState, txpool, _ := blockchain.NewTestBlockchain(true, alloc)
+	network := &simNetwork{
+		t:    t,
+		key:  key,
+		rand: rand.New(rand.NewSource(seed)),
+	}
+	for i := 0; i < size; i++ {
+		node := &simNode{
+			id:       peer.ID(fmt.Sprintf("node%d", i)),
+			chain:    base,
+			appState: appState,
+			txpool:   txpool,
+		}
+		if i > 0 {
+			node.chain, node.appState = base.Copy()
+			node.txpool = node.chain.TxPool()
+		}
+		node.handler = network.newHandler(node)
+		network.nodes = append(network.nodes, node)
+	}
+	t.Cleanup(network.stop)
+	return network
+}
+
+func (n *simNetwork) newHandler(node *simNode) *IdenaGossipHandler {
+	cfg := config.P2P{
+		MaxInboundPeers:          simPeersLimit,
+		MaxOutboundPeers:         simPeersLimit,
+		MaxInboundOwnShardPeers:  simPeersLimit,
+		MaxOutboundOwnShardPeers: simPeersLimit,
+		Multishard:               true,
+		MinPeerScore:             config.DefaultMinPeerScore,
+	}
+	proposals, _ := pengings.NewProposals(node.chain.Blockchain, node.appState, nil, nil, nil)
+	votes := pengings.NewVotes(node.appState, node.chain.Bus(), nil, nil)
+	keysPool := mempool.NewKeysPool(node.chain.Db(), node.appState, node.chain.Bus(), node.chain.SecStore())
+	h := NewIdenaGossipHandler(&simHost{id: node.id}, nil, cfg, node.chain.Blockchain, proposals, votes, node.txpool, nil,
+		node.chain.Bus(), keysPool, "0.1.0", simCeremonyChecker{})
+	h.metrics = newTestMetrics()
+	h.startGossip()
+	return h
+}
+
+// connect links two nodes and waits for both handshakes
+func (n *simNetwork) connect(a, b int, link simLink) {
+	local, remote := n.nodes[a], n.nodes[b]
+	c1, c2 := newSimConns(link, n.rand, &n.randMutex)
+	outbound := &testStream{conn: c1, c: &testConn{remote: remote.id}}
+	inbound := &testStream{conn: c2, c: &testConn{remote: local.id}}
+	errs := make(chan error, 2)
+	go func() {
+		_, err := local.handler.runPeer(outbound, false)
+		errs <- err
+	}()
+	go func() {
+		_, err := remote.handler.runPeer(inbound, true)
+		errs <- err
+	}()
+	for i := 0; i < 2; i++ {
+		require.NoError(n.t, <-errs)
+	}
+}
+
+// line connects the nodes one by one, so a message passes all links to reach the last node from the first one
+func (n *simNetwork) line(link simLink) {
+	for i := 1; i < len(n.nodes); i++ {
+		n.connect(i-1, i, link)
+	}
+}
+
+func (n *simNetwork) stop() {
+	for _, node := range n.nodes {
+		node.handler.Stop()
+	}
+}
+
+func (n *simNetwork) sendTx(node int, nonce uint32) *types.Transaction {
+	from := crypto.PubkeyToAddress(n.key.PublicKey)
+	to := common.Address{0x1}
+	appState := n.nodes[node].appState
+	tx, err := types.SignTx(blockchain.BuildTx(appState, from, &to, types.SendTx, decimal.New(1, 0), decimal.New(20, 0), decimal.Zero, nonce, 0, nil), n.key)
+	require.NoError(n.t, err)
+	require.NoError(n.t, n.nodes[node].txpool.AddInternalTx(tx))
+	return tx
+}
+
+func TestSimConn_loss(t *testing.T) {
+	dropped := func(seed int64) int {
+		c1, c2 := newSimConns(simLink{loss: 0.5}, rand.New(rand.NewSource(seed)), &sync.Mutex{})
+		defer c1.Close()
+		defer c2.Close()
+		for i := 0; i < 100; i++ {
+			_, err := c1.Write([]byte{byte(i)})
+			require.NoError(t, err)
+		}
+		return c1.dropped
+	}
+	count := dropped(1)
+	require.Equal(t, count, dropped(1))
+	require.Greater(t, count, 0)
+	require.Less(t, count, 99)
+
+	// the handshake is never dropped
+	c1, c2 := newSimConns(simLink{loss: 1}, rand.New(rand.NewSource(1)), &sync.Mutex{})
+	defer c1.Close()
+	defer c2.Close()
+	_, err := c1.Write([]byte{1})
+	require.NoError(t, err)
+	_, err = c1.Write([]byte{2})
+	require.NoError(t, err)
+	buf := make([]byte, 1)
+	_, err = c2.Read(buf)
+	require.NoError(t, err)
+	require.Equal(t, []byte{1}, buf)
+	require.Equal(t, 1, c1.dropped)
+}
+
+func TestSimConn_latency(t *testing.T) {
+	c1, c2 := newSimConns(simLink{latency: time.Millisecond * 50}, nil, nil)
+	defer c1.Close()
+	defer c2.Close()
+	start := time.Now()
+	_, err := c1.Write([]byte{1, 2, 3})
+	require.NoError(t, err)
+	buf := make([]byte, 3)
+	_, err = io.ReadFull(c2, buf)
+	require.NoError(t, err)
+	require.Equal(t, []byte{1, 2, 3}, buf)
+	require.GreaterOrEqual(t, time.Since(start), time.Millisecond*50)
+}
+
+func TestSimulation_txPropagation(t *testing.T) {
+	network := newSimNetwork(t, 4, 1)
+	latency := time.Millisecond * 50
+	network.line(simLink{latency: latency})
+
+	start := time.Now()
+	tx := network.sendTx(0, 1)
+	for _, node := range network.nodes {
+		node := node
+		require.Eventually(t, func() bool {
+			return node.txpool.GetTx(tx.Hash()) != nil
+		}, time.Second*10, time.Millisecond*10, "node %v", node.id)
+	}
+	// every hop takes at least the latency of the link
+	require.GreaterOrEqual(t, time.Since(start), latency*time.Duration(len(network.nodes)-1))
+}
+
+func TestSimulation_blocksRange(t *testing.T) {
+	network := newSimNetwork(t, 2, 1)
+	network.connect(0, 1, simLink{latency: time.Millisecond * 10})
+	source, target := network.nodes[0], network.nodes[1]
+	from := source.chain.Head.Height() + 1
+	source.chain.GenerateEmptyBlocks(5)
+
+	b, err := target.handler.GetBlocksRange(source.id, from, source.chain.Head.Height())
+	require.NoError(t, err)
+	for i := 0; i < 5; i++ {
+		select {
+		case block := <-b.headers:
+			// bodies of empty blocks are empty, so they aren't loaded from ipfs
+			require.NoError(t, target.chain.AddBlock(&types.Block{Header: block.Header, Body: &types.Body{}}, nil, collector.NewStatsCollector()))
+		case <-time.After(time.Second * 5):
+			require.Fail(t, "blocks range is not received")
+		}
+	}
+	require.Equal(t, source.chain.Head.Hash(), target.chain.Head.Hash())
+}
+
+func TestSimulation_flipRequest(t *testing.T) {
+	network := newSimNetwork(t, 2, 1)
+	for _, node := range network.nodes {
+		node.handler.flipper = flip.NewFlipper(node.chain.Db(), ipfs.NewMemoryIpfsProxy(), nil, node.txpool, node.chain.SecStore(), node.appState, node.chain.Bus())
+	}
+	network.connect(0, 1, simLink{latency: time.Millisecond * 10})
+	source, target := network.nodes[0], network.nodes[1]
+
+	data, _ := (&flip.IpfsFlip{PubKey: []byte{0x1}, PublicPart: []byte{0x2}, PrivatePart: []byte{0x3}}).ToBytes()
+	c, err := ipfs.NewMemoryIpfsProxy().Cid(data)
+	require.NoError(t, err)
+	require.Error(t, source.handler.flipper.AddFlipFromPeer(c.Bytes(), append(data, 0x4)))
+	require.NoError(t, source.handler.flipper.AddFlipFromPeer(c.Bytes(), data))
+
+	missing, _ := ipfs.NewMemoryIpfsProxy().Cid([]byte{0x5})
+	target.handler.RequestFlip(missing.Bytes())
+	target.handler.RequestFlip(c.Bytes())
+	require.Eventually(t, func() bool {
+		return target.handler.flipper.IsFlipAvailable(c.Bytes())
+	}, time.Second*5, time.Millisecond*10)
+	received, ok := target.handler.flipper.GetRawFlipFromMemory(c.Bytes())
+	require.True(t, ok)
+	require.Equal(t, data, received)
+	require.False(t, target.handler.flipper.IsFlipAvailable(missing.Bytes()))
+}
@@ -0,0 +1,23 @@
+package protocol
+
+import (
+	"github.com/idena-network/idena-go/common"
+	models "github.com/idena-network/idena-go/protobuf"
+)
+
+// provideStateProof sends Merkle proofs of the address state at the head block, so a light client can verify
+// the account and the identity against the state root of the block header
+func (h *IdenaGossipHandler) provideStateProof(p *protoPeer, query *models.ProtoGetStateProofRequest) {
+	proof, err := h.bcn.GetStateProof(common.BytesToAddress(query.Address))
+	if err != nil {
+		p.log.Debug("Failed to get state proof", "err", err)
+		return
+	}
+	p.sendMsg(StateProof, &models.ProtoStateProof{
+		Id:       query.Id,
+		Height:   proof.Height,
+		Root:     proof.Root.Bytes(),
+		Account:  proof.Account,
+		Identity: proof.Identity,
+	}, common.MultiShard, false)
+}
@@ -0,0 +1,48 @@
+package protocol
+
+import (
+	"github.com/idena-network/idena-go/common"
+	models "github.com/idena-network/idena-go/protobuf"
+	"time"
+)
+
+// statusInterval is the period of status messages, it's longer than the block time, so a status is sent
+// in addition to block announcements only if the peer misses some of them
+const statusInterval = 30 * time.Second
+
+// setHead records the head reported by the peer, the hash is updated along with the highest known height only
+func (p *protoPeer) setHead(height uint64, hash common.Hash) {
+	p.setHeight(height)
+	p.headMutex.Lock()
+	defer p.headMutex.Unlock()
+	if height == p.knownHeight.Read() {
+		p.headHash = hash
+	}
+}
+
+// HeadHash returns the hash of the peer head at the known height, an empty hash means that the peer hasn't reported it
+func (p *protoPeer) HeadHash() common.Hash {
+	p.headMutex.RLock()
+	defer p.headMutex.RUnlock()
+	return p.headHash
+}
+
+// statusLoop sends the own head to the peer periodically, so the peer's view of the node height stays fresh
+func (h *IdenaGossipHandler) statusLoop(p *protoPeer) {
+	ticker := time.NewTicker(statusInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			h.sendStatus(p)
+		case <-p.term:
+			return
+		}
+	}
+}
+
+func (h *IdenaGossipHandler) sendStatus(p *protoPeer) {
+	head := h.bcn.Head
+	hash := head.Hash()
+	p.sendMsg(Status, &models.ProtoStatus{Height: head.Height(), HeadHash: hash[:]}, common.MultiShard, false)
+}
@@ -0,0 +1,45 @@
+package protocol
+
+import (
+	"github.com/idena-network/idena-go/common"
+	models "github.com/idena-network/idena-go/protobuf"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestProtoPeer_setHead(t *testing.T) {
+	p, _ := newTestPeer("peer")
+	p.setHead(10, common.Hash{0x1})
+	require.Equal(t, uint64(10), p.knownHeight.Read())
+	require.Equal(t, common.Hash{0x1}, p.HeadHash())
+
+	// a stale status doesn't replace the head
+	p.setHead(9, common.Hash{0x2})
+	require.Equal(t, uint64(10), p.knownHeight.Read())
+	require.Equal(t, common.Hash{0x1}, p.HeadHash())
+
+	p.setHead(10, common.Hash{0x3})
+	require.Equal(t, common.Hash{0x3}, p.HeadHash())
+
+	p.setHead(11, common.Hash{0x4})
+	require.Equal(t, uint64(11), p.knownHeight.Read())
+	require.Equal(t, common.Hash{0x4}, p.HeadHash())
+}
+
+func TestIdenaGossipHandler_status(t *testing.T) {
+	h := &IdenaGossipHandler{
+		peers: newPeerSet(),
+	}
+	p, remote := newTestPeer("peer")
+	go remote.broadcast()
+	go func() {
+		for h.handle(p) == nil {
+		}
+	}()
+
+	remote.sendMsg(Status, &models.ProtoStatus{Height: 20, HeadHash: common.Hash{0x1}.Bytes()}, common.MultiShard, false)
+	require.Eventually(t, func() bool {
+		return p.knownHeight.Read() == 20 && p.HeadHash() == common.Hash{0x1}
+	}, time.Second, time.Millisecond*10)
+}
@@ -11,11 +11,13 @@ import (
 const (
 	DecodeErr                  = 1
 	ValidationErr              = 2
+	PanicErr                   = 3
 	MaxTimestampLagSeconds     = 15
 	MaxBannedPeers             = 500000
 	IdenaProtocolWeight        = 25
 	ReconnectAfterDiscTimeout  = time.Minute * 1
 	ReconnectAfterResetTimeout = time.Minute * 3
+	DefaultBanDuration         = time.Hour * 24
 )
 
 type CeremonyChecker interface {
@@ -55,25 +57,35 @@ func (msg *Msg) FromBytes(data []byte) error {
 }
 
 type handshakeData struct {
-	NetworkId    types.Network
-	Height       uint64
-	GenesisBlock common.Hash
-	Timestamp    int64
-	AppVersion   string
-	Peers        uint32
-	OldGenesis   *common.Hash
-	ShardId      common.ShardId
+	NetworkId       types.Network
+	Height          uint64
+	HeadHash        common.Hash
+	GenesisBlock    common.Hash
+	Timestamp       int64
+	AppVersion      string
+	Peers           uint32
+	OldGenesis      *common.Hash
+	ShardId         common.ShardId
+	ProtocolVersion uint32
+	Light           bool
+	ServesLight     bool
+	ListenAddrs     [][]byte
 }
 
 func (h *handshakeData) ToBytes() ([]byte, error) {
 	protoHandshake := &models.ProtoHandshake{
-		NetworkId:  h.NetworkId,
-		Height:     h.Height,
-		Genesis:    h.GenesisBlock[:],
-		Timestamp:  h.Timestamp,
-		AppVersion: h.AppVersion,
-		Peers:      h.Peers,
-		ShardId:    uint32(h.ShardId),
+		NetworkId:       h.NetworkId,
+		Height:          h.Height,
+		HeadHash:        h.HeadHash[:],
+		Genesis:         h.GenesisBlock[:],
+		Timestamp:       h.Timestamp,
+		AppVersion:      h.AppVersion,
+		Peers:           h.Peers,
+		ShardId:         uint32(h.ShardId),
+		ProtocolVersion: h.ProtocolVersion,
+		Light:           h.Light,
+		ServesLight:     h.ServesLight,
+		ListenAddrs:     h.ListenAddrs,
 	}
 	if h.OldGenesis != nil {
 		protoHandshake.OldGenesis = h.OldGenesis.Bytes()
@@ -88,6 +100,7 @@ func (h *handshakeData) FromBytes(data []byte) error {
 	}
 	h.NetworkId = protoHandshake.NetworkId
 	h.Height = protoHandshake.Height
+	h.HeadHash = common.BytesToHash(protoHandshake.HeadHash)
 	h.GenesisBlock = common.BytesToHash(protoHandshake.Genesis)
 	h.Timestamp = protoHandshake.Timestamp
 	h.AppVersion = protoHandshake.AppVersion
@@ -97,6 +110,10 @@ func (h *handshakeData) FromBytes(data []byte) error {
 		h.OldGenesis.SetBytes(protoHandshake.OldGenesis)
 	}
 	h.ShardId = common.ShardId(protoHandshake.ShardId)
+	h.ProtocolVersion = protoHandshake.ProtocolVersion
+	h.Light = protoHandshake.Light
+	h.ServesLight = protoHandshake.ServesLight
+	h.ListenAddrs = protoHandshake.ListenAddrs
 	return nil
 }
 
@@ -214,3 +231,26 @@ func (d *disconnect) FromBytes(data []byte) error {
 	d.Reason = protoObj.Reason
 	return nil
 }
+
+type newBlockHash struct {
+	Hash   common.Hash
+	Height uint64
+}
+
+func (n *newBlockHash) ToBytes() ([]byte, error) {
+	protoObj := &models.ProtoNewBlockHash{
+		Hash:   n.Hash[:],
+		Height: n.Height,
+	}
+	return proto.Marshal(protoObj)
+}
+
+func (n *newBlockHash) FromBytes(data []byte) error {
+	protoObj := new(models.ProtoNewBlockHash)
+	if err := proto.Unmarshal(data, protoObj); err != nil {
+		return err
+	}
+	n.Hash = common.BytesToHash(protoObj.Hash)
+	n.Height = protoObj.Height
+	return nil
+}
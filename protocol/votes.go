@@ -0,0 +1,78 @@
+package protocol
+
+import (
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/patrickmn/go-cache"
+	"time"
+)
+
+const (
+	voteWorkersCount     = 4
+	voteWorkersQueueSize = 1000
+	verifiedVotersTTL    = time.Minute
+)
+
+// startVoteWorkers verifies signatures of incoming votes on a pool of workers, so the handler only enqueues votes and
+// the consensus receives them with recovered voters, a batch of votes is verified by a single worker
+func (h *IdenaGossipHandler) startVoteWorkers() {
+	h.verifiedVoters = cache.New(verifiedVotersTTL, verifiedVotersTTL*2)
+	h.voteBatches = make(chan []*types.Vote, voteWorkersQueueSize)
+	for i := 0; i < voteWorkersCount; i++ {
+		go func() {
+			for votes := range h.voteBatches {
+				h.processVotes(votes)
+			}
+		}()
+	}
+}
+
+// handleVotes passes the votes to the workers, the votes are processed by the caller if the workers are overloaded
+func (h *IdenaGossipHandler) handleVotes(votes []*types.Vote) {
+	if !h.enqueueVotes(votes) {
+		h.processVotes(votes)
+	}
+}
+
+// enqueueVotes passes the votes to the workers, false means that the votes should be processed by the caller
+func (h *IdenaGossipHandler) enqueueVotes(votes []*types.Vote) bool {
+	if h.voteBatches == nil {
+		return false
+	}
+	select {
+	case h.voteBatches <- votes:
+		return true
+	default:
+		return false
+	}
+}
+
+func (h *IdenaGossipHandler) processVotes(votes []*types.Vote) {
+	for _, vote := range votes {
+		if !h.verifyVote(vote) {
+			continue
+		}
+		if h.votes.AddVote(vote) {
+			h.SendVote(vote)
+		}
+	}
+}
+
+// verifyVote recovers the voter from the signature, a voter of the vote received before is taken from the cache
+func (h *IdenaGossipHandler) verifyVote(vote *types.Vote) bool {
+	if h.verifiedVoters == nil {
+		return vote.VoterAddr() != common.Address{}
+	}
+	hash := vote.Hash128()
+	key := string(hash[:])
+	if addr, ok := h.verifiedVoters.Get(key); ok {
+		vote.SetVoterAddr(addr.(common.Address))
+		return true
+	}
+	addr := vote.VoterAddr()
+	if addr == (common.Address{}) {
+		return false
+	}
+	h.verifiedVoters.SetDefault(key, addr)
+	return true
+}
@@ -0,0 +1,48 @@
+package protocol
+
+import (
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/crypto"
+	"github.com/patrickmn/go-cache"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestIdenaGossipHandler_enqueueVotes(t *testing.T) {
+	h := &IdenaGossipHandler{}
+	votes := []*types.Vote{{Header: &types.VoteHeader{Round: 1}}}
+	require.False(t, h.enqueueVotes(votes))
+
+	h.voteBatches = make(chan []*types.Vote, 1)
+	require.True(t, h.enqueueVotes(votes))
+	// the caller processes the votes when the workers are overloaded
+	require.False(t, h.enqueueVotes(votes))
+	require.Equal(t, votes, <-h.voteBatches)
+}
+
+func TestIdenaGossipHandler_verifyVote(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	vote := &types.Vote{Header: &types.VoteHeader{Round: 1, VotedHash: common.Hash{0x1}}}
+	hash := crypto.SignatureHash(vote)
+	vote.Signature, _ = crypto.Sign(hash[:], key)
+	data, _ := vote.ToBytes()
+
+	h := &IdenaGossipHandler{verifiedVoters: cache.New(verifiedVotersTTL, verifiedVotersTTL)}
+	require.True(t, h.verifyVote(vote))
+	require.Equal(t, crypto.PubkeyToAddress(key.PublicKey), vote.VoterAddr())
+	require.Equal(t, 1, h.verifiedVoters.ItemCount())
+
+	// the voter of the same vote is taken from the cache
+	received := new(types.Vote)
+	require.NoError(t, received.FromBytes(data))
+	cached := common.Address{0x2}
+	voteHash := received.Hash128()
+	h.verifiedVoters.SetDefault(string(voteHash[:]), cached)
+	require.True(t, h.verifyVote(received))
+	require.Equal(t, cached, received.VoterAddr())
+
+	invalid := &types.Vote{Header: &types.VoteHeader{Round: 1}, Signature: []byte{0x1}}
+	require.False(t, h.verifyVote(invalid))
+	require.Equal(t, 1, h.verifiedVoters.ItemCount())
+}
@@ -0,0 +1,55 @@
+package protocol
+
+import (
+	"github.com/idena-network/idena-go/common"
+)
+
+const (
+	// maxDecodedMsgSize limits the size of a decompressed message, so a small compressed message can't allocate
+	// much memory. Messages on the wire are limited to 8MB by the stream reader
+	maxDecodedMsgSize = 32 * 1024 * 1024
+	// maxBatchItems limits the number of pushes and flip keys of a batch, peers send at most 100 of them
+	maxBatchItems = 1000
+)
+
+// maxPayloadSizes limits payloads of messages which are small by design, a larger payload can't be produced
+// by a valid peer, so the peer is penalized and disconnected. Payloads of other messages are limited by maxDecodedMsgSize
+var maxPayloadSizes = map[uint64]int{
+	Handshake:             64 * 1024,
+	ProposeProof:          4 * 1024,
+	Vote:                  4 * 1024,
+	BatchVote:             voteBatchSize * 4 * 1024,
+	NewTx:                 1024 * 1024,
+	GetBlockByHash:        1024,
+	GetBlocksRange:        1024,
+	GetForkBlockRange:     1024 * 1024,
+	FlipBody:              2 * 1024 * 1024,
+	FlipKey:               64 * 1024,
+	Push:                  1024,
+	Pull:                  1024,
+	BatchPush:             256 * 1024,
+	BatchFlipKey:          4 * 1024 * 1024,
+	UpdateShardId:         1024,
+	Disconnect:            64 * 1024,
+	NewBlockHash:          1024,
+	Ping:                  1024,
+	Pong:                  1024,
+	GetTransactions:       64 * 1024,
+	GetPooledTransactions: 1024,
+	GetStateProof:         1024,
+	GetBlockHeaders:       1024,
+	Status:                1024,
+	GetFlip:               1024,
+	Flip:                  2 * common.MaxFlipSize,
+}
+
+func checkPayloadSize(code uint64, size int) error {
+	limit, ok := maxPayloadSizes[code]
+	if !ok {
+		limit = maxDecodedMsgSize
+	}
+	if size > limit {
+		return errResp(ValidationErr, "%v payload is too big: %v, max %v", msgCodeToString(code), size, limit)
+	}
+	return nil
+}
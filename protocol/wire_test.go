@@ -0,0 +1,389 @@
+package protocol
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/config"
+	"github.com/idena-network/idena-go/core/state/snapshot"
+	"github.com/idena-network/idena-go/crypto"
+	models "github.com/idena-network/idena-go/protobuf"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"math/big"
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+)
+
+var errInvalidPayload = errors.New("invalid payload")
+
+// msgDecoders decode and check payloads the same way as the message handler does before a message is processed,
+// accessors used by the handler are called as well, so a decoded struct which would crash the handler panics here
+var msgDecoders = map[uint64]func(payload []byte) error{
+	Handshake: func(payload []byte) error {
+		return new(handshakeData).FromBytes(payload)
+	},
+	ProposeBlock: func(payload []byte) error {
+		proposal := new(types.BlockProposal)
+		if err := proposal.FromBytes(payload); err != nil {
+			return err
+		}
+		if !proposal.IsValid() {
+			return errInvalidPayload
+		}
+		proposal.Block.Height()
+		proposal.Block.Hash()
+		return nil
+	},
+	ProposeProof: func(payload []byte) error {
+		return new(types.ProofProposal).FromBytes(payload)
+	},
+	Vote: decodeTestVote,
+	BatchVote: func(payload []byte) error {
+		return decodeTestBatch(payload, voteBatchSize, decodeTestVote)
+	},
+	NewTx: func(payload []byte) error {
+		tx := new(types.Transaction)
+		if err := tx.FromBytes(payload); err != nil {
+			return err
+		}
+		tx.Hash()
+		types.Sender(tx)
+		return nil
+	},
+	GetBlockByHash:        protoDecoder(func() proto.Message { return new(models.ProtoGetBlockByHashRequest) }),
+	GetTransactions:       protoDecoder(func() proto.Message { return new(models.ProtoGetTransactionsRequest) }),
+	GetPooledTransactions: protoDecoder(func() proto.Message { return new(models.ProtoGetPooledTransactionsRequest) }),
+	PooledTransactions:    protoDecoder(func() proto.Message { return new(models.ProtoPooledTransactions) }),
+	GetStateProof:         protoDecoder(func() proto.Message { return new(models.ProtoGetStateProofRequest) }),
+	StateProof:            protoDecoder(func() proto.Message { return new(models.ProtoStateProof) }),
+	GetBlockHeaders:       protoDecoder(func() proto.Message { return new(models.ProtoGetBlockHeadersRequest) }),
+	BlockHeaders:          protoDecoder(func() proto.Message { return new(models.ProtoBlockHeaders) }),
+	GetFlip:               protoDecoder(func() proto.Message { return new(models.ProtoGetFlipRequest) }),
+	Flip:                  protoDecoder(func() proto.Message { return new(models.ProtoFlipResponse) }),
+	Ping:                  protoDecoder(func() proto.Message { return new(models.ProtoPing) }),
+	Pong:                  protoDecoder(func() proto.Message { return new(models.ProtoPing) }),
+	Status:                protoDecoder(func() proto.Message { return new(models.ProtoStatus) }),
+	GetBlocksRange:        protoDecoder(func() proto.Message { return new(models.ProtoGetBlocksRangeRequest) }),
+	GetForkBlockRange:     protoDecoder(func() proto.Message { return new(models.ProtoGetForkBlockRangeRequest) }),
+	Transactions: func(payload []byte) error {
+		response := new(models.ProtoTransactions)
+		if err := proto.Unmarshal(payload, response); err != nil {
+			return err
+		}
+		for _, protoTx := range response.Transactions {
+			if _, err := new(types.Transaction).FromProto(protoTx).ToBytes(); err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+	BlocksRange: func(payload []byte) error {
+		response := new(blockRange)
+		if err := response.FromBytes(payload); err != nil {
+			return err
+		}
+		if !response.IsValid() {
+			return errInvalidPayload
+		}
+		for _, b := range response.Blocks {
+			b.Header.Height()
+			b.Header.Hash()
+		}
+		return nil
+	},
+	FlipBody: func(payload []byte) error {
+		f := new(types.Flip)
+		if err := f.FromBytes(payload); err != nil {
+			return err
+		}
+		if !f.IsValid() {
+			return errInvalidPayload
+		}
+		f.Hash128()
+		return nil
+	},
+	FlipKey: decodeTestFlipKey,
+	BatchFlipKey: func(payload []byte) error {
+		return decodeTestBatch(payload, maxBatchItems, decodeTestFlipKey)
+	},
+	SnapshotManifest: func(payload []byte) error {
+		return new(snapshot.Manifest).FromBytes(payload)
+	},
+	FlipKeysPackage: func(payload []byte) error {
+		keysPackage := new(types.PrivateFlipKeysPackage)
+		if err := keysPackage.FromBytes(payload); err != nil {
+			return err
+		}
+		keysPackage.Hash128()
+		return nil
+	},
+	Push: decodeTestPushPullHash,
+	Pull: decodeTestPushPullHash,
+	BatchPush: func(payload []byte) error {
+		return decodeTestBatch(payload, maxBatchItems, decodeTestPushPullHash)
+	},
+	Block: func(payload []byte) error {
+		block := new(types.Block)
+		if err := block.FromBytes(payload); err != nil {
+			return err
+		}
+		if !block.IsValid() {
+			return errInvalidPayload
+		}
+		block.Hash()
+		block.Height()
+		return nil
+	},
+	UpdateShardId: func(payload []byte) error {
+		return new(updateShardId).FromBytes(payload)
+	},
+	Disconnect: func(payload []byte) error {
+		return new(disconnect).FromBytes(payload)
+	},
+	NewBlockHash: func(payload []byte) error {
+		return new(newBlockHash).FromBytes(payload)
+	},
+}
+
+func protoDecoder(create func() proto.Message) func(payload []byte) error {
+	return func(payload []byte) error {
+		return proto.Unmarshal(payload, create())
+	}
+}
+
+func decodeTestVote(payload []byte) error {
+	vote := new(types.Vote)
+	if err := vote.FromBytes(payload); err != nil {
+		return err
+	}
+	if !vote.IsValid() {
+		return errInvalidPayload
+	}
+	vote.Hash()
+	return nil
+}
+
+func decodeTestFlipKey(payload []byte) error {
+	return new(types.PublicFlipKey).FromBytes(payload)
+}
+
+func decodeTestPushPullHash(payload []byte) error {
+	hash := new(pushPullHash)
+	if err := hash.FromBytes(payload); err != nil {
+		return err
+	}
+	if !hash.IsValid() {
+		return errInvalidPayload
+	}
+	return nil
+}
+
+func decodeTestBatch(payload []byte, maxItems int, decodeItem func([]byte) error) error {
+	batch := new(msgBatch)
+	if err := batch.FromBytes(payload); err != nil {
+		return err
+	}
+	if len(batch.Data) > maxItems {
+		return errInvalidPayload
+	}
+	for _, item := range batch.Data {
+		if err := decodeItem(item.Payload); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// testPayloads returns valid payloads of messages, they are the seeds of the decoder fuzzing
+func testPayloads(t testing.TB) map[uint64][]byte {
+	key, _ := crypto.GenerateKey()
+	to := common.Address{0x1}
+	tx, err := types.SignTx(&types.Transaction{AccountNonce: 1, To: &to, Amount: big.NewInt(10), Payload: []byte{0x1}}, key)
+	require.NoError(t, err)
+	header := &types.Header{ProposedHeader: &types.ProposedHeader{Height: 2, ProposerPubKey: crypto.FromECDSAPub(&key.PublicKey)}}
+	proposal := &types.BlockProposal{Block: &types.Block{Header: header, Body: &types.Body{Transactions: []*types.Transaction{tx}}}, Proof: []byte{0x1}}
+	proposalHash := crypto.SignatureHash(proposal)
+	proposal.Signature, err = crypto.Sign(proposalHash[:], key)
+	require.NoError(t, err)
+	vote := &types.Vote{Header: &types.VoteHeader{Round: 2, Step: 1}, Signature: []byte{0x1, 0x2}}
+	voteData, _ := vote.ToBytes()
+	push, _ := (&pushPullHash{Type: pushTx, Hash: tx.Hash128()}).ToBytes()
+	flipKey, _ := (&types.PublicFlipKey{Key: []byte{0x1}, Signature: []byte{0x2}}).ToBytes()
+
+	payloads := map[uint64]interface{}{
+		Handshake:        &handshakeData{NetworkId: 1, Height: 2, AppVersion: "0.1.0", ProtocolVersion: CurrentProtocolVersion},
+		ProposeBlock:     proposal,
+		ProposeProof:     &types.ProofProposal{Proof: []byte{0x1}, Round: 2, Signature: []byte{0x2}},
+		Vote:             vote,
+		BatchVote:        &msgBatch{Data: []*batchItem{{Payload: voteData}, {Payload: voteData}}},
+		NewTx:            tx,
+		BlocksRange:      &blockRange{BatchId: 1, Blocks: []*block{{Header: header}}},
+		FlipBody:         &types.Flip{Tx: tx, PublicPart: []byte{0x1}, PrivatePart: []byte{0x2}},
+		FlipKey:          &types.PublicFlipKey{Key: []byte{0x1}, Signature: []byte{0x2}},
+		FlipKeysPackage:  &types.PrivateFlipKeysPackage{Data: []byte{0x1}, Signature: []byte{0x2}},
+		SnapshotManifest: &snapshot.Manifest{Cid: []byte{0x1}, Root: common.Hash{0x2}, Height: 3},
+		Push:             pushPullHash{Type: pushTx, Hash: tx.Hash128()},
+		Pull:             pushPullHash{Type: pushTx, Hash: tx.Hash128()},
+		BatchPush:        &msgBatch{Data: []*batchItem{{Payload: push}}},
+		BatchFlipKey:     &msgBatch{Data: []*batchItem{{Payload: flipKey}}},
+		Block:            &types.Block{Header: header, Body: &types.Body{}},
+		UpdateShardId:    &updateShardId{ShardId: 2},
+		Disconnect:       &disconnect{Reason: "reason"},
+		NewBlockHash:     &newBlockHash{Hash: common.Hash{0x1}, Height: 2},
+	}
+	result := make(map[uint64][]byte)
+	for code, payload := range payloads {
+		data, err := toBytes(code, payload)
+		require.NoError(t, err)
+		result[code] = data
+	}
+	protoPayloads := map[uint64]proto.Message{
+		GetBlockByHash:    &models.ProtoGetBlockByHashRequest{Hash: common.Hash{0x1}.Bytes()},
+		GetBlocksRange:    &models.ProtoGetBlocksRangeRequest{BatchId: 1, From: 1, To: 10},
+		GetForkBlockRange: &models.ProtoGetForkBlockRangeRequest{BatchId: 1, Blocks: [][]byte{common.Hash{0x1}.Bytes()}},
+		Transactions:      &models.ProtoTransactions{Id: 1, Transactions: []*models.ProtoTransaction{tx.ToProto()}},
+		Ping:              &models.ProtoPing{Nonce: 1},
+		Pong:              &models.ProtoPing{Nonce: 1},
+		Status:            &models.ProtoStatus{Height: 1, HeadHash: common.Hash{0x1}.Bytes()},
+		GetFlip:           &models.ProtoGetFlipRequest{Id: 1, Cid: []byte{0x1}},
+		Flip:              &models.ProtoFlipResponse{Id: 1, Cid: []byte{0x1}, Data: []byte{0x2}},
+	}
+	for code, payload := range protoPayloads {
+		data, err := proto.Marshal(payload)
+		require.NoError(t, err)
+		result[code] = data
+	}
+	return result
+}
+
+func sortedCodes(payloads map[uint64][]byte) []uint64 {
+	var codes []uint64
+	for code := range payloads {
+		codes = append(codes, code)
+	}
+	sort.Slice(codes, func(i, j int) bool {
+		return codes[i] < codes[j]
+	})
+	return codes
+}
+
+func TestMsgDecoders_seeds(t *testing.T) {
+	payloads := testPayloads(t)
+	for code := range msgDecoders {
+		if _, ok := payloads[code]; !ok {
+			continue
+		}
+		require.NoError(t, msgDecoders[code](payloads[code]), msgCodeToString(code))
+		require.NoError(t, checkPayloadSize(code, len(payloads[code])), msgCodeToString(code))
+	}
+	// every code handled by the node has a decoder
+	for code := uint64(0); code <= 0xff; code++ {
+		if name := msgCodeToString(code); !strings.HasPrefix(name, "unknown") {
+			require.Contains(t, msgDecoders, code, name)
+		}
+	}
+}
+
+// TestMsgDecoders_mutations runs a fixed number of mutations of the fuzzing seeds, so malformed messages are checked
+// by every test run and not only by the fuzzer
+func TestMsgDecoders_mutations(t *testing.T) {
+	payloads := testPayloads(t)
+	rnd := rand.New(rand.NewSource(1))
+	for _, code := range sortedCodes(payloads) {
+		seed := payloads[code]
+		for i := 0; i < 500; i++ {
+			data := append([]byte(nil), seed...)
+			switch i % 3 {
+			case 0:
+				data = data[:rnd.Intn(len(data)+1)]
+			case 1:
+				for j := 0; j <= rnd.Intn(4); j++ {
+					if len(data) > 0 {
+						data[rnd.Intn(len(data))] = byte(rnd.Intn(256))
+					}
+				}
+			default:
+				data = make([]byte, rnd.Intn(64))
+				rnd.Read(data)
+			}
+			require.NotPanics(t, func() {
+				msgDecoders[code](data)
+			}, "%v %x", msgCodeToString(code), data)
+		}
+	}
+}
+
+func FuzzMsgDecoders(f *testing.F) {
+	payloads := testPayloads(f)
+	for _, code := range sortedCodes(payloads) {
+		f.Add(uint8(code), payloads[code])
+	}
+	f.Fuzz(func(t *testing.T, code uint8, payload []byte) {
+		if decode, ok := msgDecoders[uint64(code)]; ok {
+			decode(payload)
+		}
+	})
+}
+
+func FuzzWireMsg(f *testing.F) {
+	payloads := testPayloads(f)
+	for _, code := range sortedCodes(payloads) {
+		msg, _ := (&Msg{Code: code, Payload: payloads[code]}).ToBytes()
+		f.Add(Encode(code, msg))
+	}
+	f.Add([]byte{s2Compression, 0xff, 0xff, 0xff, 0xff, 0x0f})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		decoded, err := Decode(data)
+		if err != nil {
+			return
+		}
+		if len(decoded) > maxDecodedMsgSize {
+			t.Fatalf("decoded message exceeds the limit: %v", len(decoded))
+		}
+		msg := new(Msg)
+		if err := msg.FromBytes(decoded); err != nil {
+			return
+		}
+		if checkPayloadSize(msg.Code, len(msg.Payload)) != nil {
+			return
+		}
+		if decode, ok := msgDecoders[msg.Code]; ok {
+			decode(msg.Payload)
+		}
+	})
+}
+
+func TestDecode_decodedSizeLimit(t *testing.T) {
+	data := Encode(NewTx, make([]byte, maxDecodedMsgSize+1))
+	_, err := Decode(data)
+	require.Error(t, err)
+}
+
+func TestIdenaGossipHandler_handle_oversized(t *testing.T) {
+	h := &IdenaGossipHandler{
+		peers:       newPeerSet(),
+		connManager: NewConnManager(nil, config.P2P{}),
+	}
+	p, remote := newTestPeer("peer")
+	vote := &types.Vote{Header: &types.VoteHeader{Round: 1}, Signature: make([]byte, maxPayloadSizes[Vote])}
+	go remote.rw.WriteMsg(makeMsg(Vote, vote, 0))
+	err := h.handle(p)
+	require.IsType(t, &msgError{}, err)
+	require.Equal(t, ValidationErr, err.(*msgError).code)
+}
+
+func TestIdenaGossipHandler_handle_panic(t *testing.T) {
+	// the handler has no mempool, so adding the tx panics
+	h := &IdenaGossipHandler{
+		peers:       newPeerSet(),
+		connManager: NewConnManager(nil, config.P2P{}),
+	}
+	p, remote := newTestPeer("peer")
+	go remote.rw.WriteMsg(makeMsg(NewTx, &types.Transaction{AccountNonce: 1}, 0))
+	err := h.handle(p)
+	require.IsType(t, &msgError{}, err)
+	require.Equal(t, PanicErr, err.(*msgError).code)
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"fmt"
+	"github.com/idena-network/idena-go/config"
+	"github.com/idena-network/idena-go/node"
+	"github.com/urfave/cli"
+)
+
+var repairCommand = cli.Command{
+	Name:  "repair",
+	Usage: "Recover the chain db after a crash, partially written blocks are removed, the node must be stopped",
+	Flags: []cli.Flag{
+		config.CfgFileFlag,
+		config.DataDirFlag,
+		config.DbBackendFlag,
+	},
+	Action: repairDatabase,
+}
+
+func repairDatabase(ctx *cli.Context) error {
+	cfg, err := config.MakeConfig(ctx, func(cfg *config.Config) {})
+	if err != nil {
+		return err
+	}
+	result, err := node.RepairDatabase(cfg.DataDir, cfg.Database.Backend)
+	if err != nil {
+		return err
+	}
+	if result.FilesRecovered {
+		fmt.Fprintf(ctx.App.Writer, "Files of %v db are recovered\n", cfg.Database.Backend)
+	}
+	switch {
+	case result.PrevHead == 0:
+		fmt.Fprintln(ctx.App.Writer, "Chain is empty")
+	case result.Head == result.PrevHead:
+		fmt.Fprintf(ctx.App.Writer, "Chain is consistent, head %v\n", result.Head)
+	default:
+		fmt.Fprintf(ctx.App.Writer, "Chain is reset from %v to %v\n", result.PrevHead, result.Head)
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"fmt"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/config"
+	"github.com/idena-network/idena-go/consensus"
+	"github.com/idena-network/idena-go/database"
+	"github.com/idena-network/idena-go/node"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"io"
+	"sort"
+)
+
+const defaultReplayRounds = 100
+
+var (
+	replayFromFlag = cli.Uint64Flag{
+		Name:  "from",
+		Usage: "First round to replay, the last 100 rounds are replayed by default",
+	}
+	replayToFlag = cli.Uint64Flag{
+		Name:  "to",
+		Usage: "Last round to replay, the head is used by default",
+	}
+)
+
+var replayCommand = cli.Command{
+	Name:  "replay",
+	Usage: "Replay stored consensus rounds and print proposers, seed proofs, certificate votes and own votes from the wal, the node must be stopped",
+	Flags: []cli.Flag{
+		config.CfgFileFlag,
+		config.DataDirFlag,
+		config.DbBackendFlag,
+		replayFromFlag,
+		replayToFlag,
+	},
+	Action: replayRounds,
+}
+
+func replayRounds(ctx *cli.Context) error {
+	cfg, err := config.MakeConfig(ctx, func(cfg *config.Config) {})
+	if err != nil {
+		return err
+	}
+	db, err := node.OpenDatabase(cfg.DataDir, node.ChainDbName, cfg.Database.Backend, 16, 16, false)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	repo := database.NewRepo(db)
+	head := repo.ReadHead()
+	if head == nil {
+		return errors.New("chain is empty")
+	}
+	to := head.Height()
+	if ctx.IsSet(replayToFlag.Name) && ctx.Uint64(replayToFlag.Name) < to {
+		to = ctx.Uint64(replayToFlag.Name)
+	}
+	from := uint64(1)
+	if ctx.IsSet(replayFromFlag.Name) {
+		from = ctx.Uint64(replayFromFlag.Name)
+	} else if to > defaultReplayRounds {
+		from = to - defaultReplayRounds + 1
+	}
+	if from == 0 || from > to {
+		return errors.Errorf("invalid range %d-%d, head %d", from, to, head.Height())
+	}
+	walVotes, err := consensus.ReadWalVotes(cfg.DataDir)
+	if err != nil {
+		return err
+	}
+	return consensus.ReplayRounds(repo, from, to, walVotes, func(report *consensus.RoundReport) {
+		printRoundReport(ctx.App.Writer, report)
+	})
+}
+
+func printRoundReport(w io.Writer, report *consensus.RoundReport) {
+	if report.Empty {
+		fmt.Fprintf(w, "Round %d: empty block %v\n", report.Round, report.Hash.Hex())
+	} else {
+		seed := "valid"
+		if report.SeedErr != nil {
+			seed = report.SeedErr.Error()
+		}
+		fmt.Fprintf(w, "Round %d: block %v, proposer %v, seed proof: %v\n", report.Round, report.Hash.Hex(), report.Proposer.Hex(), seed)
+	}
+	if cert := report.Cert; cert != nil {
+		fmt.Fprintf(w, "  certificate: step %d, voted %v, signatures %d, voters %d, invalid %d, turn offline %d\n",
+			cert.Step, cert.VotedHash.Hex(), cert.Signatures, cert.Voters, cert.Invalid, cert.TurnOffline)
+		var upgrades []uint32
+		for upgrade := range cert.Upgrades {
+			upgrades = append(upgrades, upgrade)
+		}
+		sort.Slice(upgrades, func(i, j int) bool { return upgrades[i] < upgrades[j] })
+		for _, upgrade := range upgrades {
+			fmt.Fprintf(w, "  upgrade %d votes: %d\n", upgrade, cert.Upgrades[upgrade])
+		}
+	} else {
+		fmt.Fprintln(w, "  certificate is not stored")
+	}
+	votes := append([]*types.Vote(nil), report.OwnVotes...)
+	sort.Slice(votes, func(i, j int) bool { return votes[i].Header.Step < votes[j].Header.Step })
+	for _, vote := range votes {
+		fmt.Fprintf(w, "  own vote: step %d, voted %v, turn offline %v\n", vote.Header.Step, vote.Header.VotedHash.Hex(), vote.Header.TurnOffline)
+	}
+}
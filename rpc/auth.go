@@ -0,0 +1,107 @@
+package rpc
+
+import (
+	"crypto/tls"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// AccessKey is an API key which grants access to the listed namespaces only, e.g. a read-only key
+// for monitoring which can't reach account and flip methods
+type AccessKey struct {
+	Key     string
+	Modules []string
+}
+
+// EndpointAuth configures authorization and transport security of an endpoint
+type EndpointAuth struct {
+	// APIKey grants access to all namespaces of the endpoint, no key is required if it's empty and there are no access keys
+	APIKey     string
+	AccessKeys []AccessKey
+	// TLSCertFile and TLSKeyFile enable TLS, both PEM files are required
+	TLSCertFile string
+	TLSKeyFile  string
+}
+
+// SetAccessKeys registers keys with restricted access in addition to the api key of the server which grants access
+// to all namespaces, requests without a valid key are rejected once any key is configured
+func (s *Server) SetAccessKeys(keys []AccessKey) {
+	s.accessKeys = make(map[string]map[string]bool, len(keys))
+	for _, key := range keys {
+		modules := make(map[string]bool, len(key.Modules))
+		for _, module := range key.Modules {
+			modules[module] = true
+		}
+		s.accessKeys[key.Key] = modules
+	}
+}
+
+// authorize checks that the key of the request grants access to its namespace, unsubscribe requests don't have
+// a namespace and are allowed for any valid key
+func (s *Server) authorize(r rpcRequest) Error {
+	if s.apiKey == "" && len(s.accessKeys) == 0 {
+		return nil
+	}
+	if s.apiKey != "" && r.key == s.apiKey {
+		return nil
+	}
+	modules, ok := s.accessKeys[r.key]
+	if !ok || r.key == "" {
+		return &invalidApiKeyError{}
+	}
+	if r.service != "" && !modules[r.service] {
+		return &accessDeniedError{r.service}
+	}
+	return nil
+}
+
+// tokenCodec fills the key of requests which don't have it with the token passed on connection,
+// so clients may authorize by the Authorization header instead of the key of every request
+type tokenCodec struct {
+	ServerCodec
+	token string
+}
+
+func (c *tokenCodec) ReadRequestHeaders() ([]rpcRequest, bool, Error) {
+	reqs, batch, err := c.ServerCodec.ReadRequestHeaders()
+	for i := range reqs {
+		if reqs[i].key == "" {
+			reqs[i].key = c.token
+		}
+	}
+	return reqs, batch, err
+}
+
+func withToken(codec ServerCodec, r *http.Request) ServerCodec {
+	token := bearerToken(r)
+	if token == "" {
+		return codec
+	}
+	return &tokenCodec{codec, token}
+}
+
+// bearerToken returns the token of the "Authorization: Bearer <token>" header
+func bearerToken(r *http.Request) string {
+	const prefix = "bearer "
+	header := r.Header.Get("Authorization")
+	if len(header) <= len(prefix) || !strings.EqualFold(header[:len(prefix)], prefix) {
+		return ""
+	}
+	return strings.TrimSpace(header[len(prefix):])
+}
+
+// listen opens a tcp listener, connections are served over TLS if the certificate and the key are provided
+func listen(endpoint string, certFile string, keyFile string) (net.Listener, error) {
+	if certFile == "" && keyFile == "" {
+		return net.Listen("tcp", endpoint)
+	}
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	return tls.Listen("tcp", endpoint, &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	})
+}
@@ -0,0 +1,96 @@
+package rpc
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type authTestResponse struct {
+	Result *Result    `json:"result"`
+	Error  *jsonError `json:"error"`
+}
+
+func callWithAuth(t *testing.T, server *Server, method string, key string, token string) authTestResponse {
+	request := map[string]interface{}{
+		"id":      1,
+		"method":  method,
+		"version": "2.0",
+		"params":  []interface{}{"s", 1, &Args{"a"}},
+	}
+	if key != "" {
+		request["key"] = key
+	}
+	body, _ := json.Marshal(request)
+	httpRequest := httptest.NewRequest(http.MethodPost, "http://localhost", strings.NewReader(string(body)))
+	httpRequest.Header.Set("content-type", contentType)
+	if token != "" {
+		httpRequest.Header.Set("Authorization", "Bearer "+token)
+	}
+	recorder := httptest.NewRecorder()
+	server.ServeHTTP(recorder, httpRequest)
+
+	var response authTestResponse
+	if err := json.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+		t.Fatalf("invalid response %q: %v", recorder.Body.String(), err)
+	}
+	return response
+}
+
+func TestServerAccessKeys(t *testing.T) {
+	server := NewServer("admin")
+	server.SetAccessKeys([]AccessKey{{Key: "monitor", Modules: []string{"test"}}})
+	if err := server.RegisterName("test", new(Service)); err != nil {
+		t.Fatal(err)
+	}
+	if err := server.RegisterName("account", new(Service)); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		method, key, token string
+		errCode            int
+	}{
+		{"test_echo", "admin", "", 0},
+		{"account_echo", "admin", "", 0},
+		{"test_echo", "monitor", "", 0},
+		{"account_echo", "monitor", "", (&accessDeniedError{}).ErrorCode()},
+		{"test_echo", "", "", (&invalidApiKeyError{}).ErrorCode()},
+		{"test_echo", "unknown", "", (&invalidApiKeyError{}).ErrorCode()},
+		{"account_echo", "", "admin", 0},
+		{"test_echo", "", "monitor", 0},
+		{"account_echo", "", "monitor", (&accessDeniedError{}).ErrorCode()},
+		// the key of the request takes precedence over the token
+		{"account_echo", "monitor", "admin", (&accessDeniedError{}).ErrorCode()},
+	}
+	for _, c := range cases {
+		response := callWithAuth(t, server, c.method, c.key, c.token)
+		if c.errCode == 0 {
+			if response.Error != nil || response.Result == nil || response.Result.String != "s" {
+				t.Errorf("%v key=%q token=%q: unexpected response %+v", c.method, c.key, c.token, response.Error)
+			}
+			continue
+		}
+		if response.Error == nil || response.Error.Code != c.errCode {
+			t.Errorf("%v key=%q token=%q: expected error %d, got %+v", c.method, c.key, c.token, c.errCode, response.Error)
+		}
+	}
+}
+
+func TestBearerToken(t *testing.T) {
+	for header, expected := range map[string]string{
+		"Bearer abc":  "abc",
+		"bearer  abc": "abc",
+		"Basic abc":   "",
+		"Bearer ":     "",
+		"":            "",
+	} {
+		request := httptest.NewRequest(http.MethodPost, "http://localhost", nil)
+		request.Header.Set("Authorization", header)
+		if token := bearerToken(request); token != expected {
+			t.Errorf("header %q: expected %q, got %q", header, expected, token)
+		}
+	}
+}
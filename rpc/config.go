@@ -35,7 +35,43 @@ type Config struct {
 	// for ephemeral nodes).
 	HTTPPort int `toml:",omitempty"`
 
+	// WSHost is the host interface on which to start the WebSocket RPC server. If
+	// this field is empty, no WebSocket API endpoint will be started.
+	WSHost string `toml:",omitempty"`
+
+	// WSPort is the TCP port number on which to start the WebSocket RPC server.
+	WSPort int `toml:",omitempty"`
+
+	// WSModules is a list of API modules to expose via the WebSocket RPC interface.
+	// If the module list is empty, all RPC API endpoints designated public will be
+	// exposed.
+	WSModules []string `toml:",omitempty"`
+
+	// WSOrigins is the list of domain to accept websocket requests from. Please be
+	// aware that the server can only act upon the HTTP request the client sends and
+	// cannot verify the validity of the request header.
+	WSOrigins []string `toml:",omitempty"`
+
 	APIKey string
+
+	// AccessKeys are API keys restricted to the listed namespaces, e.g. read-only keys for monitoring
+	AccessKeys []AccessKey `toml:",omitempty"`
+
+	// TLSCertFile and TLSKeyFile are PEM files of the certificate and the key, HTTP and WebSocket endpoints
+	// are served over TLS if both are set
+	TLSCertFile string `toml:",omitempty"`
+	TLSKeyFile  string `toml:",omitempty"`
+
+	// IPCPath is the path of the Unix domain socket which serves all namespaces to local clients without a key,
+	// access is limited by permissions of the socket file. The socket isn't opened if the path is empty
+	IPCPath string `toml:",omitempty"`
+
+	// MetricsHost is the host interface on which to serve node metrics in the Prometheus
+	// format at /metrics. If this field is empty, metrics are not exposed.
+	MetricsHost string `toml:",omitempty"`
+
+	// MetricsPort is the TCP port number on which to serve node metrics.
+	MetricsPort int `toml:",omitempty"`
 }
 
 func (c *Config) HTTPEndpoint() string {
@@ -45,6 +81,30 @@ func (c *Config) HTTPEndpoint() string {
 	return fmt.Sprintf("%s:%d", c.HTTPHost, c.HTTPPort)
 }
 
+func (c *Config) WSEndpoint() string {
+	if c.WSHost == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s:%d", c.WSHost, c.WSPort)
+}
+
+// EndpointAuth returns the authorization and TLS settings of the HTTP and WebSocket endpoints
+func (c *Config) EndpointAuth() EndpointAuth {
+	return EndpointAuth{
+		APIKey:      c.APIKey,
+		AccessKeys:  c.AccessKeys,
+		TLSCertFile: c.TLSCertFile,
+		TLSKeyFile:  c.TLSKeyFile,
+	}
+}
+
+func (c *Config) MetricsEndpoint() string {
+	if c.MetricsHost == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s:%d", c.MetricsHost, c.MetricsPort)
+}
+
 func GetDefaultRPCConfig(host string, port int) *Config {
 	// DefaultConfig contains reasonable default settings.
 	return &Config{
@@ -54,5 +114,7 @@ func GetDefaultRPCConfig(host string, port int) *Config {
 		HTTPModules:      []string{"net", "dna", "account", "flip", "bcn", "ipfs", "contract"},
 		HTTPVirtualHosts: []string{"localhost"},
 		HTTPTimeouts:     DefaultHTTPTimeouts,
+		WSModules:        []string{"bcn"},
+		MetricsPort:      9010,
 	}
 }
@@ -24,14 +24,15 @@ import (
 )
 
 // StartHTTPEndpoint starts the HTTP RPC endpoint, configured with cors/vhosts/modules
-func StartHTTPEndpoint(endpoint string, apis []API, modules []string, cors []string, vhosts []string, timeouts HTTPTimeouts, apiKey string) (net.Listener, *Server, *http.Server, error) {
+func StartHTTPEndpoint(endpoint string, apis []API, modules []string, cors []string, vhosts []string, timeouts HTTPTimeouts, auth EndpointAuth) (net.Listener, *Server, *http.Server, error) {
 	// Generate the whitelist based on the allowed modules
 	whitelist := make(map[string]bool)
 	for _, module := range modules {
 		whitelist[module] = true
 	}
 	// Register all the APIs exposed by the services
-	handler := NewServer(apiKey)
+	handler := NewServer(auth.APIKey)
+	handler.SetAccessKeys(auth.AccessKeys)
 	for _, api := range apis {
 		if whitelist[api.Namespace] || (len(whitelist) == 0 && api.Public) {
 			if err := handler.RegisterName(api.Namespace, api.Service); err != nil {
@@ -45,7 +46,7 @@ func StartHTTPEndpoint(endpoint string, apis []API, modules []string, cors []str
 		listener net.Listener
 		err      error
 	)
-	if listener, err = net.Listen("tcp", endpoint); err != nil {
+	if listener, err = listen(endpoint, auth.TLSCertFile, auth.TLSKeyFile); err != nil {
 		return nil, nil, nil, err
 	}
 	httpServer := NewHTTPServer(cors, vhosts, timeouts, handler)
@@ -54,7 +55,7 @@ func StartHTTPEndpoint(endpoint string, apis []API, modules []string, cors []str
 }
 
 // StartWSEndpoint starts a websocket endpoint
-func StartWSEndpoint(endpoint string, apis []API, modules []string, wsOrigins []string, exposeAll bool) (net.Listener, *Server, error) {
+func StartWSEndpoint(endpoint string, apis []API, modules []string, wsOrigins []string, exposeAll bool, auth EndpointAuth) (net.Listener, *Server, error) {
 
 	// Generate the whitelist based on the allowed modules
 	whitelist := make(map[string]bool)
@@ -62,7 +63,8 @@ func StartWSEndpoint(endpoint string, apis []API, modules []string, wsOrigins []
 		whitelist[module] = true
 	}
 	// Register all the APIs exposed by the services
-	handler := NewServer("")
+	handler := NewServer(auth.APIKey)
+	handler.SetAccessKeys(auth.AccessKeys)
 	for _, api := range apis {
 		if exposeAll || whitelist[api.Namespace] || (len(whitelist) == 0 && api.Public) {
 			if err := handler.RegisterName(api.Namespace, api.Service); err != nil {
@@ -76,7 +78,7 @@ func StartWSEndpoint(endpoint string, apis []API, modules []string, wsOrigins []
 		listener net.Listener
 		err      error
 	)
-	if listener, err = net.Listen("tcp", endpoint); err != nil {
+	if listener, err = listen(endpoint, auth.TLSCertFile, auth.TLSKeyFile); err != nil {
 		return nil, nil, err
 	}
 	go NewWSServer(wsOrigins, handler).Serve(listener)
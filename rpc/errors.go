@@ -71,3 +71,12 @@ type invalidApiKeyError struct{}
 func (e *invalidApiKeyError) ErrorCode() int { return -32800 }
 
 func (e *invalidApiKeyError) Error() string { return "the provided API key is invalid" }
+
+// the api key doesn't grant access to the namespace
+type accessDeniedError struct{ service string }
+
+func (e *accessDeniedError) ErrorCode() int { return -32801 }
+
+func (e *accessDeniedError) Error() string {
+	return fmt.Sprintf("the provided API key has no access to the %s namespace", e.service)
+}
@@ -246,7 +246,7 @@ func (srv *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	body := io.LimitReader(r.Body, maxRequestContentLength)
-	codec := NewJSONCodec(&httpReadWriteNopCloser{body, w})
+	codec := withToken(NewJSONCodec(&httpReadWriteNopCloser{body, w}), r)
 	defer codec.Close()
 
 	w.Header().Set("content-type", contentType)
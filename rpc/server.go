@@ -390,8 +390,8 @@ func (s *Server) readRequest(codec ServerCodec) ([]*serverRequest, bool, Error)
 			continue
 		}
 
-		if s.apiKey != "" && r.key != s.apiKey {
-			requests[i] = &serverRequest{id: r.id, err: &invalidApiKeyError{}}
+		if err := s.authorize(r); err != nil {
+			requests[i] = &serverRequest{id: r.id, err: err}
 			continue
 		}
 
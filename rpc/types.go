@@ -69,8 +69,9 @@ type subscriptions map[string]*callback  // collection of subscription callbacks
 
 // Server represents a RPC server
 type Server struct {
-	services serviceRegistry
-	apiKey   string
+	services   serviceRegistry
+	apiKey     string
+	accessKeys map[string]map[string]bool
 
 	run      int32
 	codecsMu sync.Mutex
@@ -0,0 +1,276 @@
+package txtracker
+
+import (
+	"github.com/idena-network/idena-go/blockchain"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/eventbus"
+	"github.com/idena-network/idena-go/core/appstate"
+	"github.com/idena-network/idena-go/core/mempool"
+	"github.com/idena-network/idena-go/events"
+	"github.com/idena-network/idena-go/log"
+	"sort"
+	"sync"
+)
+
+const (
+	// maxTrackedTxs limits the number of tracked transactions, own transactions above the limit aren't tracked
+	maxTrackedTxs = 1000
+	// rebroadcastBlocks is the number of blocks after which a transaction which isn't mined yet is sent again
+	rebroadcastBlocks = 3
+	// stuckBlocks is the number of blocks after which a transaction which isn't mined yet is reported as stuck
+	stuckBlocks = 10
+	// finalityDepth is the number of blocks on top of the block of a transaction after which it's confirmed
+	finalityDepth = 6
+	// keepResolvedBlocks is the number of blocks confirmed and failed transactions are still reported for
+	keepResolvedBlocks = 100
+)
+
+type Status string
+
+const (
+	Pending   Status = "Pending"
+	Stuck     Status = "Stuck"
+	Mined     Status = "Mined"
+	Confirmed Status = "Confirmed"
+	Failed    Status = "Failed"
+)
+
+// TrackedTx is an own transaction followed by the tracker
+type TrackedTx struct {
+	Tx     *types.Transaction
+	Sender common.Address
+	Status Status
+	// AddedHeight is the head height when the transaction was submitted
+	AddedHeight uint64
+	BlockHash   common.Hash
+	BlockHeight uint64
+	// Resubmissions is the number of times the transaction was sent again
+	Resubmissions int
+	// Error is the reason of the failure or the last error of the resubmission
+	Error string
+
+	lastSent       uint64
+	resolvedHeight uint64
+}
+
+func (tx *TrackedTx) resolved() bool {
+	return tx.Status == Confirmed || tx.Status == Failed
+}
+
+func (tx *TrackedTx) waiting() bool {
+	return tx.Status == Pending || tx.Status == Stuck
+}
+
+// Tracker follows locally submitted transactions until they are confirmed. A transaction which isn't mined is sent
+// again every few blocks, so it reaches new peers, and is added to the mempool again if it was dropped from there
+type Tracker struct {
+	txpool    mempool.TransactionPool
+	appState  *appstate.AppState
+	chain     *blockchain.Blockchain
+	broadcast func(tx *types.Transaction)
+	txs       map[common.Hash]*TrackedTx
+	head      uint64
+	log       log.Logger
+	mutex     sync.Mutex
+}
+
+// NewTracker subscribes to own transactions of the mempool and applied blocks
+func NewTracker(bus eventbus.Bus, txpool mempool.TransactionPool, appState *appstate.AppState, chain *blockchain.Blockchain) *Tracker {
+	t := &Tracker{
+		txpool:   txpool,
+		appState: appState,
+		chain:    chain,
+		txs:      make(map[common.Hash]*TrackedTx),
+		log:      log.New(log.ModuleKey, "txtracker"),
+	}
+	bus.Subscribe(events.NewTxEventID, func(e eventbus.Event) {
+		if newTxEvent := e.(*events.NewTxEvent); newTxEvent.Own {
+			t.track(newTxEvent.Tx)
+		}
+	})
+	bus.Subscribe(events.AddBlockEventID, func(e eventbus.Event) {
+		t.onBlock(e.(*events.NewBlockEvent).Block)
+	})
+	return t
+}
+
+// Initialize sets the head the heights of tracked transactions are counted from
+func (t *Tracker) Initialize(head *types.Header) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.head = head.Height()
+}
+
+// SetBroadcaster sets the function which sends a transaction of the mempool to peers again
+func (t *Tracker) SetBroadcaster(broadcast func(tx *types.Transaction)) {
+	t.broadcast = broadcast
+}
+
+func (t *Tracker) track(tx *types.Transaction) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if _, ok := t.txs[tx.Hash()]; ok {
+		return
+	}
+	if len(t.txs) >= maxTrackedTxs {
+		t.log.Warn("Too many tracked txs, tx is not tracked", "hash", tx.Hash().Hex())
+		return
+	}
+	sender, _ := types.Sender(tx)
+	t.txs[tx.Hash()] = &TrackedTx{
+		Tx:          tx,
+		Sender:      sender,
+		Status:      Pending,
+		AddedHeight: t.head,
+		lastSent:    t.head,
+	}
+}
+
+func (t *Tracker) onBlock(block *types.Block) {
+	resubmit, rebroadcast := t.update(block)
+	for _, tx := range resubmit {
+		err := t.txpool.AddInternalTx(tx)
+		if err == mempool.DuplicateTxError {
+			err = nil
+		}
+		t.mutex.Lock()
+		if tracked, ok := t.txs[tx.Hash()]; ok {
+			tracked.Resubmissions++
+			tracked.Error = ""
+			if err != nil {
+				tracked.Error = err.Error()
+			}
+		}
+		t.mutex.Unlock()
+		if err != nil {
+			t.log.Warn("Own tx is not resubmitted", "hash", tx.Hash().Hex(), "err", err)
+		} else {
+			t.log.Info("Own tx is resubmitted", "hash", tx.Hash().Hex())
+		}
+	}
+	if t.broadcast != nil {
+		for _, tx := range rebroadcast {
+			t.broadcast(tx)
+		}
+	}
+}
+
+// update applies the block to tracked transactions and returns the ones which should be added to the mempool
+// again and the ones which should be sent to peers again, both are ordered by nonces
+func (t *Tracker) update(block *types.Block) (resubmit, rebroadcast []*types.Transaction) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	height := block.Height()
+	t.head = height
+	if len(t.txs) == 0 {
+		return nil, nil
+	}
+	if block.Body != nil {
+		for _, tx := range block.Body.Transactions {
+			if tracked, ok := t.txs[tx.Hash()]; ok && !tracked.resolved() {
+				tracked.Status = Mined
+				tracked.BlockHash = block.Hash()
+				tracked.BlockHeight = height
+				tracked.Error = ""
+			}
+		}
+	}
+	globalEpoch := t.appState.State.Epoch()
+	var waiting []*TrackedTx
+	for hash, tracked := range t.txs {
+		switch {
+		case tracked.resolved():
+			if height >= tracked.resolvedHeight+keepResolvedBlocks {
+				delete(t.txs, hash)
+			}
+			continue
+		case tracked.Status == Mined:
+			if height < tracked.BlockHeight+finalityDepth {
+				continue
+			}
+			if header := t.chain.GetBlockHeaderByHeight(tracked.BlockHeight); header != nil && header.Hash() == tracked.BlockHash {
+				tracked.Status = Confirmed
+				tracked.resolvedHeight = height
+				continue
+			}
+			// the block was replaced by a fork, the transaction is sent again unless it's in the new chain
+			tracked.Status = Pending
+			tracked.BlockHash = common.Hash{}
+			tracked.BlockHeight = 0
+			tracked.lastSent = 0
+		}
+		if tracked.Tx.Epoch < globalEpoch {
+			t.fail(tracked, height, "epoch of the tx is over")
+			continue
+		}
+		if tracked.Tx.Epoch == globalEpoch && t.appState.State.GetEpoch(tracked.Sender) == globalEpoch &&
+			t.appState.State.GetNonce(tracked.Sender) >= tracked.Tx.AccountNonce {
+			t.fail(tracked, height, "nonce is used by another tx")
+			continue
+		}
+		if height >= tracked.AddedHeight+stuckBlocks {
+			tracked.Status = Stuck
+		}
+		waiting = append(waiting, tracked)
+	}
+	if t.txpool.IsSyncing() {
+		return nil, nil
+	}
+	sort.Slice(waiting, func(i, j int) bool {
+		if waiting[i].Tx.Epoch != waiting[j].Tx.Epoch {
+			return waiting[i].Tx.Epoch < waiting[j].Tx.Epoch
+		}
+		return waiting[i].Tx.AccountNonce < waiting[j].Tx.AccountNonce
+	})
+	for _, tracked := range waiting {
+		if height < tracked.lastSent+rebroadcastBlocks {
+			continue
+		}
+		tracked.lastSent = height
+		if t.txpool.GetTx(tracked.Tx.Hash()) == nil {
+			resubmit = append(resubmit, tracked.Tx)
+		} else {
+			rebroadcast = append(rebroadcast, tracked.Tx)
+		}
+	}
+	return resubmit, rebroadcast
+}
+
+func (t *Tracker) fail(tracked *TrackedTx, height uint64, reason string) {
+	tracked.Status = Failed
+	tracked.Error = reason
+	tracked.resolvedHeight = height
+	t.log.Warn("Own tx failed", "hash", tracked.Tx.Hash().Hex(), "reason", reason)
+}
+
+// NextNonce returns the nonce of the next transaction of the address, nonces of transactions which were dropped
+// from the mempool but are still resubmitted are skipped
+func (t *Tracker) NextNonce(address common.Address, epoch uint16) uint32 {
+	nonce := t.appState.NonceCache.GetNonce(address, epoch)
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	for _, tracked := range t.txs {
+		if tracked.Sender == address && tracked.Tx.Epoch == epoch && tracked.waiting() && tracked.Tx.AccountNonce > nonce {
+			nonce = tracked.Tx.AccountNonce
+		}
+	}
+	return nonce + 1
+}
+
+// Txs returns copies of tracked transactions ordered by the submission height
+func (t *Tracker) Txs() []TrackedTx {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	result := make([]TrackedTx, 0, len(t.txs))
+	for _, tracked := range t.txs {
+		result = append(result, *tracked)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].AddedHeight != result[j].AddedHeight {
+			return result[i].AddedHeight < result[j].AddedHeight
+		}
+		return result[i].Tx.AccountNonce < result[j].Tx.AccountNonce
+	})
+	return result
+}
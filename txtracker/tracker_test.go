@@ -0,0 +1,97 @@
+package txtracker
+
+import (
+	"github.com/idena-network/idena-go/blockchain"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/blockchain/validation"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/crypto"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func newTestTracker(t *testing.T) (*Tracker, *blockchain.TestBlockchain, func(nonce uint32, amount int64) *types.Transaction) {
+	key, _ := crypto.GenerateKey()
+	chain, appState := blockchain.NewCustomTestBlockchain(1, 0, key)
+	tracker := NewTracker(chain.Bus(), chain.TxPool(), appState, chain.Blockchain)
+	tracker.Initialize(chain.Head)
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	newTx := func(nonce uint32, amount int64) *types.Transaction {
+		to := common.Address{0x1}
+		tx := blockchain.BuildTx(appState, from, &to, types.SendTx, decimal.New(amount, 0), decimal.New(20, 0), decimal.Zero, nonce, 0, nil)
+		signed, err := types.SignTx(tx, key)
+		require.NoError(t, err)
+		return signed
+	}
+	return tracker, chain, newTx
+}
+
+func trackedTx(tracker *Tracker, hash common.Hash) TrackedTx {
+	for _, tx := range tracker.Txs() {
+		if tx.Tx.Hash() == hash {
+			return tx
+		}
+	}
+	return TrackedTx{}
+}
+
+func TestTracker_resubmit(t *testing.T) {
+	tracker, chain, newTx := newTestTracker(t)
+	pool := chain.TxPool()
+	var broadcasts []common.Hash
+	tracker.SetBroadcaster(func(tx *types.Transaction) {
+		broadcasts = append(broadcasts, tx.Hash())
+	})
+
+	tx := newTx(1, 1)
+	require.NoError(t, pool.AddInternalTx(tx))
+	require.Equal(t, Pending, trackedTx(tracker, tx.Hash()).Status)
+
+	// the tx is dropped from the mempool, so its nonce is still taken by the tracker
+	pool.Remove(tx)
+	chain.GenerateEmptyBlocks(1)
+	require.Equal(t, uint32(2), tracker.NextNonce(trackedTx(tracker, tx.Hash()).Sender, tx.Epoch))
+
+	chain.GenerateEmptyBlocks(rebroadcastBlocks - 1)
+	require.NotNil(t, pool.GetTx(tx.Hash()))
+	require.Equal(t, 1, trackedTx(tracker, tx.Hash()).Resubmissions)
+	require.Empty(t, broadcasts)
+
+	chain.GenerateEmptyBlocks(rebroadcastBlocks)
+	require.Equal(t, []common.Hash{tx.Hash()}, broadcasts)
+
+	chain.GenerateBlocks(1, 0)
+	tracked := trackedTx(tracker, tx.Hash())
+	require.Equal(t, Mined, tracked.Status)
+	require.Equal(t, chain.Head.Hash(), tracked.BlockHash)
+
+	chain.GenerateEmptyBlocks(finalityDepth)
+	require.Equal(t, Confirmed, trackedTx(tracker, tx.Hash()).Status)
+
+	chain.GenerateEmptyBlocks(keepResolvedBlocks)
+	require.Empty(t, tracker.Txs())
+}
+
+func TestTracker_stuckAndFailed(t *testing.T) {
+	tracker, chain, newTx := newTestTracker(t)
+	pool := chain.TxPool()
+
+	// the tx waits for the missing nonce
+	stuck := newTx(3, 1)
+	require.NoError(t, pool.AddInternalTx(stuck))
+
+	replaced := newTx(1, 1)
+	require.NoError(t, pool.AddInternalTx(replaced))
+	pool.Remove(replaced)
+	require.NoError(t, pool.AddExternalTxs(validation.InboundTx, newTx(1, 2)))
+	chain.GenerateBlocks(1, 0)
+
+	tracked := trackedTx(tracker, replaced.Hash())
+	require.Equal(t, Failed, tracked.Status)
+	require.NotEmpty(t, tracked.Error)
+
+	chain.GenerateEmptyBlocks(stuckBlocks)
+	require.Equal(t, Stuck, trackedTx(tracker, stuck.Hash()).Status)
+	require.Equal(t, uint32(4), tracker.NextNonce(trackedTx(tracker, stuck.Hash()).Sender, stuck.Epoch))
+}